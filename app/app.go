@@ -7,6 +7,7 @@ import (
 	"github.com/containers/podman-tui/pdcs/registry"
 	health "github.com/containers/podman-tui/system"
 	"github.com/containers/podman-tui/ui/containers"
+	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/containers/podman-tui/ui/help"
 	"github.com/containers/podman-tui/ui/images"
 	"github.com/containers/podman-tui/ui/infobar"
@@ -37,7 +38,10 @@ type App struct {
 	menu            *tview.TextView
 	health          *health.Engine
 	help            *help.Help
+	palette         *dialogs.CommandPaletteDialog
+	cmdline         *commandLine
 	currentPage     string
+	navigateHistory []navigateLocation
 	needInitUI      bool
 	fastRefreshChan chan bool
 	config          config.Config
@@ -66,8 +70,9 @@ func NewApp(name string, version string) *App {
 
 	app.infoBar = infobar.NewInfoBar()
 
-	app.pods = pods.NewPods()
 	app.containers = containers.NewContainers()
+	// pod's container exec sessions are shared with container page.
+	app.pods = pods.NewPods(app.containers.ExecSessions())
 	app.volumes = volumes.NewVolumes()
 	app.images = images.NewImages()
 	app.networks = networks.NewNetworks()
@@ -102,6 +107,16 @@ func NewApp(name string, version string) *App {
 	// its required for image build dialog.
 	app.images.SetFastRefreshChannel(app.fastRefreshChan)
 
+	// set refresh channel for pod page
+	// its required for pod's container exec and attach.
+	app.pods.SetFastRefreshChannel(app.fastRefreshChan)
+
+	// set refresh channel for network, volume and secret pages
+	// its required for details panel.
+	app.networks.SetFastRefreshChannel(app.fastRefreshChan)
+	app.volumes.SetFastRefreshChannel(app.fastRefreshChan)
+	app.secrets.SetFastRefreshChannel(app.fastRefreshChan)
+
 	// set app set focus
 	app.containers.SetAppFocusHandler(func() {
 		app.SetFocus(app.containers)
@@ -139,6 +154,14 @@ func NewApp(name string, version string) *App {
 		app.fastRefreshChan <- true
 	})
 
+	// set resources navigation handler
+	app.system.SetNavigateFunc(app.navigateTo)
+	app.containers.SetNavigateFunc(app.navigateTo)
+	app.images.SetNavigateFunc(app.navigateTo)
+	app.volumes.SetNavigateFunc(app.navigateTo)
+	app.networks.SetNavigateFunc(app.navigateTo)
+	app.secrets.SetNavigateFunc(app.navigateTo)
+
 	// menu items
 	menuItems := [][]string{
 		{utils.HelpScreenKey.Label(), app.help.GetTitle()},
@@ -162,6 +185,15 @@ func NewApp(name string, version string) *App {
 	app.pages.AddPage(app.networks.GetTitle(), app.networks, true, false)
 	app.pages.AddPage(app.secrets.GetTitle(), app.secrets, true, false)
 
+	// command palette is displayed on top of the current screen
+	app.palette = dialogs.NewCommandPaletteDialog()
+	app.palette.SetSelectedFunc(app.runPaletteItem)
+	app.palette.SetCancelFunc(app.hideCommandPalette)
+	app.pages.AddPage(commandPalettePage, app.palette, true, false)
+
+	// command line and its dialogs are displayed on top of the current screen
+	app.initCommandLine()
+
 	return &app
 }
 
@@ -189,7 +221,24 @@ func (app *App) Run() error { //nolint:cyclop
 			os.Exit(0)
 		}
 
+		// command palette and command line handle their own input
+		if app.palette.IsDisplay() || app.cmdline.IsDisplay() {
+			return event
+		}
+
 		if !app.frontScreenHasActiveDialog() {
+			if event.Key() == utils.CommandPaletteKey.Key {
+				app.displayCommandPalette()
+
+				return nil
+			}
+
+			if event.Rune() == utils.CommandLineKey.Rune() {
+				app.displayCommandLine()
+
+				return nil
+			}
+
 			event = utils.ParseKeyEventKey(event)
 
 			// previous and next screen keys
@@ -204,6 +253,12 @@ func (app *App) Run() error { //nolint:cyclop
 				// previous screen
 				app.switchToPreviousScreen()
 
+				return nil
+
+			case utils.NavigateBackKey.Rune():
+				// previous navigation location
+				app.navigateBack()
+
 				return nil
 			}
 
@@ -0,0 +1,403 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	putils "github.com/containers/podman-tui/pdcs/utils"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	commandLinePage     = "command line"
+	cmdlineSelectedItem = "$(selected)"
+	cmdlineErrorTitle   = "COMMAND LINE ERROR"
+)
+
+var errCmdlineNoSelectedItem = errors.New("there is no selected item on the current screen")
+
+// commandLine hosts the command line and the progress, confirm, message and error dialogs
+// of its commands on top of the current screen.
+type commandLine struct {
+	*tview.Box
+
+	input          *dialogs.CommandLineDialog
+	progressDialog *dialogs.ProgressDialog
+	confirmDialog  *dialogs.ConfirmDialog
+	messageDialog  *dialogs.MessageDialog
+	errorDialog    *dialogs.ErrorDialog
+	confirmHandler func()
+}
+
+func newCommandLine() *commandLine {
+	return &commandLine{
+		Box:            tview.NewBox(),
+		input:          dialogs.NewCommandLineDialog(),
+		progressDialog: dialogs.NewProgressDialog(),
+		confirmDialog:  dialogs.NewConfirmDialog(),
+		messageDialog:  dialogs.NewMessageDialog(""),
+		errorDialog:    dialogs.NewErrorDialog(),
+	}
+}
+
+// getInnerDialogs returns the command line dialogs, the top most one is the first.
+func (cl *commandLine) getInnerDialogs() []utils.UIDialog {
+	return []utils.UIDialog{
+		cl.errorDialog,
+		cl.progressDialog,
+		cl.confirmDialog,
+		cl.messageDialog,
+		cl.input,
+	}
+}
+
+// IsDisplay returns true if the command line or one of its dialogs is shown.
+func (cl *commandLine) IsDisplay() bool {
+	for _, dialog := range cl.getInnerDialogs() {
+		if dialog.IsDisplay() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (cl *commandLine) HasFocus() bool {
+	for _, dialog := range cl.getInnerDialogs() {
+		if dialog.HasFocus() {
+			return true
+		}
+	}
+
+	return cl.Box.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (cl *commandLine) Focus(delegate func(p tview.Primitive)) {
+	for _, dialog := range cl.getInnerDialogs() {
+		if dialog.IsDisplay() {
+			delegate(dialog)
+
+			return
+		}
+	}
+
+	cl.Box.Focus(delegate)
+}
+
+// InputHandler returns input handler function for this primitive.
+func (cl *commandLine) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return cl.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("app: command line event %v received", event)
+
+		for _, dialog := range cl.getInnerDialogs() {
+			if !dialog.IsDisplay() {
+				continue
+			}
+
+			if dialogHandler := dialog.InputHandler(); dialogHandler != nil {
+				dialogHandler(event, setFocus)
+			}
+
+			return
+		}
+	})
+}
+
+// Draw draws the command line and its displayed dialogs onto the screen.
+func (cl *commandLine) Draw(screen tcell.Screen) {
+	x, y, width, height := cl.GetRect()
+	dialogList := cl.getInnerDialogs()
+
+	for i := len(dialogList) - 1; i >= 0; i-- {
+		if dialogList[i].IsDisplay() {
+			dialogList[i].SetRect(x, y, width, height)
+			dialogList[i].Draw(screen)
+		}
+	}
+}
+
+func (app *App) initCommandLine() {
+	app.cmdline = newCommandLine()
+
+	app.cmdline.input.SetExecuteFunc(app.executeCommandLine)
+	app.cmdline.input.SetCompleteFunc(app.completeCommandLine)
+	app.cmdline.input.SetCancelFunc(func() {
+		app.cmdline.input.Hide()
+		app.commandLineFocus()
+	})
+
+	app.cmdline.confirmDialog.SetCancelFunc(func() {
+		app.cmdline.confirmDialog.Hide()
+		app.commandLineFocus()
+	})
+
+	app.cmdline.confirmDialog.SetSelectedFunc(func() {
+		app.cmdline.confirmDialog.Hide()
+
+		if app.cmdline.confirmHandler != nil {
+			app.cmdline.confirmHandler()
+		}
+
+		app.commandLineFocus()
+	})
+
+	app.cmdline.messageDialog.SetCancelFunc(func() {
+		app.cmdline.messageDialog.Hide()
+		app.commandLineFocus()
+	})
+
+	app.cmdline.errorDialog.SetDoneFunc(func() {
+		app.cmdline.errorDialog.Hide()
+		app.commandLineFocus()
+	})
+
+	app.pages.AddPage(commandLinePage, app.cmdline, true, false)
+}
+
+func (app *App) displayCommandLine() {
+	app.cmdline.input.Display()
+	app.pages.ShowPage(commandLinePage)
+	app.SetFocus(app.cmdline)
+}
+
+// commandLineFocus focuses the command line top most dialog or
+// switches back to the current screen if none of them is displayed.
+func (app *App) commandLineFocus() {
+	if app.cmdline.IsDisplay() {
+		app.SetFocus(app.cmdline)
+
+		return
+	}
+
+	app.pages.HidePage(commandLinePage)
+	app.setPageFocus(app.currentPage)
+}
+
+func (app *App) commandLineError(title string, err error) {
+	log.Error().Msgf("app: %s: %v", strings.ToLower(title), err)
+	app.cmdline.errorDialog.SetTitle(title)
+	app.cmdline.errorDialog.SetText(fmt.Sprintf("%v", err))
+	app.cmdline.errorDialog.Display()
+}
+
+// executeCommandLine parses the command line, replaces the selected item placeholder
+// with the current screen selected item and runs the command after confirmation if required.
+func (app *App) executeCommandLine(text string) {
+	log.Debug().Msgf("app: command line %q", text)
+
+	app.cmdline.input.Hide()
+
+	defer app.commandLineFocus()
+
+	words, err := utils.SplitCommandLine(text)
+	if err != nil {
+		app.commandLineError(cmdlineErrorTitle, err)
+
+		return
+	}
+
+	for i := range words {
+		if !strings.Contains(words[i], cmdlineSelectedItem) {
+			continue
+		}
+
+		selectedID := app.selectedItemID(app.currentPage)
+		if selectedID == "" {
+			app.commandLineError(cmdlineErrorTitle, errCmdlineNoSelectedItem)
+
+			return
+		}
+
+		words[i] = strings.ReplaceAll(words[i], cmdlineSelectedItem, selectedID)
+	}
+
+	cmd, args, force, err := parseCommandLine(words)
+	if err != nil {
+		app.commandLineError(cmdlineErrorTitle, err)
+
+		return
+	}
+
+	if !cmd.confirm {
+		app.runCommandLine(cmd, args, force)
+
+		return
+	}
+
+	app.cmdline.confirmHandler = func() {
+		app.runCommandLine(cmd, args, force)
+	}
+
+	bgColor := style.GetColorHex(style.DialogBorderColor)
+	fgColor := style.GetColorHex(style.DialogFgColor)
+	targets := fmt.Sprintf("[%s:%s:b]%sS:[:-:-] %s",
+		fgColor, bgColor, strings.ToUpper(cmd.kind), tview.Escape(strings.Join(args, ", ")))
+
+	podmanCmd := fmt.Sprintf("podman %s %s", cmd.kind, cmd.verb)
+	if force {
+		podmanCmd += " " + cmdlineForceFlag
+	}
+
+	description := fmt.Sprintf("%s\n\nAre you sure you want to run %q?", targets, podmanCmd)
+
+	app.cmdline.confirmDialog.SetTitle(podmanCmd)
+	app.cmdline.confirmDialog.SetText(description)
+	app.cmdline.confirmDialog.Display()
+}
+
+// runCommandLine runs the command in background, refreshes the command resource
+// and the current screens data and displays the command output or error.
+func (app *App) runCommandLine(cmd *cmdlineCommand, args []string, force bool) {
+	app.cmdline.progressDialog.SetTitle(fmt.Sprintf("%s %s in progress", cmd.kind, cmd.verb))
+	app.cmdline.progressDialog.Display()
+
+	currentPage := app.currentPage
+
+	run := func() {
+		output, err := cmd.run(args, force)
+
+		// multiple targets commands may partially fail, the data is refreshed in both cases
+		app.updatePageDataFromEvent(cmd.kind)
+		app.updatePageData(currentPage)
+
+		app.QueueUpdateDraw(func() {
+			app.cmdline.progressDialog.Hide()
+
+			defer app.commandLineFocus()
+
+			if err != nil {
+				title := fmt.Sprintf("PODMAN %s %s ERROR", strings.ToUpper(cmd.kind), strings.ToUpper(cmd.verb))
+
+				app.commandLineError(title, err)
+
+				return
+			}
+
+			if output == "" {
+				return
+			}
+
+			app.cmdline.setMessage(cmd, args, output)
+
+			if cmd.fullSize {
+				app.cmdline.messageDialog.DisplayFullSize()
+
+				return
+			}
+
+			app.cmdline.messageDialog.Display()
+		})
+	}
+
+	go run()
+}
+
+// completeCommandLine returns the completion candidates of the last command line word.
+func (app *App) completeCommandLine(words []string) []string {
+	switch len(words) {
+	case 1:
+		candidates := slices.Clone(cmdlineKinds)
+		for alias := range cmdlineAliases {
+			candidates = append(candidates, alias)
+		}
+
+		candidates = append(candidates, cmdlineVerbs(putils.ResourceKindContainer)...)
+		slices.Sort(candidates)
+
+		return slices.Compact(candidates)
+	case 2: //nolint:mnd
+		if slices.Contains(cmdlineKinds, words[0]) {
+			return cmdlineVerbs(words[0])
+		}
+	}
+
+	cmd, rest, err := lookupCmdlineCommand(words[:len(words)-1])
+	if err != nil {
+		return nil
+	}
+
+	argIndex := 0
+
+	for _, word := range rest {
+		if !strings.HasPrefix(word, "-") {
+			argIndex++
+		}
+	}
+
+	if cmd.maxArgs > 0 && argIndex >= cmd.maxArgs {
+		return nil
+	}
+
+	kind := cmd.argKinds[min(argIndex, len(cmd.argKinds)-1)]
+	if kind == "" {
+		return nil
+	}
+
+	candidates := []string{cmdlineSelectedItem}
+	if cmd.force {
+		candidates = append(candidates, cmdlineForceFlag)
+	}
+
+	return append(candidates, app.cmdlineResourceNames(kind)...)
+}
+
+// cmdlineResourceNames returns the names of the resources listed on the screens.
+func (app *App) cmdlineResourceNames(kind string) []string {
+	connStatus, _ := app.health.ConnStatus()
+	if connStatus != registry.ConnectionStatusConnected {
+		return nil
+	}
+
+	names := make([]string, 0)
+
+	for _, screen := range app.paletteScreens() {
+		for _, resource := range screen.GetResources() {
+			if resource.Kind != kind {
+				continue
+			}
+
+			if resource.Name == "" || strings.Contains(resource.Name, "<none>") {
+				names = append(names, utils.GetIDWithLimit(resource.ID))
+
+				continue
+			}
+
+			names = append(names, strings.Split(resource.Name, ",")...)
+		}
+	}
+
+	slices.Sort(names)
+
+	return slices.Compact(names)
+}
+
+// setMessage sets the message dialog title, resource header and the command output.
+func (cl *commandLine) setMessage(cmd *cmdlineCommand, args []string, output string) {
+	headerType := dialogs.MessageContainerInfo
+
+	switch cmd.kind {
+	case putils.ResourceKindPod:
+		headerType = dialogs.MessagePodInfo
+	case putils.ResourceKindImage:
+		headerType = dialogs.MessageImageInfo
+	case putils.ResourceKindVolume:
+		headerType = dialogs.MessageVolumeInfo
+	case putils.ResourceKindNetwork:
+		headerType = dialogs.MessageNetworkInfo
+	case putils.ResourceKindSecret:
+		headerType = dialogs.MessageSecretInfo
+	}
+
+	cl.messageDialog.SetTitle(fmt.Sprintf("podman %s %s", cmd.kind, cmd.verb))
+	cl.messageDialog.SetText(headerType, strings.Join(args, ", "), tview.Escape(output))
+}
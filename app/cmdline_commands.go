@@ -0,0 +1,342 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/containers/podman-tui/pdcs/images"
+	"github.com/containers/podman-tui/pdcs/networks"
+	"github.com/containers/podman-tui/pdcs/pods"
+	"github.com/containers/podman-tui/pdcs/secrets"
+	putils "github.com/containers/podman-tui/pdcs/utils"
+	"github.com/containers/podman-tui/pdcs/volumes"
+)
+
+const (
+	cmdlineForceFlag     = "-f"
+	cmdlineForceLongFlag = "--force"
+)
+
+var (
+	errCmdlineUnknownCommand = errors.New("unknown command")
+	errCmdlineUnknownFlag    = errors.New("unknown flag")
+	errCmdlineUsage          = errors.New("usage")
+)
+
+// cmdlineRunFunc runs the command line command with its arguments.
+type cmdlineRunFunc func(args []string, force bool) (string, error)
+
+// cmdlineCommand implements a podman command of the command line.
+type cmdlineCommand struct {
+	kind  string
+	verb  string
+	usage string
+	// argKinds are the resource kinds of the arguments used for completion,
+	// the last one is used for the rest of the arguments.
+	argKinds []string
+	minArgs  int
+	// maxArgs is the max number of arguments, zero for no limit.
+	maxArgs  int
+	force    bool
+	confirm  bool
+	fullSize bool
+	run      cmdlineRunFunc
+}
+
+// cmdlineAliases are the podman top level commands of the resources other than container.
+var cmdlineAliases = map[string][]string{
+	"pull":  {putils.ResourceKindImage, "pull"},
+	"rmi":   {putils.ResourceKindImage, "rm"},
+	"tag":   {putils.ResourceKindImage, "tag"},
+	"untag": {putils.ResourceKindImage, "untag"},
+}
+
+var cmdlineKinds = []string{
+	putils.ResourceKindContainer,
+	putils.ResourceKindPod,
+	putils.ResourceKindImage,
+	putils.ResourceKindVolume,
+	putils.ResourceKindNetwork,
+	putils.ResourceKindSecret,
+}
+
+func cmdlineCommands() []cmdlineCommand { //nolint:funlen
+	container := putils.ResourceKindContainer
+	pod := putils.ResourceKindPod
+	image := putils.ResourceKindImage
+	volume := putils.ResourceKindVolume
+	network := putils.ResourceKindNetwork
+	secret := putils.ResourceKindSecret
+
+	return []cmdlineCommand{
+		// containers
+		newCmdlineTargetsCommand(container, "start", cmdlineEach(containers.Start)),
+		newCmdlineTargetsCommand(container, "stop", cmdlineEach(containers.Stop)),
+		newCmdlineTargetsCommand(container, "restart", cmdlineEach(containers.Restart)),
+		newCmdlineTargetsCommand(container, "pause", cmdlineEach(containers.Pause)),
+		newCmdlineTargetsCommand(container, "unpause", cmdlineEach(containers.Unpause)),
+		newCmdlineConfirmCommand(container, "kill", false, cmdlineEach(containers.Kill)),
+		newCmdlineConfirmCommand(container, "rm", true, cmdlineRemove(containers.Stop, containers.Remove)),
+		newCmdlineOutputCommand(container, "logs", cmdlineLogs),
+		newCmdlineOutputCommand(container, "inspect", cmdlineFirst(containers.Inspect)),
+		// pods
+		newCmdlineTargetsCommand(pod, "start", cmdlineEach(pods.Start)),
+		newCmdlineTargetsCommand(pod, "stop", cmdlineEach(pods.Stop)),
+		newCmdlineTargetsCommand(pod, "restart", cmdlineEach(pods.Restart)),
+		newCmdlineTargetsCommand(pod, "pause", cmdlineEach(pods.Pause)),
+		newCmdlineTargetsCommand(pod, "unpause", cmdlineEach(pods.Unpause)),
+		newCmdlineConfirmCommand(pod, "kill", false, cmdlineEach(pods.Kill)),
+		newCmdlineConfirmCommand(pod, "rm", true, cmdlineRemove(pods.Stop, pods.Remove)),
+		newCmdlineOutputCommand(pod, "inspect", cmdlineFirst(pods.Inspect)),
+		// images
+		{
+			kind:     image,
+			verb:     "pull",
+			usage:    "image pull NAME [NAME...]",
+			argKinds: []string{image},
+			minArgs:  1,
+			run:      cmdlineEach(images.Pull),
+		},
+		{
+			kind:     image,
+			verb:     "tag",
+			usage:    "image tag IMAGE TARGET_NAME",
+			argKinds: []string{image, ""},
+			minArgs:  2, //nolint:mnd
+			maxArgs:  2, //nolint:mnd
+			run: func(args []string, _ bool) (string, error) {
+				return "", images.Tag(args[0], args[1])
+			},
+		},
+		newCmdlineTargetsCommand(image, "untag", cmdlineEach(images.Untag)),
+		newCmdlineConfirmCommand(image, "rm", false, cmdlineImageRemove),
+		newCmdlineOutputCommand(image, "inspect", cmdlineFirst(images.Inspect)),
+		// volumes
+		newCmdlineConfirmCommand(volume, "rm", false, cmdlineEach(volumes.Remove)),
+		newCmdlineOutputCommand(volume, "inspect", cmdlineFirst(volumes.Inspect)),
+		// networks
+		{
+			kind:     network,
+			verb:     "connect",
+			usage:    "network connect NETWORK CONTAINER",
+			argKinds: []string{network, container},
+			minArgs:  2, //nolint:mnd
+			maxArgs:  2, //nolint:mnd
+			run: func(args []string, _ bool) (string, error) {
+				return "", networks.Connect(networks.NetworkConnect{Network: args[0], Container: args[1]})
+			},
+		},
+		{
+			kind:     network,
+			verb:     "disconnect",
+			usage:    "network disconnect NETWORK CONTAINER",
+			argKinds: []string{network, container},
+			minArgs:  2, //nolint:mnd
+			maxArgs:  2, //nolint:mnd
+			run: func(args []string, _ bool) (string, error) {
+				return "", networks.Disconnect(args[0], args[1])
+			},
+		},
+		newCmdlineConfirmCommand(network, "rm", false, cmdlineEach(networks.Remove)),
+		newCmdlineOutputCommand(network, "inspect", cmdlineFirst(networks.Inspect)),
+		// secrets
+		newCmdlineConfirmCommand(secret, "rm", false, cmdlineEach(secrets.Remove)),
+		newCmdlineOutputCommand(secret, "inspect", cmdlineFirst(func(id string) (string, error) {
+			return secrets.Inspect(id, false)
+		})),
+	}
+}
+
+// newCmdlineTargetsCommand returns a command which is run on one or more resources.
+func newCmdlineTargetsCommand(kind string, verb string, run cmdlineRunFunc) cmdlineCommand {
+	return cmdlineCommand{
+		kind:     kind,
+		verb:     verb,
+		usage:    fmt.Sprintf("%s %s %s [%s...]", kind, verb, strings.ToUpper(kind), strings.ToUpper(kind)),
+		argKinds: []string{kind},
+		minArgs:  1,
+		run:      run,
+	}
+}
+
+// newCmdlineConfirmCommand returns a command which is confirmed before it's run on the resources.
+func newCmdlineConfirmCommand(kind string, verb string, force bool, run cmdlineRunFunc) cmdlineCommand {
+	cmd := newCmdlineTargetsCommand(kind, verb, run)
+	cmd.confirm = true
+	cmd.force = force
+
+	if force {
+		cmd.usage = fmt.Sprintf("%s %s [%s] %s [%s...]",
+			kind, verb, cmdlineForceFlag, strings.ToUpper(kind), strings.ToUpper(kind))
+	}
+
+	return cmd
+}
+
+// newCmdlineOutputCommand returns a command which output of a single resource is displayed in full size.
+func newCmdlineOutputCommand(kind string, verb string, run cmdlineRunFunc) cmdlineCommand {
+	return cmdlineCommand{
+		kind:     kind,
+		verb:     verb,
+		usage:    fmt.Sprintf("%s %s %s", kind, verb, strings.ToUpper(kind)),
+		argKinds: []string{kind},
+		minArgs:  1,
+		maxArgs:  1,
+		fullSize: true,
+		run:      run,
+	}
+}
+
+// cmdlineEach runs the function on every argument and reports the arguments like podman does.
+func cmdlineEach(fn func(id string) error) cmdlineRunFunc {
+	return func(args []string, _ bool) (string, error) {
+		report := make([]string, 0, len(args))
+
+		for _, arg := range args {
+			if err := fn(arg); err != nil {
+				return "", err
+			}
+
+			report = append(report, arg)
+		}
+
+		return strings.Join(report, "\n"), nil
+	}
+}
+
+// cmdlineFirst runs the output function on the first argument.
+func cmdlineFirst(fn func(id string) (string, error)) cmdlineRunFunc {
+	return func(args []string, _ bool) (string, error) {
+		return fn(args[0])
+	}
+}
+
+// cmdlineRemove removes the resources, running resources are stopped first if force is set.
+func cmdlineRemove(stop func(id string) error, remove func(id string) ([]string, error)) cmdlineRunFunc {
+	return func(args []string, force bool) (string, error) {
+		report := make([]string, 0, len(args))
+
+		for _, arg := range args {
+			if force {
+				if err := stop(arg); err != nil {
+					return "", err
+				}
+			}
+
+			errData, err := remove(arg)
+			if err != nil {
+				return "", err
+			}
+
+			if len(errData) > 0 {
+				return "", fmt.Errorf("%s", strings.Join(errData, "\n")) //nolint:err113
+			}
+
+			report = append(report, arg)
+		}
+
+		return strings.Join(report, "\n"), nil
+	}
+}
+
+func cmdlineImageRemove(args []string, _ bool) (string, error) {
+	report := make([]string, 0, len(args))
+
+	for _, arg := range args {
+		imgReport, err := images.Remove(arg)
+		if err != nil {
+			return "", err
+		}
+
+		report = append(report, imgReport...)
+	}
+
+	return strings.Join(report, "\n"), nil
+}
+
+func cmdlineLogs(args []string, _ bool) (string, error) {
+	logs, err := containers.Logs(args[0])
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join(logs, "\n"), nil
+}
+
+// parseCommandLine returns the command of the command line words, its arguments and force flag.
+// The resource kind can be omitted for the container commands and the podman top level aliases.
+func parseCommandLine(words []string) (*cmdlineCommand, []string, bool, error) {
+	cmd, rest, err := lookupCmdlineCommand(words)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	args := make([]string, 0, len(rest))
+	force := false
+
+	for _, word := range rest {
+		switch {
+		case cmd.force && (word == cmdlineForceFlag || word == cmdlineForceLongFlag):
+			force = true
+		case strings.HasPrefix(word, "-") && len(word) > 1:
+			return nil, nil, false, fmt.Errorf("%w %q for %s %s", errCmdlineUnknownFlag, word, cmd.kind, cmd.verb)
+		default:
+			args = append(args, word)
+		}
+	}
+
+	if len(args) < cmd.minArgs || (cmd.maxArgs > 0 && len(args) > cmd.maxArgs) {
+		return nil, nil, false, fmt.Errorf("%w: %s", errCmdlineUsage, cmd.usage)
+	}
+
+	return cmd, args, force, nil
+}
+
+// lookupCmdlineCommand returns the command of the command line words and the rest of the words.
+func lookupCmdlineCommand(words []string) (*cmdlineCommand, []string, error) {
+	if len(words) == 0 {
+		return nil, nil, errCmdlineUnknownCommand
+	}
+
+	kind := putils.ResourceKindContainer
+	verb := words[0]
+	rest := words[1:]
+
+	switch {
+	case slices.Contains(cmdlineKinds, words[0]):
+		if len(words) < 2 { //nolint:mnd
+			return nil, nil, fmt.Errorf("%w %q", errCmdlineUnknownCommand, words[0])
+		}
+
+		kind = words[0]
+		verb = words[1]
+		rest = words[2:]
+	case cmdlineAliases[words[0]] != nil:
+		kind = cmdlineAliases[words[0]][0]
+		verb = cmdlineAliases[words[0]][1]
+	}
+
+	for _, cmd := range cmdlineCommands() {
+		if cmd.kind == kind && cmd.verb == verb {
+			return &cmd, rest, nil
+		}
+	}
+
+	return nil, nil, fmt.Errorf("%w %q", errCmdlineUnknownCommand, strings.Join(words[:len(words)-len(rest)], " "))
+}
+
+// cmdlineVerbs returns the command line verbs of the resource kind.
+func cmdlineVerbs(kind string) []string {
+	verbs := make([]string, 0)
+
+	for _, cmd := range cmdlineCommands() {
+		if cmd.kind == kind {
+			verbs = append(verbs, cmd.verb)
+		}
+	}
+
+	return verbs
+}
@@ -0,0 +1,123 @@
+package app
+
+import (
+	putils "github.com/containers/podman-tui/pdcs/utils"
+	"github.com/rs/zerolog/log"
+)
+
+// navigateHistoryMaxSize maximum number of locations kept in navigation history.
+const navigateHistoryMaxSize = 50
+
+type navigateLocation struct {
+	page string
+	id   string
+}
+
+// navigateTo switches to the screen of the specified resource kind and selects the resource.
+// The current screen and its selected item are pushed to navigation history.
+func (app *App) navigateTo(kind string, id string) {
+	page := app.resourcePage(kind)
+	if page == "" {
+		log.Warn().Msgf("app: navigate to unknown resource kind %q", kind)
+
+		return
+	}
+
+	log.Debug().Msgf("app: navigate to %s %s", kind, id)
+
+	// the screen input handler sets its own focus after the handler returns,
+	// switching to the new screen shall happen afterward
+	app.QueueUpdateDraw(func() {
+		app.pushNavigateHistory()
+		app.switchToScreen(page)
+		app.selectItem(page, id)
+	})
+}
+
+// pushNavigateHistory pushes the current screen and its selected item to navigation history.
+func (app *App) pushNavigateHistory() {
+	app.navigateHistory = append(app.navigateHistory, navigateLocation{
+		page: app.currentPage,
+		id:   app.selectedItemID(app.currentPage),
+	})
+
+	if len(app.navigateHistory) > navigateHistoryMaxSize {
+		app.navigateHistory = app.navigateHistory[1:]
+	}
+}
+
+// navigateBack switches back to the previous navigation location.
+func (app *App) navigateBack() {
+	if len(app.navigateHistory) == 0 {
+		return
+	}
+
+	location := app.navigateHistory[len(app.navigateHistory)-1]
+	app.navigateHistory = app.navigateHistory[:len(app.navigateHistory)-1]
+
+	log.Debug().Msgf("app: navigate back to %s %s", location.page, location.id)
+
+	app.switchToScreen(location.page)
+	app.selectItem(location.page, location.id)
+}
+
+func (app *App) resourcePage(kind string) string {
+	switch kind {
+	case putils.ResourceKindContainer:
+		return app.containers.GetTitle()
+	case putils.ResourceKindPod:
+		return app.pods.GetTitle()
+	case putils.ResourceKindImage:
+		return app.images.GetTitle()
+	case putils.ResourceKindVolume:
+		return app.volumes.GetTitle()
+	case putils.ResourceKindNetwork:
+		return app.networks.GetTitle()
+	case putils.ResourceKindSecret:
+		return app.secrets.GetTitle()
+	}
+
+	return ""
+}
+
+func (app *App) selectedItemID(page string) string {
+	switch page {
+	case app.pods.GetTitle():
+		return app.pods.GetSelectedItemID()
+	case app.containers.GetTitle():
+		return app.containers.GetSelectedItemID()
+	case app.networks.GetTitle():
+		return app.networks.GetSelectedItemID()
+	case app.images.GetTitle():
+		return app.images.GetSelectedItemID()
+	case app.volumes.GetTitle():
+		return app.volumes.GetSelectedItemID()
+	case app.secrets.GetTitle():
+		return app.secrets.GetSelectedItemID()
+	}
+
+	return ""
+}
+
+func (app *App) selectItem(page string, id string) {
+	if id == "" {
+		return
+	}
+
+	switch page {
+	case app.system.GetTitle():
+		app.system.SelectItem(id)
+	case app.pods.GetTitle():
+		app.pods.SelectItem(id)
+	case app.containers.GetTitle():
+		app.containers.SelectItem(id)
+	case app.networks.GetTitle():
+		app.networks.SelectItem(id)
+	case app.images.GetTitle():
+		app.images.SelectItem(id)
+	case app.volumes.GetTitle():
+		app.volumes.SelectItem(id)
+	case app.secrets.GetTitle():
+		app.secrets.SelectItem(id)
+	}
+}
@@ -0,0 +1,125 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	putils "github.com/containers/podman-tui/pdcs/utils"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/rs/zerolog/log"
+)
+
+const commandPalettePage = "command palette"
+
+// paletteScreen is a screen which commands and resources are listed in the command palette.
+type paletteScreen interface {
+	GetTitle() string
+	GetCommands() [][]string
+	GetResources() []putils.ResourceReference
+	SelectItem(id string)
+	RunCommand(cmd string)
+}
+
+func (app *App) paletteScreens() []paletteScreen {
+	// only the system screen commands are available if not connected
+	connStatus, _ := app.health.ConnStatus()
+	if connStatus != registry.ConnectionStatusConnected {
+		return []paletteScreen{app.system}
+	}
+
+	return []paletteScreen{
+		app.system,
+		app.pods,
+		app.containers,
+		app.volumes,
+		app.images,
+		app.networks,
+		app.secrets,
+	}
+}
+
+// paletteItems returns the commands of every screen, the screens resources and
+// the commands applied to each of the screen resources.
+func (app *App) paletteItems() []dialogs.PaletteItem {
+	items := make([]dialogs.PaletteItem, 0)
+
+	for _, screen := range app.paletteScreens() {
+		commands := screen.GetCommands()
+
+		for _, cmd := range commands {
+			items = append(items, dialogs.PaletteItem{
+				Screen:      screen.GetTitle(),
+				Command:     cmd[0],
+				Description: cmd[1],
+			})
+		}
+
+		for _, resource := range screen.GetResources() {
+			items = append(items, dialogs.PaletteItem{
+				Screen:      screen.GetTitle(),
+				Description: fmt.Sprintf("switch to %s screen and select the %s", screen.GetTitle(), resource.Kind),
+				Target:      resource,
+			})
+
+			for _, cmd := range commands {
+				items = append(items, dialogs.PaletteItem{
+					Screen:      screen.GetTitle(),
+					Command:     cmd[0],
+					Description: cmd[1],
+					Target:      resource,
+				})
+			}
+		}
+	}
+
+	return items
+}
+
+func (app *App) displayCommandPalette() {
+	app.palette.SetItems(app.paletteItems())
+	app.palette.Display()
+	app.pages.ShowPage(commandPalettePage)
+	app.SetFocus(app.palette)
+}
+
+func (app *App) hideCommandPalette() {
+	app.palette.Hide()
+	app.pages.HidePage(commandPalettePage)
+	app.setPageFocus(app.currentPage)
+}
+
+// runPaletteItem switches to the screen of the selected palette item,
+// selects its target resource and runs the command.
+func (app *App) runPaletteItem() {
+	item, ok := app.palette.GetSelectedItem()
+
+	app.hideCommandPalette()
+
+	if !ok {
+		return
+	}
+
+	log.Debug().Msgf("app: command palette %q %q on %s screen", item.Command, item.Target.Target(), item.Screen)
+
+	for _, screen := range app.paletteScreens() {
+		if screen.GetTitle() != item.Screen {
+			continue
+		}
+
+		if item.Screen != app.currentPage {
+			app.pushNavigateHistory()
+			app.switchToScreen(item.Screen)
+		}
+
+		if target := item.Target.Target(); target != "" {
+			screen.SelectItem(target)
+		}
+
+		if item.Command != "" {
+			screen.RunCommand(item.Command)
+			app.setPageFocus(item.Screen)
+		}
+
+		return
+	}
+}
@@ -0,0 +1,410 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/containers/podman-tui/pdcs/pods"
+	"github.com/containers/podman-tui/pdcs/utils"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// _presetsPath is the path to the podman-tui presets directory
+	// inside user's home directory.
+	_presetsPath          = ".config/podman-tui/presets"
+	_containerPresetsFile = "containers.json"
+	_podPresetsFile       = "pods.json"
+	_presetsDirPerm       = 0o700
+	_presetsFilePerm      = 0o600
+	_presetsVersion       = 1
+)
+
+var (
+	ErrEmptyPresetName          = errors.New("empty preset name")
+	ErrPresetNotFound           = errors.New("preset not found")
+	ErrUnsupportedPresetVersion = errors.New("unsupported presets file version")
+)
+
+// ContainerPreset is a named container create options preset.
+type ContainerPreset struct {
+	Name    string
+	Options containers.CreateOptions
+}
+
+// PodPreset is a named pod create options preset.
+type PodPreset struct {
+	Name    string
+	Options pods.CreateOptions
+}
+
+// presetsFile is the presets file content.
+type presetsFile[T any] struct {
+	Version int         `json:"version"`
+	Presets []preset[T] `json:"presets"`
+}
+
+type preset[T any] struct {
+	Name    string `json:"name"`
+	Options T      `json:"options"`
+}
+
+// containerPresetOptions is the container create options presets file format,
+// its fields must match containers.CreateOptions fields.
+type containerPresetOptions struct {
+	Name                  string   `json:"name,omitempty"`
+	Command               string   `json:"command,omitempty"`
+	CommandArgs           []string `json:"command_args,omitempty"`
+	Entrypoint            string   `json:"entrypoint,omitempty"`
+	Labels                []string `json:"labels,omitempty"`
+	Image                 string   `json:"image,omitempty"`
+	Remove                bool     `json:"remove,omitempty"`
+	Privileged            bool     `json:"privileged,omitempty"`
+	ReadOnly              bool     `json:"read_only,omitempty"`
+	Init                  bool     `json:"init,omitempty"`
+	Restart               string   `json:"restart,omitempty"`
+	CapAdd                []string `json:"cap_add,omitempty"`
+	CapDrop               []string `json:"cap_drop,omitempty"`
+	GroupAdd              []string `json:"group_add,omitempty"`
+	Timeout               string   `json:"timeout,omitempty"`
+	Interactive           bool     `json:"interactive,omitempty"`
+	TTY                   bool     `json:"tty,omitempty"`
+	Detach                bool     `json:"detach,omitempty"`
+	Secret                []string `json:"secret,omitempty"`
+	WorkDir               string   `json:"work_dir,omitempty"`
+	EnvVars               []string `json:"env_vars,omitempty"`
+	EnvFile               []string `json:"env_file,omitempty"`
+	EnvMerge              []string `json:"env_merge,omitempty"`
+	UnsetEnv              []string `json:"unset_env,omitempty"`
+	EnvHost               bool     `json:"env_host,omitempty"`
+	UnsetEnvAll           bool     `json:"unset_env_all,omitempty"`
+	Umask                 string   `json:"umask,omitempty"`
+	User                  string   `json:"user,omitempty"`
+	HostUsers             []string `json:"host_users,omitempty"`
+	GroupEntry            string   `json:"group_entry,omitempty"`
+	PasswdEntry           string   `json:"passwd_entry,omitempty"`
+	Pod                   string   `json:"pod,omitempty"`
+	Hostname              string   `json:"hostname,omitempty"`
+	IPAddress             string   `json:"ip_address,omitempty"`
+	Network               string   `json:"network,omitempty"`
+	Networks              []string `json:"networks,omitempty"`
+	MacAddress            string   `json:"mac_address,omitempty"`
+	Publish               []string `json:"publish,omitempty"`
+	Expose                []string `json:"expose,omitempty"`
+	PublishAll            bool     `json:"publish_all,omitempty"`
+	DNSServer             []string `json:"dns_server,omitempty"`
+	DNSOptions            []string `json:"dns_options,omitempty"`
+	DNSSearchDomain       []string `json:"dns_search_domain,omitempty"`
+	AddHost               []string `json:"add_host,omitempty"`
+	Volume                []string `json:"volume,omitempty"`
+	ImageVolume           string   `json:"image_volume,omitempty"`
+	Mount                 []string `json:"mount,omitempty"`
+	Device                []string `json:"device,omitempty"`
+	Tmpfs                 []string `json:"tmpfs,omitempty"`
+	VolumesFrom           []string `json:"volumes_from,omitempty"`
+	SelinuxOpts           []string `json:"selinux_opts,omitempty"`
+	ApparmorProfile       string   `json:"apparmor_profile,omitempty"`
+	Seccomp               string   `json:"seccomp,omitempty"`
+	SecNoNewPriv          bool     `json:"sec_no_new_priv,omitempty"`
+	SecMask               string   `json:"sec_mask,omitempty"`
+	SecUnmask             string   `json:"sec_unmask,omitempty"`
+	HealthCmd             string   `json:"health_cmd,omitempty"`
+	HealthInterval        string   `json:"health_interval,omitempty"`
+	HealthRetries         string   `json:"health_retries,omitempty"`
+	HealthStartPeroid     string   `json:"health_start_period,omitempty"`
+	HealthTimeout         string   `json:"health_timeout,omitempty"`
+	HealthOnFailure       string   `json:"health_on_failure,omitempty"`
+	HealthStartupCmd      string   `json:"health_startup_cmd,omitempty"`
+	HealthStartupInterval string   `json:"health_startup_interval,omitempty"`
+	HealthStartupRetries  string   `json:"health_startup_retries,omitempty"`
+	HealthStartupSuccess  string   `json:"health_startup_success,omitempty"`
+	HealthStartupTimeout  string   `json:"health_startup_timeout,omitempty"`
+	HealthLogDestination  string   `json:"health_log_destination,omitempty"`
+	HealthMaxLogSize      string   `json:"health_max_log_size,omitempty"`
+	HealthMaxLogCount     string   `json:"health_max_log_count,omitempty"`
+	Memory                string   `json:"memory,omitempty"`
+	MemoryReservation     string   `json:"memory_reservation,omitempty"`
+	MemorySwap            string   `json:"memory_swap,omitempty"`
+	MemorySwappiness      string   `json:"memory_swappiness,omitempty"`
+	CPUs                  string   `json:"cpus,omitempty"`
+	CPUShares             string   `json:"cpu_shares,omitempty"`
+	CPUPeriod             string   `json:"cpu_period,omitempty"`
+	CPUQuota              string   `json:"cpu_quota,omitempty"`
+	CPURtPeriod           string   `json:"cpu_rt_period,omitempty"`
+	CPURtRuntime          string   `json:"cpu_rt_runtime,omitempty"`
+	CPUSetCPUs            string   `json:"cpuset_cpus,omitempty"`
+	CPUSetMems            string   `json:"cpuset_mems,omitempty"`
+	SHMSize               string   `json:"shm_size,omitempty"`
+	SHMSizeSystemd        string   `json:"shm_size_systemd,omitempty"`
+	NamespaceCgroup       string   `json:"namespace_cgroup,omitempty"`
+	NamespacePid          string   `json:"namespace_pid,omitempty"`
+	NamespaceIpc          string   `json:"namespace_ipc,omitempty"`
+	NamespaceUser         string   `json:"namespace_user,omitempty"`
+	NamespaceUts          string   `json:"namespace_uts,omitempty"`
+	NamespaceUidmap       string   `json:"namespace_uidmap,omitempty"`
+	NamespaceSubuidName   string   `json:"namespace_subuid_name,omitempty"`
+	NamespaceGidmap       string   `json:"namespace_gidmap,omitempty"`
+	NamespaceSubgidName   string   `json:"namespace_subgid_name,omitempty"`
+}
+
+// podPresetOptions is the pod create options presets file format,
+// its fields must match pods.CreateOptions fields.
+type podPresetOptions struct {
+	Name                string            `json:"name,omitempty"`
+	NoHost              bool              `json:"no_host,omitempty"`
+	Labels              map[string]string `json:"labels,omitempty"`
+	DNSServer           []string          `json:"dns_server,omitempty"`
+	DNSOptions          []string          `json:"dns_options,omitempty"`
+	DNSSearchDomain     []string          `json:"dns_search_domain,omitempty"`
+	Infra               bool              `json:"infra,omitempty"`
+	InfraCommand        string            `json:"infra_command,omitempty"`
+	InfraImage          string            `json:"infra_image,omitempty"`
+	Hostname            string            `json:"hostname,omitempty"`
+	IPAddress           string            `json:"ip_address,omitempty"`
+	MacAddress          string            `json:"mac_address,omitempty"`
+	AddHost             []string          `json:"add_host,omitempty"`
+	Network             string            `json:"network,omitempty"`
+	Publish             []string          `json:"publish,omitempty"`
+	SecurityOpts        []string          `json:"security_opts,omitempty"`
+	Memory              string            `json:"memory,omitempty"`
+	MemorySwap          string            `json:"memory_swap,omitempty"`
+	CPUs                string            `json:"cpus,omitempty"`
+	CPUShares           string            `json:"cpu_shares,omitempty"`
+	CPUSetCPUs          string            `json:"cpuset_cpus,omitempty"`
+	CPUSetMems          string            `json:"cpuset_mems,omitempty"`
+	ShmSize             string            `json:"shm_size,omitempty"`
+	ShmSizeSystemd      string            `json:"shm_size_systemd,omitempty"`
+	NamespaceShare      []string          `json:"namespace_share,omitempty"`
+	NamespacePid        string            `json:"namespace_pid,omitempty"`
+	NamespaceUser       string            `json:"namespace_user,omitempty"`
+	NamespaceUts        string            `json:"namespace_uts,omitempty"`
+	NamespaceUidmap     string            `json:"namespace_uidmap,omitempty"`
+	NamespaceSubuidName string            `json:"namespace_subuid_name,omitempty"`
+	NamespaceGidmap     string            `json:"namespace_gidmap,omitempty"`
+	NamespaceSubgidName string            `json:"namespace_subgid_name,omitempty"`
+}
+
+// ContainerPresets returns saved container create presets.
+// The environment variables values are not saved, they are returned as bare names
+// which are set from the host environment on create (not overriding the image defaults otherwise).
+func ContainerPresets() ([]ContainerPreset, error) {
+	presets, err := loadPresets[containerPresetOptions](_containerPresetsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	cntPresets := make([]ContainerPreset, 0, len(presets))
+
+	for _, p := range presets {
+		cntPresets = append(cntPresets, ContainerPreset{Name: p.Name, Options: containers.CreateOptions(p.Options)})
+	}
+
+	return cntPresets, nil
+}
+
+// SaveContainerPreset saves (or overwrites existing) container create preset.
+// Only the environment variables names are saved as their values may contain secrets.
+func SaveContainerPreset(name string, opts containers.CreateOptions) error {
+	presetOpts := containerPresetOptions(opts)
+	presetOpts.EnvVars = make([]string, 0, len(opts.EnvVars))
+
+	for _, env := range opts.EnvVars {
+		envName, _, _ := strings.Cut(env, "=")
+		presetOpts.EnvVars = append(presetOpts.EnvVars, envName)
+	}
+
+	return savePreset(_containerPresetsFile, name, presetOpts)
+}
+
+// RemoveContainerPreset removes the container create preset.
+func RemoveContainerPreset(name string) error {
+	return removePreset[containerPresetOptions](_containerPresetsFile, name)
+}
+
+// PodPresets returns saved pod create presets.
+func PodPresets() ([]PodPreset, error) {
+	presets, err := loadPresets[podPresetOptions](_podPresetsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	podPresets := make([]PodPreset, 0, len(presets))
+
+	for _, p := range presets {
+		podPresets = append(podPresets, PodPreset{Name: p.Name, Options: pods.CreateOptions(p.Options)})
+	}
+
+	return podPresets, nil
+}
+
+// SavePodPreset saves (or overwrites existing) pod create preset.
+func SavePodPreset(name string, opts pods.CreateOptions) error {
+	return savePreset(_podPresetsFile, name, podPresetOptions(opts))
+}
+
+// RemovePodPreset removes the pod create preset.
+func RemovePodPreset(name string) error {
+	return removePreset[podPresetOptions](_podPresetsFile, name)
+}
+
+func presetsFilePath(file string) (string, error) {
+	home, err := utils.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, _presetsPath, file), nil
+}
+
+func loadPresets[T any](file string) ([]preset[T], error) {
+	var presets presetsFile[T]
+
+	path, err := presetsFilePath(file)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug().Msgf("config: loading presets from %s", path)
+
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return presets.Presets, nil
+		}
+
+		return nil, err
+	}
+
+	// the presets file has no version before version 1
+	if data = bytes.TrimSpace(data); len(data) > 0 && data[0] == '[' {
+		legacyPresets, err := migrateLegacyPresets[T](data)
+		if err != nil {
+			return nil, fmt.Errorf("%w failed to parse presets file %q", err, path)
+		}
+
+		return legacyPresets, nil
+	}
+
+	if err := json.Unmarshal(data, &presets); err != nil {
+		return nil, fmt.Errorf("%w failed to parse presets file %q", err, path)
+	}
+
+	if presets.Version != _presetsVersion {
+		return nil, fmt.Errorf("%w %d: %q", ErrUnsupportedPresetVersion, presets.Version, path)
+	}
+
+	return presets.Presets, nil
+}
+
+// migrateLegacyPresets converts unversioned presets file content.
+// The unversioned file options are keyed by the create options field names,
+// they are renamed to the presets file format json names.
+func migrateLegacyPresets[T any](data []byte) ([]preset[T], error) {
+	var legacyPresets []preset[map[string]json.RawMessage]
+
+	if err := json.Unmarshal(data, &legacyPresets); err != nil {
+		return nil, err
+	}
+
+	optsType := reflect.TypeFor[T]()
+	presets := make([]preset[T], 0, len(legacyPresets))
+
+	for _, legacyPreset := range legacyPresets {
+		fields := make(map[string]json.RawMessage)
+
+		for i := range optsType.NumField() {
+			field := optsType.Field(i)
+			jsonName, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+			if value, ok := legacyPreset.Options[field.Name]; ok {
+				fields[jsonName] = value
+			}
+		}
+
+		optsData, err := json.Marshal(fields)
+		if err != nil {
+			return nil, err
+		}
+
+		var opts T
+
+		if err := json.Unmarshal(optsData, &opts); err != nil {
+			return nil, err
+		}
+
+		presets = append(presets, preset[T]{Name: legacyPreset.Name, Options: opts})
+	}
+
+	return presets, nil
+}
+
+func writePresets[T any](file string, presets []preset[T]) error {
+	path, err := presetsFilePath(file)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), _presetsDirPerm); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(presetsFile[T]{Version: _presetsVersion, Presets: presets}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first to not leave a broken presets file behind
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, _presetsFilePerm); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+func savePreset[T any](file string, name string, opts T) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrEmptyPresetName
+	}
+
+	presets, err := loadPresets[T](file)
+	if err != nil {
+		return err
+	}
+
+	newPreset := preset[T]{Name: name, Options: opts}
+
+	index := slices.IndexFunc(presets, func(p preset[T]) bool { return p.Name == name })
+	if index >= 0 {
+		presets[index] = newPreset
+	} else {
+		presets = append(presets, newPreset)
+	}
+
+	slices.SortFunc(presets, func(a, b preset[T]) int { return strings.Compare(a.Name, b.Name) })
+
+	return writePresets(file, presets)
+}
+
+func removePreset[T any](file string, name string) error {
+	presets, err := loadPresets[T](file)
+	if err != nil {
+		return err
+	}
+
+	index := slices.IndexFunc(presets, func(p preset[T]) bool { return p.Name == name })
+	if index < 0 {
+		return fmt.Errorf("%w: %q", ErrPresetNotFound, name)
+	}
+
+	return writePresets(file, slices.Delete(presets, index, index+1))
+}
@@ -0,0 +1,112 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/containers/podman-tui/pdcs/pods"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("presets", func() {
+	var presetsDir string
+
+	writePresetsFile := func(file string, content string) {
+		Expect(os.MkdirAll(presetsDir, _presetsDirPerm)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(presetsDir, file), []byte(content), _presetsFilePerm)).To(Succeed())
+	}
+
+	readPresetsFile := func(file string) presetsFile[json.RawMessage] {
+		var presets presetsFile[json.RawMessage]
+
+		data, err := os.ReadFile(filepath.Join(presetsDir, file))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(json.Unmarshal(data, &presets)).To(Succeed())
+
+		return presets
+	}
+
+	BeforeEach(func() {
+		home := GinkgoT().TempDir()
+		GinkgoT().Setenv("HOME", home)
+
+		presetsDir = filepath.Join(home, _presetsPath)
+	})
+
+	It("no presets file", func() {
+		presets, err := ContainerPresets()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(presets).To(BeEmpty())
+	})
+
+	It("environment variables round trip", func() {
+		err := SaveContainerPreset("web", containers.CreateOptions{
+			Image:   "docker.io/library/nginx:latest",
+			EnvVars: []string{"DB_PASSWORD=secret", "DEBUG"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		data, err := os.ReadFile(filepath.Join(presetsDir, _containerPresetsFile))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).NotTo(ContainSubstring("secret"))
+
+		presets, err := ContainerPresets()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(presets).To(HaveLen(1))
+		Expect(presets[0].Name).To(Equal("web"))
+		Expect(presets[0].Options.Image).To(Equal("docker.io/library/nginx:latest"))
+		Expect(presets[0].Options.EnvVars).To(Equal([]string{"DB_PASSWORD", "DEBUG"}))
+	})
+
+	It("save writes versioned file", func() {
+		Expect(SavePodPreset("pod01", pods.CreateOptions{Hostname: "pod01host"})).To(Succeed())
+
+		presets := readPresetsFile(_podPresetsFile)
+		Expect(presets.Version).To(Equal(_presetsVersion))
+		Expect(presets.Presets).To(HaveLen(1))
+
+		info, err := os.Stat(filepath.Join(presetsDir, _podPresetsFile))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(_presetsFilePerm)))
+
+		_, err = os.Stat(filepath.Join(presetsDir, _podPresetsFile+".tmp"))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+
+	It("unversioned file migration", func() {
+		writePresetsFile(_containerPresetsFile, `[
+			{"name": "web", "options": {"Image": "docker.io/library/nginx:latest", "Publish": ["8080:80"]}}
+		]`)
+
+		presets, err := ContainerPresets()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(presets).To(HaveLen(1))
+		Expect(presets[0].Options.Image).To(Equal("docker.io/library/nginx:latest"))
+		Expect(presets[0].Options.Publish).To(Equal([]string{"8080:80"}))
+
+		Expect(SaveContainerPreset("db", containers.CreateOptions{Image: "docker.io/library/postgres"})).To(Succeed())
+
+		savedPresets := readPresetsFile(_containerPresetsFile)
+		Expect(savedPresets.Version).To(Equal(_presetsVersion))
+		Expect(savedPresets.Presets).To(HaveLen(2))
+	})
+
+	It("unknown version", func() {
+		writePresetsFile(_podPresetsFile, `{"version": 99, "presets": []}`)
+
+		_, err := PodPresets()
+		Expect(err).To(MatchError(ErrUnsupportedPresetVersion))
+
+		err = SavePodPreset("pod01", pods.CreateOptions{})
+		Expect(err).To(MatchError(ErrUnsupportedPresetVersion))
+	})
+
+	It("remove preset", func() {
+		Expect(SavePodPreset("pod01", pods.CreateOptions{})).To(Succeed())
+		Expect(RemovePodPreset("pod01")).To(Succeed())
+		Expect(RemovePodPreset("pod01")).To(MatchError(ErrPresetNotFound))
+	})
+})
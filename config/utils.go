@@ -12,7 +12,7 @@ import (
 	"regexp"
 	"time"
 
-	"github.com/containers/podman-tui/ui/utils"
+	"github.com/containers/podman-tui/pdcs/utils"
 	"github.com/rs/zerolog/log"
 	cntssh "go.podman.io/common/pkg/ssh"
 	"go.podman.io/podman/v6/libpod/define"
@@ -0,0 +1,288 @@
+package containers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"slices"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/rs/zerolog/log"
+	"go.podman.io/image/v5/docker/reference"
+	"go.podman.io/podman/v6/pkg/bindings/containers"
+	"go.podman.io/podman/v6/pkg/bindings/images"
+	"go.podman.io/podman/v6/pkg/bindings/system"
+)
+
+// auto-update labels and policies.
+const (
+	AutoUpdateLabel          = "io.containers.autoupdate"
+	AutoUpdateUnitLabel      = "PODMAN_SYSTEMD_UNIT"
+	AutoUpdatePolicyRegistry = "registry"
+	AutoUpdatePolicyLocal    = "local"
+)
+
+// auto-update report status.
+const (
+	AutoUpdateStatusTrue       = "true"
+	AutoUpdateStatusFalse      = "false"
+	AutoUpdateStatusPending    = "pending"
+	AutoUpdateStatusFailed     = "failed"
+	AutoUpdateStatusRolledBack = "rolled back"
+)
+
+var (
+	errAutoUpdatePolicy = errors.New("unsupported auto-update policy")
+	// ErrAutoUpdateSystemdUnit is returned when a container managed by a systemd unit is updated
+	// over a remote connection, the unit can only be restarted on the podman host.
+	ErrAutoUpdateSystemdUnit = errors.New("systemd unit can only be restarted on local connections, restart the unit on the podman host")
+)
+
+// AutoUpdateReport container auto-update report.
+type AutoUpdateReport struct {
+	ContainerID   string
+	ContainerName string
+	ImageName     string
+	Policy        string
+	SystemdUnit   string
+	Updated       string
+	Error         string
+}
+
+// AutoUpdateList returns containers with auto-update label.
+func AutoUpdateList() ([]AutoUpdateReport, error) {
+	log.Debug().Msg("pdcs: podman auto-update list")
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	filters := map[string][]string{"label": {AutoUpdateLabel}}
+
+	response, err := containers.List(conn, new(containers.ListOptions).WithAll(true).WithFilters(filters))
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]AutoUpdateReport, 0, len(response))
+
+	for _, cnt := range response {
+		cntName := ""
+		if len(cnt.Names) > 0 {
+			cntName = cnt.Names[0]
+		}
+
+		reports = append(reports, AutoUpdateReport{
+			ContainerID:   cnt.ID,
+			ContainerName: cntName,
+			ImageName:     cnt.Image,
+			Policy:        cnt.Labels[AutoUpdateLabel],
+			SystemdUnit:   cnt.Labels[AutoUpdateUnitLabel],
+		})
+	}
+
+	slices.SortFunc(reports, func(a, b AutoUpdateReport) int {
+		if a.SystemdUnit != b.SystemdUnit {
+			return strings.Compare(a.SystemdUnit, b.SystemdUnit)
+		}
+
+		return strings.Compare(a.ContainerName, b.ContainerName)
+	})
+
+	return reports, nil
+}
+
+// AutoUpdate checks and updates the specified auto-update containers (all if empty).
+// The REST API does not implement auto-update, updates are checked similar to
+// podman auto-update: registry images are pulled on the podman host (also in dry-run mode)
+// and the pulled image is compared with the container image.
+// Containers managed by a systemd unit are updated by restarting the unit (local connections only),
+// the other containers are recreated on the new image. Both are restored on failure.
+func AutoUpdate(ids []string, dryRun bool) ([]AutoUpdateReport, error) {
+	log.Debug().Msgf("pdcs: podman auto-update %v (dry-run=%v)", ids, dryRun)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	cntList, err := AutoUpdateList()
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]AutoUpdateReport, 0, len(cntList))
+
+	for _, report := range cntList {
+		if len(ids) > 0 && !slices.Contains(ids, report.ContainerID) {
+			continue
+		}
+
+		cntImageID, pending, err := autoUpdateCheck(conn, report)
+		if err != nil {
+			report.Updated = AutoUpdateStatusFailed
+			report.Error = err.Error()
+		} else {
+			report.Updated = AutoUpdateStatusFalse
+
+			if pending {
+				report.Updated = AutoUpdateStatusPending
+			}
+		}
+
+		if !dryRun && report.Updated == AutoUpdateStatusPending {
+			if report.SystemdUnit != "" {
+				report = autoUpdateUnit(conn, report, cntImageID)
+			} else {
+				report = autoUpdateContainer(report)
+			}
+		}
+
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
+
+// autoUpdateCheck returns the container image ID and true if the container image can be updated.
+// The registry policy image is pulled on the podman host if a newer image is available.
+func autoUpdateCheck(conn context.Context, report AutoUpdateReport) (string, bool, error) {
+	if report.Policy != AutoUpdatePolicyRegistry && report.Policy != AutoUpdatePolicyLocal {
+		return "", false, fmt.Errorf("%w: %q", errAutoUpdatePolicy, report.Policy)
+	}
+
+	cntData, err := containers.Inspect(conn, report.ContainerID, new(containers.InspectOptions))
+	if err != nil {
+		return "", false, err
+	}
+
+	if report.Policy == AutoUpdatePolicyRegistry {
+		pullOpts := new(images.PullOptions).WithQuiet(true).WithPolicy("newer")
+
+		pulledIDs, err := images.Pull(conn, report.ImageName, pullOpts)
+		if err != nil {
+			return cntData.Image, false, err
+		}
+
+		if len(pulledIDs) > 0 && pulledIDs[0] != cntData.Image {
+			return cntData.Image, true, nil
+		}
+	}
+
+	imgData, err := images.GetImage(conn, report.ImageName, new(images.GetOptions))
+	if err != nil {
+		return cntData.Image, false, err
+	}
+
+	// a newer local image is available
+	return cntData.Image, imgData.ID != cntData.Image, nil
+}
+
+// autoUpdateContainer recreates the container on the new image.
+// The report container ID is set to the new container ID on success.
+func autoUpdateContainer(report AutoUpdateReport) AutoUpdateReport {
+	_, newID, err := recreateWithImage(report.ContainerID, report.ImageName)
+	if err != nil {
+		report.Updated = AutoUpdateStatusFailed
+		if errors.Is(err, ErrRecreateRolledBack) {
+			report.Updated = AutoUpdateStatusRolledBack
+		}
+
+		report.Error = err.Error()
+
+		return report
+	}
+
+	report.ContainerID = newID
+	report.Updated = AutoUpdateStatusTrue
+
+	return report
+}
+
+// autoUpdateUnit restarts the container systemd unit as podman auto-update does,
+// the unit creates a new container on the new image.
+// On failure the previous image is tagged back and the unit is restarted again.
+func autoUpdateUnit(conn context.Context, report AutoUpdateReport, prevImageID string) AutoUpdateReport {
+	if !strings.HasPrefix(registry.ConnectionURI(), "unix://") {
+		report.Updated = AutoUpdateStatusFailed
+		report.Error = fmt.Sprintf("%v: %s", ErrAutoUpdateSystemdUnit, report.SystemdUnit)
+
+		return report
+	}
+
+	info, err := system.Info(conn, nil)
+	if err != nil {
+		report.Updated = AutoUpdateStatusFailed
+		report.Error = err.Error()
+
+		return report
+	}
+
+	rootless := info.Host.Security.Rootless
+
+	err = restartSystemdUnit(report.SystemdUnit, rootless)
+	if err == nil {
+		report.Updated = AutoUpdateStatusTrue
+
+		return report
+	}
+
+	report.Updated = AutoUpdateStatusFailed
+	report.Error = err.Error()
+
+	if err := tagImage(conn, prevImageID, report.ImageName); err != nil {
+		report.Error = fmt.Sprintf("%s, rollback: %v", report.Error, err)
+
+		return report
+	}
+
+	if err := restartSystemdUnit(report.SystemdUnit, rootless); err != nil {
+		report.Error = fmt.Sprintf("%s, rollback: %v", report.Error, err)
+
+		return report
+	}
+
+	report.Updated = AutoUpdateStatusRolledBack
+
+	return report
+}
+
+// systemctlRestartArgs returns systemctl arguments to restart the unit,
+// rootless podman units are user units.
+func systemctlRestartArgs(unit string, rootless bool) []string {
+	args := []string{"restart", unit}
+	if rootless {
+		args = append([]string{"--user"}, args...)
+	}
+
+	return args
+}
+
+func restartSystemdUnit(unit string, rootless bool) error {
+	log.Debug().Msgf("pdcs: podman auto-update restart systemd unit %s (rootless=%v)", unit, rootless)
+
+	output, err := exec.Command("systemctl", systemctlRestartArgs(unit, rootless)...).CombinedOutput() //nolint:gosec,noctx
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
+// tagImage tags the image ID with the image name.
+func tagImage(conn context.Context, imageID string, name string) error {
+	named, err := reference.ParseNormalizedNamed(name)
+	if err != nil {
+		return err
+	}
+
+	tagged, ok := reference.TagNameOnly(named).(reference.NamedTagged)
+	if !ok {
+		return fmt.Errorf("%w: %q", reference.ErrReferenceInvalidFormat, name)
+	}
+
+	return images.Tag(conn, imageID, tagged.Tag(), tagged.Name(), nil)
+}
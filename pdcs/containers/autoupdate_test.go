@@ -0,0 +1,13 @@
+package containers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("container auto-update", func() {
+	It("systemd unit restart arguments", func() {
+		Expect(systemctlRestartArgs("web.service", false)).To(Equal([]string{"restart", "web.service"}))
+		Expect(systemctlRestartArgs("web.service", true)).To(Equal([]string{"--user", "restart", "web.service"}))
+	})
+})
@@ -0,0 +1,128 @@
+package containers
+
+import (
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/rs/zerolog/log"
+	"go.podman.io/podman/v6/pkg/bindings/containers"
+	"go.podman.io/podman/v6/pkg/bindings/pods"
+)
+
+// CloneOptions container clone options.
+// Empty name defaults to the original container name with -clone suffix,
+// empty pod, image, cpus and memory keep the original container values.
+type CloneOptions struct {
+	Name    string
+	Pod     string
+	Image   string
+	CPUs    string
+	Memory  string
+	Destroy bool
+	Run     bool
+}
+
+// Clone creates a copy of an existing container with the specified overrides.
+// The REST API has no clone endpoint, the clone is created from the original
+// container configuration instead, the settings which cannot be cloned are returned as warnings.
+func Clone(id string, opts CloneOptions) ([]string, string, error) {
+	log.Debug().Msgf("pdcs: podman container clone %s %v", id, opts)
+
+	createOpts, unmapped, err := ContainerCreateOptions(id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	warnings := make([]string, 0, len(unmapped))
+	for _, setting := range unmapped {
+		warnings = append(warnings, "setting not cloned: "+setting)
+	}
+
+	// the clone pod can be a pod name or ID, the container create options pod is an ID
+	if opts.Pod != "" {
+		conn, err := registry.GetConnection()
+		if err != nil {
+			return warnings, "", err
+		}
+
+		podReport, err := pods.Inspect(conn, opts.Pod, nil)
+		if err != nil {
+			return warnings, "", err
+		}
+
+		opts.Pod = podReport.ID
+	}
+
+	cloneCreateOptions(&createOpts, opts)
+
+	// the create options interactive and tty are only set for run
+	createWarnings, cloneID, err := Create(createOpts, true)
+	warnings = append(warnings, createWarnings...)
+
+	if err != nil {
+		return warnings, cloneID, err
+	}
+
+	if opts.Destroy {
+		conn, err := registry.GetConnection()
+		if err != nil {
+			return warnings, cloneID, err
+		}
+
+		_, err = containers.Remove(conn, id, new(containers.RemoveOptions).WithForce(true))
+		if err != nil {
+			return warnings, cloneID, err
+		}
+	}
+
+	if opts.Run {
+		if err := Start(cloneID); err != nil {
+			return warnings, cloneID, err
+		}
+	}
+
+	return warnings, cloneID, nil
+}
+
+// cloneCreateOptions applies the clone overrides to the original container create options.
+func cloneCreateOptions(createOpts *CreateOptions, opts CloneOptions) {
+	if opts.Name != "" {
+		createOpts.Name = opts.Name
+	} else {
+		createOpts.Name += "-clone"
+	}
+
+	if opts.Pod != "" && opts.Pod != createOpts.Pod {
+		createOpts.Pod = opts.Pod
+		createOpts.Network = ""
+		createOpts.Networks = nil
+		createOpts.IPAddress = ""
+		createOpts.MacAddress = ""
+		createOpts.Hostname = ""
+		createOpts.Publish = nil
+		createOpts.PublishAll = false
+		createOpts.Expose = nil
+		createOpts.DNSServer = nil
+		createOpts.DNSOptions = nil
+		createOpts.DNSSearchDomain = nil
+		createOpts.AddHost = nil
+		createOpts.NamespaceIpc = ""
+		createOpts.NamespacePid = ""
+		createOpts.NamespaceUts = ""
+		createOpts.NamespaceUser = ""
+		createOpts.NamespaceCgroup = ""
+	}
+
+	if opts.Image != "" {
+		createOpts.Image = opts.Image
+	}
+
+	if opts.CPUs != "" {
+		createOpts.CPUs = opts.CPUs
+		createOpts.CPUPeriod = ""
+		createOpts.CPUQuota = ""
+	}
+
+	if opts.Memory != "" && opts.Memory != createOpts.Memory {
+		createOpts.Memory = opts.Memory
+		createOpts.MemorySwap = ""
+	}
+}
@@ -0,0 +1,60 @@
+package containers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("container clone options", func() {
+	var createOpts CreateOptions
+
+	BeforeEach(func() {
+		createOpts = CreateOptions{
+			Name:            "cnt01",
+			Image:           "docker.io/library/alpine:latest",
+			Pod:             "pod01id",
+			Hostname:        "cnt01host",
+			NamespaceIpc:    "shareable",
+			Networks:        []string{"net01"},
+			DNSSearchDomain: []string{"example.com"},
+			Memory:          "512m",
+			MemorySwap:      "1g",
+		}
+	})
+
+	It("default name", func() {
+		cloneCreateOptions(&createOpts, CloneOptions{})
+		Expect(createOpts.Name).To(Equal("cnt01-clone"))
+
+		createOpts.Name = "cnt01"
+		cloneCreateOptions(&createOpts, CloneOptions{Name: "cnt02"})
+		Expect(createOpts.Name).To(Equal("cnt02"))
+	})
+
+	It("unchanged pod", func() {
+		cloneCreateOptions(&createOpts, CloneOptions{Pod: "pod01id"})
+		Expect(createOpts.Pod).To(Equal("pod01id"))
+		Expect(createOpts.Hostname).To(Equal("cnt01host"))
+		Expect(createOpts.NamespaceIpc).To(Equal("shareable"))
+		Expect(createOpts.Networks).To(Equal([]string{"net01"}))
+		Expect(createOpts.DNSSearchDomain).To(Equal([]string{"example.com"}))
+	})
+
+	It("changed pod", func() {
+		cloneCreateOptions(&createOpts, CloneOptions{Pod: "pod02id"})
+		Expect(createOpts.Pod).To(Equal("pod02id"))
+		Expect(createOpts.Hostname).To(BeEmpty())
+		Expect(createOpts.NamespaceIpc).To(BeEmpty())
+		Expect(createOpts.Networks).To(BeNil())
+		Expect(createOpts.DNSSearchDomain).To(BeNil())
+	})
+
+	It("unchanged memory", func() {
+		cloneCreateOptions(&createOpts, CloneOptions{Memory: "512m"})
+		Expect(createOpts.MemorySwap).To(Equal("1g"))
+
+		cloneCreateOptions(&createOpts, CloneOptions{Memory: "1g"})
+		Expect(createOpts.Memory).To(Equal("1g"))
+		Expect(createOpts.MemorySwap).To(BeEmpty())
+	})
+})
@@ -0,0 +1,189 @@
+package containers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/utils"
+	"github.com/rs/zerolog/log"
+	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/pkg/bindings/containers"
+)
+
+// container compare report sections.
+const (
+	CompareSectionConfig    = "config"
+	CompareSectionEnv       = "env"
+	CompareSectionLabels    = "labels"
+	CompareSectionMounts    = "mounts"
+	CompareSectionNetworks  = "networks"
+	CompareSectionPorts     = "ports"
+	CompareSectionResources = "resources"
+)
+
+// Compare returns the differences between inspect fields of two containers.
+func Compare(leftID string, rightID string) ([]utils.CompareEntry, error) {
+	log.Debug().Msgf("pdcs: podman container compare %s %s", leftID, rightID)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	left, err := containers.Inspect(conn, leftID, new(containers.InspectOptions))
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := containers.Inspect(conn, rightID, new(containers.InspectOptions))
+	if err != nil {
+		return nil, err
+	}
+
+	return compareContainersData(left, right), nil
+}
+
+func compareContainersData(left *define.InspectContainerData, right *define.InspectContainerData) []utils.CompareEntry {
+	leftConfig := left.Config
+	if leftConfig == nil {
+		leftConfig = new(define.InspectContainerConfig)
+	}
+
+	rightConfig := right.Config
+	if rightConfig == nil {
+		rightConfig = new(define.InspectContainerConfig)
+	}
+
+	report := []utils.CompareEntry{
+		utils.CompareValue(CompareSectionConfig, "image", left.ImageName, right.ImageName),
+		utils.CompareValue(CompareSectionConfig, "image id", left.Image, right.Image),
+		utils.CompareValue(CompareSectionConfig, "status", containerStateStatus(left), containerStateStatus(right)),
+		utils.CompareValue(CompareSectionConfig, "pod", left.Pod, right.Pod),
+		utils.CompareValue(CompareSectionConfig, "hostname", leftConfig.Hostname, rightConfig.Hostname),
+		utils.CompareValue(CompareSectionConfig, "user", leftConfig.User, rightConfig.User),
+		utils.CompareValue(CompareSectionConfig, "workdir", leftConfig.WorkingDir, rightConfig.WorkingDir),
+		utils.CompareValue(CompareSectionConfig, "entrypoint",
+			strings.Join(leftConfig.Entrypoint, " "), strings.Join(rightConfig.Entrypoint, " ")),
+		utils.CompareValue(CompareSectionConfig, "cmd",
+			strings.Join(leftConfig.Cmd, " "), strings.Join(rightConfig.Cmd, " ")),
+	}
+
+	report = append(report, utils.CompareMaps(CompareSectionEnv,
+		utils.KeyValueToMap(leftConfig.Env), utils.KeyValueToMap(rightConfig.Env))...)
+	report = append(report, utils.CompareMaps(CompareSectionLabels, leftConfig.Labels, rightConfig.Labels)...)
+	report = append(report, utils.CompareMaps(CompareSectionMounts,
+		containerMounts(left), containerMounts(right))...)
+	report = append(report, utils.CompareMaps(CompareSectionNetworks,
+		containerNetworks(left), containerNetworks(right))...)
+	report = append(report, utils.CompareMaps(CompareSectionPorts,
+		containerPorts(left), containerPorts(right))...)
+	report = append(report, utils.CompareMaps(CompareSectionResources,
+		containerResources(left), containerResources(right))...)
+
+	return report
+}
+
+func containerStateStatus(data *define.InspectContainerData) string {
+	if data.State == nil {
+		return ""
+	}
+
+	return data.State.Status
+}
+
+// containerMounts returns container mounts destination and their source, type and mode.
+func containerMounts(data *define.InspectContainerData) map[string]string {
+	mounts := make(map[string]string)
+
+	for _, mount := range data.Mounts {
+		source := mount.Source
+		if mount.Name != "" {
+			source = mount.Name
+		}
+
+		mode := "ro"
+		if mount.RW {
+			mode = "rw"
+		}
+
+		mounts[mount.Destination] = fmt.Sprintf("%s (%s,%s)", source, mount.Type, mode)
+	}
+
+	return mounts
+}
+
+// containerNetworks returns container networks name and their IP address.
+func containerNetworks(data *define.InspectContainerData) map[string]string {
+	networks := make(map[string]string)
+
+	if data.NetworkSettings == nil {
+		return networks
+	}
+
+	for name, network := range data.NetworkSettings.Networks {
+		address := ""
+
+		if network != nil {
+			address = network.IPAddress
+		}
+
+		networks[name] = address
+	}
+
+	if data.HostConfig != nil && len(networks) == 0 {
+		networks[data.HostConfig.NetworkMode] = data.NetworkSettings.IPAddress
+	}
+
+	return networks
+}
+
+// containerPorts returns container ports and their host bindings.
+func containerPorts(data *define.InspectContainerData) map[string]string {
+	ports := make(map[string]string)
+
+	if data.NetworkSettings == nil {
+		return ports
+	}
+
+	for port, hostPorts := range data.NetworkSettings.Ports {
+		bindings := make([]string, 0, len(hostPorts))
+
+		for _, hostPort := range hostPorts {
+			bindings = append(bindings, fmt.Sprintf("%s:%s", hostPort.HostIP, hostPort.HostPort))
+		}
+
+		ports[port] = strings.Join(bindings, ", ")
+	}
+
+	return ports
+}
+
+// containerResources returns container resources limits and privileges.
+func containerResources(data *define.InspectContainerData) map[string]string {
+	resources := make(map[string]string)
+
+	hostConfig := data.HostConfig
+	if hostConfig == nil {
+		return resources
+	}
+
+	resources["memory"] = strconv.FormatInt(hostConfig.Memory, 10)
+	resources["memory swap"] = strconv.FormatInt(hostConfig.MemorySwap, 10)
+	resources["cpu shares"] = strconv.FormatUint(hostConfig.CpuShares, 10)
+	resources["cpu quota"] = strconv.FormatInt(hostConfig.CpuQuota, 10)
+	resources["cpu period"] = strconv.FormatUint(hostConfig.CpuPeriod, 10)
+	resources["nano cpus"] = strconv.FormatInt(hostConfig.NanoCpus, 10)
+	resources["cpuset cpus"] = hostConfig.CpusetCpus
+	resources["pids limit"] = strconv.FormatInt(hostConfig.PidsLimit, 10)
+	resources["privileged"] = strconv.FormatBool(hostConfig.Privileged)
+	resources["cap add"] = strings.Join(hostConfig.CapAdd, ",")
+	resources["cap drop"] = strings.Join(hostConfig.CapDrop, ",")
+
+	if hostConfig.RestartPolicy != nil {
+		resources["restart policy"] = hostConfig.RestartPolicy.Name
+	}
+
+	return resources
+}
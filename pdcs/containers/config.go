@@ -0,0 +1,697 @@
+package containers
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/utils"
+	"github.com/rs/zerolog/log"
+	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/pkg/bindings/containers"
+	"go.podman.io/podman/v6/pkg/bindings/images"
+	"go.podman.io/podman/v6/pkg/inspect"
+)
+
+const (
+	defaultShmSize = 65536000
+	nanoCPUs       = 1e9
+)
+
+// ignored environment variables which are set by podman itself.
+var configIgnoredEnvVars = []string{"HOSTNAME=", "container="}
+
+// podman create flags which are not supported by the create options.
+var configUnmappedFlags = []string{
+	"--annotation", "--blkio-weight", "--blkio-weight-device", "--cgroup-conf", "--cgroup-parent",
+	"--cgroups", "--chrootdirs", "--device-cgroup-rule", "--device-read-bps", "--device-read-iops",
+	"--device-write-bps", "--device-write-iops", "--gpus", "--hooks-dir", "--log-driver", "--log-opt",
+	"--oom-kill-disable", "--oom-score-adj", "--personality", "--pids-limit", "--rdt-class",
+	"--requires", "--rootfs", "--sdnotify", "--stop-signal", "--stop-timeout", "--sysctl",
+	"--systemd", "--ulimit",
+}
+
+// network options which are not reported by the container inspect data.
+var configStaticNetworkOpts = []string{"ip", "ip6", "mac", "interface_name"}
+
+// ContainerCreateOptions returns create options generated from an existing container configuration
+// and the container settings which cannot be set by the create options.
+// The options inherited from the container image (environment, labels, command, user,
+// working directory and entrypoint) are not set.
+func ContainerCreateOptions(id string) (CreateOptions, []string, error) {
+	log.Debug().Msgf("pdcs: podman container create options %s", id)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return CreateOptions{}, nil, err
+	}
+
+	cntData, err := containers.Inspect(conn, id, new(containers.InspectOptions))
+	if err != nil {
+		return CreateOptions{}, nil, err
+	}
+
+	imgData := &inspect.ImageData{}
+
+	imgReport, err := images.GetImage(conn, cntData.Image, nil)
+	if err == nil && imgReport.ImageData != nil {
+		imgData = imgReport.ImageData
+	} else {
+		log.Debug().Msgf("pdcs: podman container create options image %s inspect: %v", cntData.Image, err)
+	}
+
+	opts, unmapped := createOptionsFromInspect(cntData, imgData)
+
+	return opts, unmapped, nil
+}
+
+func createOptionsFromInspect(cntData *define.InspectContainerData, imgData *inspect.ImageData) (CreateOptions, []string) {
+	opts := CreateOptions{
+		Name:  cntData.Name,
+		Image: cntData.ImageName,
+		Pod:   cntData.Pod,
+	}
+
+	if cntData.Config == nil {
+		cntData.Config = &define.InspectContainerConfig{}
+	}
+
+	if cntData.HostConfig == nil {
+		cntData.HostConfig = &define.InspectContainerHostConfig{}
+	}
+
+	cmdArgs := createCommandArgs(cntData.Config)
+
+	configCreateOptions(&opts, cntData, imgData)
+	envCreateOptions(&opts, cntData.Config, imgData)
+	healthCreateOptions(&opts, cntData.Config)
+	hostConfigCreateOptions(&opts, cntData.HostConfig)
+
+	opts.Mount = containerExtraMounts(cntData)
+
+	unmapped := securityCreateOptions(&opts, cntData.HostConfig)
+	unmapped = append(unmapped, flagsCreateOptions(&opts, cmdArgs)...)
+
+	// pod containers share the pod network namespace
+	if opts.Pod != "" {
+		opts.Hostname = ""
+		opts.IPAddress = ""
+		opts.MacAddress = ""
+		opts.Publish = nil
+		opts.PublishAll = false
+		opts.Expose = nil
+		opts.DNSServer = nil
+		opts.DNSOptions = nil
+		opts.DNSSearchDomain = nil
+		opts.AddHost = nil
+
+		return opts, unmapped
+	}
+
+	opts.Expose = containerExposedPorts(cntData, imgData)
+	opts.NamespaceIpc = namespaceCreateOption(cntData.HostConfig.IpcMode)
+	opts.NamespacePid = namespaceCreateOption(cntData.HostConfig.PidMode)
+	opts.NamespaceUts = namespaceCreateOption(cntData.HostConfig.UTSMode)
+	opts.NamespaceUser = namespaceCreateOption(cntData.HostConfig.UsernsMode)
+	opts.NamespaceCgroup = namespaceCreateOption(cntData.HostConfig.CgroupMode)
+
+	unmapped = append(unmapped, networkCreateOptions(&opts, cntData, cmdArgs)...)
+
+	return opts, unmapped
+}
+
+func configCreateOptions(opts *CreateOptions, cntData *define.InspectContainerData, imgData *inspect.ImageData) {
+	config := cntData.Config
+
+	opts.CommandArgs = containerCommand(config, imgData)
+	opts.Command = utils.ShellJoin(opts.CommandArgs)
+	opts.Interactive = config.OpenStdin
+	opts.TTY = config.Tty
+	opts.Umask = config.Umask
+
+	opts.User, opts.WorkDir, opts.Entrypoint = containerProcessOverrides(config, imgData)
+
+	if config.Timeout > 0 {
+		opts.Timeout = strconv.FormatUint(uint64(config.Timeout), 10)
+	}
+
+	// hostname defaults to the short container ID and is inherited from the pod
+	if cntData.Pod == "" && !strings.HasPrefix(cntData.ID, config.Hostname) {
+		opts.Hostname = config.Hostname
+	}
+
+	for _, secret := range config.Secrets {
+		if secret != nil {
+			opts.Secret = append(opts.Secret, secret.Name)
+		}
+	}
+}
+
+// envCreateOptions sets the container environment variables and labels which are not
+// inherited from the image, the image variables removed from the container are unset.
+func envCreateOptions(opts *CreateOptions, config *define.InspectContainerConfig, imgData *inspect.ImageData) {
+	opts.EnvVars = containerEnvVars(config, imgData)
+	opts.Labels = containerLabels(config, imgData)
+
+	if imgData.Config == nil {
+		return
+	}
+
+	for _, imgEnvVar := range imgData.Config.Env {
+		name, _, _ := strings.Cut(imgEnvVar, "=")
+
+		if !slices.ContainsFunc(config.Env, func(envVar string) bool { return strings.HasPrefix(envVar, name+"=") }) {
+			opts.UnsetEnv = append(opts.UnsetEnv, name)
+		}
+	}
+}
+
+func healthCreateOptions(opts *CreateOptions, config *define.InspectContainerConfig) { //nolint:cyclop
+	health := config.Healthcheck
+	if health == nil {
+		return
+	}
+
+	// empty health command disables the image healthcheck
+	opts.HealthCmd = healthCmdString(health.Test)
+	if opts.HealthCmd == "" {
+		return
+	}
+
+	opts.HealthInterval = durationString(health.Interval)
+	opts.HealthTimeout = durationString(health.Timeout)
+	opts.HealthStartPeroid = durationString(health.StartPeriod)
+
+	if health.Retries > 0 {
+		opts.HealthRetries = strconv.Itoa(health.Retries)
+	}
+
+	if config.HealthcheckOnFailureAction != "" && config.HealthcheckOnFailureAction != "none" {
+		opts.HealthOnFailure = config.HealthcheckOnFailureAction
+	}
+
+	if config.HealthLogDestination != define.DefaultHealthCheckLocalDestination {
+		opts.HealthLogDestination = config.HealthLogDestination
+	}
+
+	if config.HealthMaxLogCount > 0 {
+		opts.HealthMaxLogCount = strconv.FormatUint(uint64(config.HealthMaxLogCount), 10)
+	}
+
+	if config.HealthMaxLogSize > 0 {
+		opts.HealthMaxLogSize = strconv.FormatUint(uint64(config.HealthMaxLogSize), 10)
+	}
+
+	startup := config.StartupHealthCheck
+	if startup == nil {
+		return
+	}
+
+	opts.HealthStartupCmd = healthCmdString(startup.Test)
+	if opts.HealthStartupCmd == "" {
+		return
+	}
+
+	opts.HealthStartupInterval = durationString(startup.Interval)
+	opts.HealthStartupTimeout = durationString(startup.Timeout)
+
+	if startup.Retries > 0 {
+		opts.HealthStartupRetries = strconv.Itoa(startup.Retries)
+	}
+
+	if startup.Successes > 0 {
+		opts.HealthStartupSuccess = strconv.Itoa(startup.Successes)
+	}
+}
+
+func hostConfigCreateOptions(opts *CreateOptions, hostConfig *define.InspectContainerHostConfig) { //nolint:cyclop
+	opts.Remove = hostConfig.AutoRemove
+	opts.Privileged = hostConfig.Privileged
+	opts.ReadOnly = hostConfig.ReadonlyRootfs
+	opts.Init = hostConfig.Init
+	opts.GroupAdd = hostConfig.GroupAdd
+	opts.AddHost = hostConfig.ExtraHosts
+	opts.PublishAll = hostConfig.PublishAllPorts
+	opts.DNSServer = hostConfig.Dns
+	opts.DNSOptions = hostConfig.DnsOptions
+	opts.DNSSearchDomain = hostConfig.DnsSearch
+
+	if hostConfig.RestartPolicy != nil && hostConfig.RestartPolicy.Name != "" && hostConfig.RestartPolicy.Name != "no" {
+		opts.Restart = hostConfig.RestartPolicy.Name
+		if hostConfig.RestartPolicy.MaximumRetryCount > 0 {
+			opts.Restart = fmt.Sprintf("%s:%d", opts.Restart, hostConfig.RestartPolicy.MaximumRetryCount)
+		}
+	}
+
+	// privileged containers have all the capabilities
+	if !hostConfig.Privileged {
+		opts.CapAdd = hostConfig.CapAdd
+		opts.CapDrop = hostConfig.CapDrop
+	}
+
+	opts.Volume = hostConfig.Binds
+	opts.VolumesFrom = hostConfig.VolumesFrom
+
+	for _, device := range hostConfig.Devices {
+		spec := device.PathOnHost
+		if device.PathInContainer != "" && device.PathInContainer != device.PathOnHost {
+			spec = spec + ":" + device.PathInContainer
+		}
+
+		if device.CgroupPermissions != "" {
+			spec = spec + ":" + device.CgroupPermissions
+		}
+
+		opts.Device = append(opts.Device, spec)
+	}
+
+	for path, tmpfsOpts := range hostConfig.Tmpfs {
+		spec := path
+		if tmpfsOpts != "" {
+			spec = spec + ":" + tmpfsOpts
+		}
+
+		opts.Tmpfs = append(opts.Tmpfs, spec)
+	}
+
+	sort.Strings(opts.Tmpfs)
+
+	for cntPort, hostPorts := range hostConfig.PortBindings {
+		for _, hostPort := range hostPorts {
+			publish := fmt.Sprintf("%s:%s", hostPort.HostPort, cntPort)
+			if hostPort.HostIP != "" {
+				publish = fmt.Sprintf("%s:%s", hostPort.HostIP, publish)
+			}
+
+			opts.Publish = append(opts.Publish, publish)
+		}
+	}
+
+	sort.Strings(opts.Publish)
+	resourceCreateOptions(opts, hostConfig)
+}
+
+func resourceCreateOptions(opts *CreateOptions, hostConfig *define.InspectContainerHostConfig) { //nolint:cyclop
+	if hostConfig.Memory > 0 {
+		opts.Memory = strconv.FormatInt(hostConfig.Memory, 10)
+	}
+
+	if hostConfig.MemoryReservation > 0 {
+		opts.MemoryReservation = strconv.FormatInt(hostConfig.MemoryReservation, 10)
+	}
+
+	// podman sets the swap limit to twice the memory limit by default
+	if hostConfig.MemorySwap > 0 && hostConfig.MemorySwap != 2*hostConfig.Memory {
+		opts.MemorySwap = strconv.FormatInt(hostConfig.MemorySwap, 10)
+	}
+
+	if hostConfig.MemorySwappiness != nil && *hostConfig.MemorySwappiness >= 0 {
+		opts.MemorySwappiness = strconv.FormatInt(*hostConfig.MemorySwappiness, 10)
+	}
+
+	if hostConfig.NanoCpus > 0 {
+		opts.CPUs = strconv.FormatFloat(float64(hostConfig.NanoCpus)/nanoCPUs, 'f', -1, 64)
+	} else {
+		if hostConfig.CpuPeriod > 0 {
+			opts.CPUPeriod = strconv.FormatUint(hostConfig.CpuPeriod, 10)
+		}
+
+		if hostConfig.CpuQuota > 0 {
+			opts.CPUQuota = strconv.FormatInt(hostConfig.CpuQuota, 10)
+		}
+	}
+
+	if hostConfig.CpuShares > 0 {
+		opts.CPUShares = strconv.FormatUint(hostConfig.CpuShares, 10)
+	}
+
+	if hostConfig.CpuRealtimePeriod > 0 {
+		opts.CPURtPeriod = strconv.FormatUint(hostConfig.CpuRealtimePeriod, 10)
+	}
+
+	if hostConfig.CpuRealtimeRuntime > 0 {
+		opts.CPURtRuntime = strconv.FormatInt(hostConfig.CpuRealtimeRuntime, 10)
+	}
+
+	opts.CPUSetCPUs = hostConfig.CpusetCpus
+	opts.CPUSetMems = hostConfig.CpusetMems
+
+	if hostConfig.ShmSize > 0 && hostConfig.ShmSize != defaultShmSize {
+		opts.SHMSize = strconv.FormatInt(hostConfig.ShmSize, 10)
+	}
+}
+
+// securityCreateOptions sets the security options and returns the unsupported ones.
+func securityCreateOptions(opts *CreateOptions, hostConfig *define.InspectContainerHostConfig) []string {
+	var (
+		unmapped []string
+		masks    []string
+		unmasks  []string
+	)
+
+	for _, secOpt := range hostConfig.SecurityOpt {
+		name, value, _ := strings.Cut(secOpt, "=")
+
+		switch name {
+		case "label":
+			opts.SelinuxOpts = append(opts.SelinuxOpts, value)
+		case "apparmor":
+			opts.ApparmorProfile = value
+		case "seccomp":
+			opts.Seccomp = value
+		case "mask":
+			masks = append(masks, value)
+		case "unmask":
+			unmasks = append(unmasks, value)
+		case "no-new-privileges", "no-new-privileges:true":
+			opts.SecNoNewPriv = true
+		default:
+			unmapped = append(unmapped, "--security-opt "+secOpt)
+		}
+	}
+
+	opts.SecMask = strings.Join(masks, ":")
+	opts.SecUnmask = strings.Join(unmasks, ":")
+
+	return unmapped
+}
+
+// flagsCreateOptions sets the options which are only available from the podman create
+// command line and returns the unsupported command line flags.
+func flagsCreateOptions(opts *CreateOptions, cmdArgs []string) []string { //nolint:cyclop
+	var unmapped []string
+
+	opts.HostUsers = createCommandFlagValues(cmdArgs, "--hostuser")
+	opts.PasswdEntry = lastValue(createCommandFlagValues(cmdArgs, "--passwd-entry"))
+	opts.GroupEntry = lastValue(createCommandFlagValues(cmdArgs, "--group-entry"))
+	opts.SHMSizeSystemd = lastValue(createCommandFlagValues(cmdArgs, "--shm-size-systemd"))
+	opts.ImageVolume = lastValue(createCommandFlagValues(cmdArgs, "--image-volume"))
+	opts.NamespaceSubuidName = lastValue(createCommandFlagValues(cmdArgs, "--subuidname"))
+	opts.NamespaceSubgidName = lastValue(createCommandFlagValues(cmdArgs, "--subgidname"))
+
+	// secret options (e.g. type=env) are not reported by the container inspect data
+	if secrets := createCommandFlagValues(cmdArgs, "--secret"); len(secrets) > 0 {
+		opts.Secret = secrets
+	}
+
+	for flag, value := range map[string]*string{"--uidmap": &opts.NamespaceUidmap, "--gidmap": &opts.NamespaceGidmap} {
+		values := createCommandFlagValues(cmdArgs, flag)
+
+		switch {
+		case len(values) == 1:
+			*value = values[0]
+		case len(values) > 1:
+			for _, mapping := range values {
+				unmapped = append(unmapped, flag+" "+mapping)
+			}
+		}
+	}
+
+	for _, flag := range configUnmappedFlags {
+		values := createCommandFlagValues(cmdArgs, flag)
+		for _, value := range values {
+			unmapped = append(unmapped, flag+" "+value)
+		}
+
+		if len(values) == 0 && createCommandHasFlag(cmdArgs, flag) {
+			unmapped = append(unmapped, flag)
+		}
+	}
+
+	sort.Strings(unmapped)
+
+	return unmapped
+}
+
+// networkCreateOptions sets the container networks and returns the unsupported network settings.
+// The first network is the create options network, the others and the network options
+// (aliases and static addresses) are set in podman --network format.
+func networkCreateOptions(opts *CreateOptions, cntData *define.InspectContainerData, cmdArgs []string) []string {
+	networkMode := cntData.HostConfig.NetworkMode
+	cmdNetworks := createCommandFlagValues(cmdArgs, "--network")
+
+	switch {
+	case networkMode == "host" || networkMode == "none" ||
+		strings.HasPrefix(networkMode, "container:") || strings.HasPrefix(networkMode, "ns:"):
+		return []string{"--network " + networkMode}
+	case networkMode == "pasta" || networkMode == "slirp4netns":
+		// pasta is the rootless default network mode
+		if slices.ContainsFunc(cmdNetworks, func(network string) bool { return strings.HasPrefix(network, networkMode) }) {
+			return []string{"--network " + networkMode}
+		}
+
+		return nil
+	}
+
+	if cntData.NetworkSettings == nil || len(cntData.NetworkSettings.Networks) == 0 {
+		return nil
+	}
+
+	networks := make([]string, 0, len(cntData.NetworkSettings.Networks))
+	for netName := range cntData.NetworkSettings.Networks {
+		networks = append(networks, netName)
+	}
+
+	sort.Strings(networks)
+
+	// static addresses of the networks set by the command line
+	staticOpts := make(map[string][]string)
+
+	for _, cmdNetwork := range cmdNetworks {
+		netName, netOpts, _ := strings.Cut(cmdNetwork, ":")
+
+		for netOpt := range strings.SplitSeq(netOpts, ",") {
+			name, _, _ := strings.Cut(netOpt, "=")
+			if slices.Contains(configStaticNetworkOpts, name) {
+				staticOpts[netName] = append(staticOpts[netName], netOpt)
+			}
+		}
+	}
+
+	opts.Network = networks[0]
+	opts.IPAddress = lastValue(createCommandFlagValues(cmdArgs, "--ip"))
+	opts.MacAddress = lastValue(createCommandFlagValues(cmdArgs, "--mac-address"))
+
+	for _, ip6 := range createCommandFlagValues(cmdArgs, "--ip6") {
+		staticOpts[opts.Network] = append(staticOpts[opts.Network], "ip6="+ip6)
+	}
+
+	for _, netName := range networks {
+		netOpts := staticOpts[netName]
+
+		for _, alias := range cntData.NetworkSettings.Networks[netName].Aliases {
+			// container name and short ID are default aliases
+			if alias != cntData.Name && !strings.HasPrefix(cntData.ID, alias) {
+				netOpts = append(netOpts, "alias="+alias)
+			}
+		}
+
+		switch {
+		case len(netOpts) > 0:
+			opts.Networks = append(opts.Networks, netName+":"+strings.Join(netOpts, ","))
+		case netName != opts.Network:
+			opts.Networks = append(opts.Networks, netName)
+		}
+	}
+
+	return nil
+}
+
+// namespaceCreateOption returns the namespace mode if it's not a podman default.
+func namespaceCreateOption(mode string) string {
+	if slices.Contains(runCmdDefaultNSModes, mode) {
+		return ""
+	}
+
+	return mode
+}
+
+// containerEnvVars returns the container environment variables which are not inherited from the image.
+func containerEnvVars(config *define.InspectContainerConfig, imgData *inspect.ImageData) []string {
+	var (
+		imgEnv  []string
+		envVars []string
+	)
+
+	if imgData.Config != nil {
+		imgEnv = imgData.Config.Env
+	}
+
+	for _, envVar := range config.Env {
+		if slices.Contains(imgEnv, envVar) || (config.Tty && envVar == "TERM=xterm") {
+			continue
+		}
+
+		ignored := slices.ContainsFunc(configIgnoredEnvVars, func(prefix string) bool {
+			return strings.HasPrefix(envVar, prefix)
+		})
+
+		if !ignored {
+			envVars = append(envVars, envVar)
+		}
+	}
+
+	return envVars
+}
+
+// containerLabels returns the sorted container labels which are not inherited from the image.
+func containerLabels(config *define.InspectContainerConfig, imgData *inspect.ImageData) []string {
+	labels := make([]string, 0, len(config.Labels))
+
+	for key, value := range config.Labels {
+		if imgValue, ok := imgData.Labels[key]; ok && imgValue == value {
+			continue
+		}
+
+		labels = append(labels, fmt.Sprintf("%s=%s", key, value))
+	}
+
+	sort.Strings(labels)
+
+	return labels
+}
+
+// containerCommand returns the container command if it's not the image command.
+func containerCommand(config *define.InspectContainerConfig, imgData *inspect.ImageData) []string {
+	if imgData.Config != nil && slices.Equal(config.Cmd, imgData.Config.Cmd) {
+		return nil
+	}
+
+	return config.Cmd
+}
+
+// containerProcessOverrides returns the container user, working directory and entrypoint
+// (JSON array) which are not inherited from the image.
+func containerProcessOverrides(
+	config *define.InspectContainerConfig, imgData *inspect.ImageData,
+) (string, string, string) {
+	imgConfig := imgData.Config
+	if imgConfig == nil {
+		return config.User, config.WorkingDir, ""
+	}
+
+	var user, workDir, entrypoint string
+
+	if config.User != imgConfig.User {
+		user = config.User
+	}
+
+	if config.WorkingDir != imgConfig.WorkingDir && config.WorkingDir != "/" {
+		workDir = config.WorkingDir
+	}
+
+	if len(config.Entrypoint) > 0 && !slices.Equal(config.Entrypoint, imgConfig.Entrypoint) {
+		entrypoint = jsonArray(config.Entrypoint)
+	}
+
+	return user, workDir, entrypoint
+}
+
+// containerExposedPorts returns the sorted container exposed ports which are
+// not exposed by the image or published.
+func containerExposedPorts(cntData *define.InspectContainerData, imgData *inspect.ImageData) []string {
+	var ports []string
+
+	for port := range cntData.Config.ExposedPorts {
+		if imgData.Config != nil {
+			if _, ok := imgData.Config.ExposedPorts[port]; ok {
+				continue
+			}
+		}
+
+		if _, ok := cntData.HostConfig.PortBindings[port]; ok {
+			continue
+		}
+
+		ports = append(ports, port)
+	}
+
+	sort.Strings(ports)
+
+	return ports
+}
+
+// containerExtraMounts returns the mounts which are not reported as binds (e.g. image or glob mounts)
+// in podman --mount format.
+func containerExtraMounts(cntData *define.InspectContainerData) []string {
+	var mounts []string
+
+	for _, mount := range cntData.Mounts {
+		if mount.Type == "bind" || mount.Type == "volume" || mount.Type == "tmpfs" {
+			continue
+		}
+
+		source := mount.Source
+		if mount.Name != "" {
+			source = mount.Name
+		}
+
+		spec := fmt.Sprintf("type=%s,source=%s,destination=%s", mount.Type, source, mount.Destination)
+		if !mount.RW {
+			spec += ",ro=true"
+		}
+
+		mounts = append(mounts, spec)
+	}
+
+	return mounts
+}
+
+// createCommandArgs returns the podman create (run) command line of the container
+// without its image and command.
+func createCommandArgs(config *define.InspectContainerConfig) []string {
+	args := config.CreateCommand
+
+	if len(config.Cmd) > 0 && len(args) > len(config.Cmd) && slices.Equal(args[len(args)-len(config.Cmd):], config.Cmd) {
+		args = args[:len(args)-len(config.Cmd)]
+	}
+
+	if len(args) > 0 {
+		args = args[:len(args)-1]
+	}
+
+	return args
+}
+
+// createCommandFlagValues returns the values of the command line flag.
+func createCommandFlagValues(args []string, flag string) []string {
+	var values []string
+
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == flag && i+1 < len(args):
+			values = append(values, args[i+1])
+			i++
+		case strings.HasPrefix(args[i], flag+"="):
+			values = append(values, strings.TrimPrefix(args[i], flag+"="))
+		}
+	}
+
+	return values
+}
+
+func createCommandHasFlag(args []string, flag string) bool {
+	return slices.ContainsFunc(args, func(arg string) bool {
+		return arg == flag || strings.HasPrefix(arg, flag+"=")
+	})
+}
+
+func lastValue(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[len(values)-1]
+}
+
+func durationString(value time.Duration) string {
+	if value <= 0 {
+		return ""
+	}
+
+	return value.String()
+}
@@ -0,0 +1,13 @@
+package containers_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestContainers(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Containers Suite")
+}
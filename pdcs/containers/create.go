@@ -20,14 +20,31 @@ import (
 
 var ErrInvalidCreateTimeout = errors.New("invalid container create timeout value")
 
+// seccomp policies, other seccomp values are profile paths.
+const (
+	seccompPolicyDefault = "default"
+	seccompPolicyImage   = "image"
+)
+
 // CreateOptions container create options.
+// The Command is split on white spaces if CommandArgs is not set.
+// Networks are additional networks in podman --network format (name:alias=...,ip=...),
+// the options of the Network are merged.
 type CreateOptions struct {
 	Name                  string
 	Command               string
+	CommandArgs           []string
+	Entrypoint            string
 	Labels                []string
 	Image                 string
 	Remove                bool
 	Privileged            bool
+	ReadOnly              bool
+	Init                  bool
+	Restart               string
+	CapAdd                []string
+	CapDrop               []string
+	GroupAdd              []string
 	Timeout               string
 	Interactive           bool
 	TTY                   bool
@@ -49,6 +66,7 @@ type CreateOptions struct {
 	Hostname              string
 	IPAddress             string
 	Network               string
+	Networks              []string
 	MacAddress            string
 	Publish               []string
 	Expose                []string
@@ -56,9 +74,13 @@ type CreateOptions struct {
 	DNSServer             []string
 	DNSOptions            []string
 	DNSSearchDomain       []string
-	Volume                string
+	AddHost               []string
+	Volume                []string
 	ImageVolume           string
-	Mount                 string
+	Mount                 []string
+	Device                []string
+	Tmpfs                 []string
+	VolumesFrom           []string
 	SelinuxOpts           []string
 	ApparmorProfile       string
 	Seccomp               string
@@ -127,6 +149,16 @@ func Create(opts CreateOptions, run bool) ([]string, string, error) { //nolint:c
 	createOptions.Name = opts.Name
 	createOptions.Rm = opts.Remove
 	createOptions.Privileged = opts.Privileged
+	createOptions.ReadOnly = opts.ReadOnly
+	createOptions.Init = opts.Init
+	createOptions.Restart = opts.Restart
+	createOptions.CapAdd = opts.CapAdd
+	createOptions.CapDrop = opts.CapDrop
+	createOptions.GroupAdd = opts.GroupAdd
+
+	if opts.Entrypoint != "" {
+		createOptions.Entrypoint = &opts.Entrypoint
+	}
 
 	if opts.Timeout != "" {
 		timeout, err := strconv.Atoi(opts.Timeout)
@@ -157,16 +189,24 @@ func Create(opts CreateOptions, run bool) ([]string, string, error) { //nolint:c
 		createOptions.Net.Network.NSMode = specgen.Default
 	}
 
-	if opts.Volume != "" {
-		createOptions.Volume = strings.Split(opts.Volume, ",")
+	if len(opts.Volume) > 0 {
+		createOptions.Volume = opts.Volume
 	}
 
-	if opts.Mount != "" {
-		for mopts := range strings.SplitSeq(opts.Mount, " ") {
-			if mopts != "" {
-				createOptions.Mount = append(createOptions.Mount, mopts)
-			}
-		}
+	if len(opts.Mount) > 0 {
+		createOptions.Mount = opts.Mount
+	}
+
+	if len(opts.Device) > 0 {
+		createOptions.Devices = opts.Device
+	}
+
+	if len(opts.Tmpfs) > 0 {
+		createOptions.TmpFS = opts.Tmpfs
+	}
+
+	if len(opts.VolumesFrom) > 0 {
+		createOptions.VolumesFrom = opts.VolumesFrom
 	}
 
 	createOptions.ImageVolume = opts.ImageVolume
@@ -184,8 +224,13 @@ func Create(opts CreateOptions, run bool) ([]string, string, error) { //nolint:c
 		createOptions.SecurityOpt = append(createOptions.SecurityOpt, "unmask="+opts.SecUnmask)
 	}
 
-	if opts.Seccomp != "" {
+	// seccomp policy or profile
+	switch opts.Seccomp {
+	case "":
+	case seccompPolicyDefault, seccompPolicyImage:
 		createOptions.SeccompPolicy = opts.Seccomp
+	default:
+		createOptions.SecurityOpt = append(createOptions.SecurityOpt, "seccomp="+opts.Seccomp)
 	}
 
 	if opts.SecNoNewPriv {
@@ -401,8 +446,12 @@ func Create(opts CreateOptions, run bool) ([]string, string, error) { //nolint:c
 
 	// command
 	cmd := strings.TrimSpace(opts.Command)
-	if cmd != "" {
-		s.Command = strings.Split(cmd, " ")
+
+	switch {
+	case len(opts.CommandArgs) > 0:
+		s.Command = opts.CommandArgs
+	case cmd != "":
+		s.Command = strings.Fields(cmd)
 	}
 
 	// validate spec
@@ -511,7 +560,7 @@ func containerHealthOptions(createOptions *entities.ContainerCreateOptions, opts
 		}
 
 		startupSuccessWd := uint(startupSuccess)
-		createOptions.StartupHCRetries = startupSuccessWd
+		createOptions.StartupHCSuccesses = startupSuccessWd
 	}
 
 	return nil
@@ -545,6 +594,8 @@ func containerNetworkOptions(opts CreateOptions) (*entities.NetOptions, error) {
 		netOptions.DNSSearch = opts.DNSSearchDomain
 	}
 
+	netOptions.AddHosts = opts.AddHost
+
 	if len(opts.Publish) > 0 {
 		netOptions.PublishPorts, err = specgenutil.CreatePortBindings(opts.Publish)
 		if err != nil {
@@ -575,5 +626,25 @@ func containerNetworkOptions(opts CreateOptions) (*entities.NetOptions, error) {
 		netOptions.Networks[opts.Network] = perNetworkOpt
 	}
 
+	for _, network := range opts.Networks {
+		_, networks, _, _, err := specgen.ParseNetworkFlag([]string{network})
+		if err != nil {
+			return nil, err
+		}
+
+		for name, netOpts := range networks {
+			if existing, ok := netOptions.Networks[name]; ok {
+				netOpts.Aliases = append(existing.Aliases, netOpts.Aliases...)
+				netOpts.StaticIPs = append(existing.StaticIPs, netOpts.StaticIPs...)
+
+				if netOpts.StaticMAC == nil {
+					netOpts.StaticMAC = existing.StaticMAC
+				}
+			}
+
+			netOptions.Networks[name] = netOpts
+		}
+	}
+
 	return netOptions, nil
 }
@@ -0,0 +1,130 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/utils"
+	"github.com/docker/go-units"
+	"github.com/rs/zerolog/log"
+	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/pkg/bindings/containers"
+)
+
+// container details report sections.
+const (
+	DetailsSectionContainer = "container"
+	DetailsSectionPorts     = "ports"
+	DetailsSectionMounts    = "mounts"
+	DetailsSectionNetworks  = "networks"
+	DetailsSectionLogs      = "logs"
+)
+
+// DetailsLogLines number of last log lines in container details report.
+const DetailsLogLines = 5
+
+// Details returns summary of container status, image, ports, mounts, networks, health and its last log lines.
+func Details(id string) ([]utils.DetailsEntry, error) {
+	log.Debug().Msgf("pdcs: podman container details %s", id)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := containers.Inspect(conn, id, new(containers.InspectOptions))
+	if err != nil {
+		return nil, err
+	}
+
+	report := containerDetailsData(data)
+
+	logs := containerDetailsLogs(conn, id)
+
+	report = append(report, utils.DetailsList(DetailsSectionLogs, logs)...)
+
+	return report, nil
+}
+
+// containerDetailsLogs returns the container last log lines.
+// The logs are not available for some log drivers (none, passthrough) and never started containers,
+// the error is not reported in that case and no log lines are returned.
+func containerDetailsLogs(conn context.Context, id string) []string {
+	logChan := make(chan string, DetailsLogLines)
+	logsDone := make(chan []string)
+
+	// the log lines are collected while the logs are streamed to not block the streaming
+	go func() {
+		logs := make([]string, 0, DetailsLogLines)
+		for msg := range logChan {
+			logs = append(logs, msg)
+		}
+
+		logsDone <- logs
+	}()
+
+	options := new(containers.LogOptions).
+		WithFollow(false).
+		WithStdout(true).
+		WithStderr(true).
+		WithTail(strconv.Itoa(DetailsLogLines))
+
+	err := containers.Logs(conn, id, options, logChan, logChan)
+
+	close(logChan)
+
+	logs := <-logsDone
+
+	if err != nil {
+		log.Debug().Msgf("pdcs: podman container details %s logs: %v", id, err)
+
+		return nil
+	}
+
+	return utils.TailLines(logs, DetailsLogLines)
+}
+
+func containerDetailsData(data *define.InspectContainerData) []utils.DetailsEntry {
+	status := containerStateStatus(data)
+	health := ""
+
+	if data.State != nil {
+		if !data.State.Running && !data.State.FinishedAt.IsZero() {
+			status = fmt.Sprintf("%s (exit code %d)", status, data.State.ExitCode)
+		}
+
+		if data.State.Health != nil {
+			health = data.State.Health.Status
+			if data.State.Health.FailingStreak > 0 {
+				health = fmt.Sprintf("%s (failing streak %d)", health, data.State.Health.FailingStreak)
+			}
+		}
+	}
+
+	command := ""
+	if data.Config != nil {
+		command = strings.Join(slices.Concat(data.Config.Entrypoint, data.Config.Cmd), " ")
+	}
+
+	report := []utils.DetailsEntry{
+		utils.DetailsValue(DetailsSectionContainer, "name", data.Name),
+		utils.DetailsValue(DetailsSectionContainer, "status", status),
+		utils.DetailsValue(DetailsSectionContainer, "health", health),
+		utils.DetailsValue(DetailsSectionContainer, "image", data.ImageName),
+		utils.DetailsValue(DetailsSectionContainer, "command", command),
+		utils.DetailsValue(DetailsSectionContainer, "pod", data.Pod),
+		utils.DetailsValue(DetailsSectionContainer, "created", units.HumanDuration(time.Since(data.Created))+" ago"),
+		utils.DetailsValue(DetailsSectionContainer, "restarts", strconv.FormatInt(int64(data.RestartCount), 10)),
+	}
+
+	report = append(report, utils.DetailsMap(DetailsSectionPorts, containerPorts(data))...)
+	report = append(report, utils.DetailsMap(DetailsSectionMounts, containerMounts(data))...)
+	report = append(report, utils.DetailsMap(DetailsSectionNetworks, containerNetworks(data))...)
+
+	return report
+}
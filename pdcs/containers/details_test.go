@@ -0,0 +1,20 @@
+package containers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("container details", func() {
+	It("logs error returns no logs", func() {
+		logsDone := make(chan []string, 1)
+
+		go func() {
+			logsDone <- containerDetailsLogs(context.Background(), "cnt01")
+		}()
+
+		Eventually(logsDone).Should(Receive(BeNil()))
+	})
+})
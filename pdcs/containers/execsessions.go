@@ -0,0 +1,63 @@
+package containers
+
+import (
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/rs/zerolog/log"
+	"go.podman.io/podman/v6/pkg/bindings/containers"
+)
+
+// ExecSessionReport container exec session information.
+type ExecSessionReport struct {
+	ID       string
+	Command  string
+	User     string
+	Tty      bool
+	Running  bool
+	Pid      int
+	ExitCode int
+}
+
+// ExecSessions returns container's exec sessions.
+func ExecSessions(id string) ([]ExecSessionReport, error) {
+	log.Debug().Msgf("pdcs: podman container (%s) exec sessions", id)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := containers.Inspect(conn, id, new(containers.InspectOptions))
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]ExecSessionReport, 0, len(response.ExecIDs))
+
+	for _, execID := range response.ExecIDs {
+		execInspect, err := containers.ExecInspect(conn, execID, new(containers.ExecInspectOptions))
+		if err != nil {
+			return nil, err
+		}
+
+		report := ExecSessionReport{
+			ID:       execInspect.ID,
+			Running:  execInspect.Running,
+			Pid:      execInspect.Pid,
+			ExitCode: execInspect.ExitCode,
+		}
+
+		if execInspect.ProcessConfig != nil {
+			cmd := append([]string{execInspect.ProcessConfig.Entrypoint}, execInspect.ProcessConfig.Arguments...)
+
+			report.Command = strings.Join(cmd, " ")
+			report.User = execInspect.ProcessConfig.User
+			report.Tty = execInspect.ProcessConfig.Tty
+		}
+
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
@@ -2,15 +2,20 @@ package containers
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/utils"
 	"github.com/rs/zerolog/log"
 	"go.podman.io/podman/v6/pkg/bindings/containers"
 )
 
-// Prune removes all non running containers.
-func Prune() ([]string, error) {
-	log.Debug().Msgf("pdcs: podman container prune")
+// container states which are removed by prune, pod containers are not removed.
+var pruneContainerStates = []string{"exited", "stopped", "created", "configured"}
+
+// Prune removes all non running containers matching the prune filters.
+func Prune(opts utils.PruneOptions) ([]string, error) {
+	log.Debug().Msgf("pdcs: podman container prune %v", opts.Filters())
 
 	var report []string
 
@@ -19,7 +24,7 @@ func Prune() ([]string, error) {
 		return report, err
 	}
 
-	response, err := containers.Prune(conn, new(containers.PruneOptions))
+	response, err := containers.Prune(conn, new(containers.PruneOptions).WithFilters(opts.Filters()))
 	if err != nil {
 		return report, err
 	}
@@ -33,3 +38,52 @@ func Prune() ([]string, error) {
 
 	return report, nil
 }
+
+// PrunePreview returns the containers which would be removed by prune and their writable layer size.
+func PrunePreview(opts utils.PruneOptions) ([]utils.PruneReport, error) {
+	log.Debug().Msgf("pdcs: podman container prune preview %v", opts.Filters())
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := containers.List(conn, new(containers.ListOptions).WithAll(true).WithSize(true))
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]utils.PruneReport, 0)
+
+	for _, cnt := range response {
+		if cnt.Pod != "" || !slices.Contains(pruneContainerStates, cnt.State) {
+			continue
+		}
+
+		match, err := opts.Match(cnt.Labels, cnt.Created)
+		if err != nil {
+			return nil, err
+		}
+
+		if !match {
+			continue
+		}
+
+		report := utils.PruneReport{
+			Type: "container",
+			ID:   cnt.ID,
+		}
+
+		if len(cnt.Names) > 0 {
+			report.Name = cnt.Names[0]
+		}
+
+		if cnt.Size != nil {
+			report.Size = cnt.Size.RwSize
+		}
+
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
@@ -0,0 +1,113 @@
+package containers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/rs/zerolog/log"
+	"go.podman.io/podman/v6/pkg/bindings/containers"
+)
+
+const recreateBackupSuffix = "-podman-tui-backup"
+
+var (
+	// ErrRecreateAutoRemove is returned when a running container with auto remove (--rm)
+	// is recreated, stopping the container will remove it and rollback is not possible.
+	ErrRecreateAutoRemove = errors.New("running container has auto remove (--rm) enabled")
+	// ErrRecreateRolledBack is returned when the new container cannot be created or started
+	// and the original container has been restored.
+	ErrRecreateRolledBack = errors.New("container recreate failed, original container restored")
+)
+
+// Recreate replaces an existing container with a new one created from the specified options.
+// The old container is stopped and renamed, the new container is created with the same name
+// (if name is not set) and the running state is restored.
+// On failure the original container name and running state are restored.
+func Recreate(id string, opts CreateOptions) ([]string, string, error) { //nolint:cyclop
+	log.Debug().Msgf("pdcs: podman container recreate %s %v", id, opts)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, "", err
+	}
+
+	cntData, err := containers.Inspect(conn, id, new(containers.InspectOptions))
+	if err != nil {
+		return nil, "", err
+	}
+
+	var (
+		cntName    = cntData.Name
+		wasRunning = cntData.State != nil && cntData.State.Running
+	)
+
+	if wasRunning && cntData.HostConfig != nil && cntData.HostConfig.AutoRemove {
+		return nil, "", ErrRecreateAutoRemove
+	}
+
+	if opts.Name == "" {
+		opts.Name = cntName
+	}
+
+	if wasRunning {
+		if err := Stop(cntData.ID); err != nil {
+			return nil, "", err
+		}
+	}
+
+	rollback := func(cause error) error {
+		var rollbackErrors []error
+
+		if err := Rename(cntData.ID, cntName); err != nil {
+			rollbackErrors = append(rollbackErrors, err)
+		}
+
+		if wasRunning {
+			if err := Start(cntData.ID); err != nil {
+				rollbackErrors = append(rollbackErrors, err)
+			}
+		}
+
+		if len(rollbackErrors) > 0 {
+			return fmt.Errorf("%w: rollback failed: %w", cause, errors.Join(rollbackErrors...))
+		}
+
+		return fmt.Errorf("%w: %w", ErrRecreateRolledBack, cause)
+	}
+
+	if err := Rename(cntData.ID, cntName+recreateBackupSuffix); err != nil {
+		if wasRunning {
+			if startErr := Start(cntData.ID); startErr != nil {
+				return nil, "", fmt.Errorf("%w: %w", err, startErr)
+			}
+		}
+
+		return nil, "", err
+	}
+
+	warnings, newID, err := Create(opts, true)
+	if err != nil {
+		return warnings, "", rollback(err)
+	}
+
+	if wasRunning {
+		if err := Start(newID); err != nil {
+			_, rmErr := containers.Remove(conn, newID, new(containers.RemoveOptions).WithForce(true))
+			if rmErr != nil {
+				warnings = append(warnings, fmt.Sprintf("failed to remove new container %s: %v", newID, rmErr))
+			}
+
+			return warnings, "", rollback(err)
+		}
+	}
+
+	report, err := Remove(cntData.ID)
+	if err != nil {
+		warnings = append(warnings, fmt.Sprintf("failed to remove old container %s: %v", cntData.ID, err))
+	}
+
+	warnings = append(warnings, report...)
+
+	return warnings, newID, nil
+}
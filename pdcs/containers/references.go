@@ -0,0 +1,143 @@
+package containers
+
+import (
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/utils"
+	"github.com/rs/zerolog/log"
+	"go.podman.io/podman/v6/pkg/bindings/containers"
+	"go.podman.io/podman/v6/pkg/bindings/pods"
+)
+
+// References returns the pod, image, volumes, networks and secrets referenced by the container.
+func References(id string) ([]utils.ResourceReference, error) {
+	log.Debug().Msgf("pdcs: podman container references %s", id)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := containers.Inspect(conn, id, new(containers.InspectOptions))
+	if err != nil {
+		return nil, err
+	}
+
+	report := make([]utils.ResourceReference, 0)
+
+	if data.Pod != "" {
+		podName := ""
+
+		podData, err := pods.Inspect(conn, data.Pod, new(pods.InspectOptions))
+		if err != nil {
+			return nil, err
+		}
+
+		if podData.InspectPodData != nil {
+			podName = podData.Name
+		}
+
+		report = append(report, utils.ResourceReference{
+			Kind: utils.ResourceKindPod,
+			ID:   data.Pod,
+			Name: podName,
+		})
+	}
+
+	report = append(report, utils.ResourceReference{
+		Kind: utils.ResourceKindImage,
+		ID:   data.Image,
+		Name: data.ImageName,
+	})
+
+	for _, mount := range data.Mounts {
+		if mount.Type != "volume" {
+			continue
+		}
+
+		report = append(report, utils.ResourceReference{
+			Kind: utils.ResourceKindVolume,
+			Name: mount.Name,
+			Info: mount.Destination,
+		})
+	}
+
+	if data.NetworkSettings != nil {
+		for name, network := range data.NetworkSettings.Networks {
+			ref := utils.ResourceReference{
+				Kind: utils.ResourceKindNetwork,
+				Name: name,
+			}
+
+			if network != nil {
+				ref.ID = network.NetworkID
+				ref.Info = network.IPAddress
+			}
+
+			report = append(report, ref)
+		}
+	}
+
+	if data.Config != nil {
+		for _, secret := range data.Config.Secrets {
+			if secret == nil {
+				continue
+			}
+
+			report = append(report, utils.ResourceReference{
+				Kind: utils.ResourceKindSecret,
+				ID:   secret.ID,
+				Name: secret.Name,
+			})
+		}
+	}
+
+	return report, nil
+}
+
+// UsedByImage returns list of containers which are created from the image.
+func UsedByImage(id string) ([]utils.ResourceReference, error) {
+	return usedBy("ancestor", id)
+}
+
+// UsedByVolume returns list of containers which mount the volume.
+func UsedByVolume(name string) ([]utils.ResourceReference, error) {
+	return usedBy("volume", name)
+}
+
+// UsedByNetwork returns list of containers which are connected to the network.
+func UsedByNetwork(name string) ([]utils.ResourceReference, error) {
+	return usedBy("network", name)
+}
+
+func usedBy(filter string, value string) ([]utils.ResourceReference, error) {
+	log.Debug().Msgf("pdcs: podman container list --filter %s=%s", filter, value)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	listOpts := new(containers.ListOptions).
+		WithAll(true).
+		WithFilters(map[string][]string{filter: {value}})
+
+	cntList, err := containers.List(conn, listOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	report := make([]utils.ResourceReference, 0, len(cntList))
+
+	for _, cnt := range cntList {
+		report = append(report, utils.ResourceReference{
+			Kind: utils.ResourceKindContainer,
+			ID:   cnt.ID,
+			Name: strings.Join(cnt.Names, ","),
+			Info: cnt.State,
+		})
+	}
+
+	return report, nil
+}
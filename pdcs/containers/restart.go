@@ -0,0 +1,19 @@
+package containers
+
+import (
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/rs/zerolog/log"
+	"go.podman.io/podman/v6/pkg/bindings/containers"
+)
+
+// Restart restarts a container.
+func Restart(id string) error {
+	log.Debug().Msgf("pdcs: podman container restart %s", id)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return err
+	}
+
+	return containers.Restart(conn, id, new(containers.RestartOptions))
+}
@@ -0,0 +1,489 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/utils"
+	"github.com/rs/zerolog/log"
+	"go.podman.io/image/v5/manifest"
+	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/pkg/bindings/containers"
+	"go.podman.io/podman/v6/pkg/bindings/images"
+	"go.podman.io/podman/v6/pkg/bindings/pods"
+	"go.podman.io/podman/v6/pkg/inspect"
+)
+
+const (
+	defaultUmask         = "0022"
+	runCmdLineSeparator  = " \\\n  "
+	healthCmdShellPrefix = "CMD-SHELL"
+	healthCmdExecPrefix  = "CMD"
+	healthCmdNone        = "NONE"
+)
+
+// namespace modes which are podman defaults.
+var runCmdDefaultNSModes = []string{"", "private", "shareable", "bridge"}
+
+// runCmdBuilder builds podman run command line, one option per line.
+type runCmdBuilder struct {
+	lines [][]string
+}
+
+func (b *runCmdBuilder) add(args ...string) {
+	b.lines = append(b.lines, args)
+}
+
+func (b *runCmdBuilder) addValue(flag string, value string) {
+	if value != "" {
+		b.add(flag, value)
+	}
+}
+
+func (b *runCmdBuilder) addValues(flag string, values []string) {
+	for _, value := range values {
+		b.addValue(flag, value)
+	}
+}
+
+func (b *runCmdBuilder) addBool(flag string, value bool) {
+	if value {
+		b.add(flag)
+	}
+}
+
+func (b *runCmdBuilder) String() string {
+	lines := make([]string, 0, len(b.lines))
+
+	for _, line := range b.lines {
+		quoted := make([]string, 0, len(line))
+		for _, arg := range line {
+			quoted = append(quoted, utils.ShellQuote(arg))
+		}
+
+		lines = append(lines, strings.Join(quoted, " "))
+	}
+
+	return strings.Join(lines, runCmdLineSeparator)
+}
+
+// RunCommand returns podman run command line which recreates the container
+// from its inspect data.
+func RunCommand(id string) (string, error) {
+	log.Debug().Msgf("pdcs: podman container run command %s", id)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return "", err
+	}
+
+	cntData, err := containers.Inspect(conn, id, new(containers.InspectOptions))
+	if err != nil {
+		return "", err
+	}
+
+	// image data is used to skip the options inherited from the image
+	imgData := &inspect.ImageData{}
+
+	imgReport, err := images.GetImage(conn, cntData.Image, nil)
+	if err == nil && imgReport.ImageData != nil {
+		imgData = imgReport.ImageData
+	} else {
+		log.Debug().Msgf("pdcs: podman container run command image %s inspect: %v", cntData.Image, err)
+	}
+
+	podName := cntData.Pod
+	if cntData.Pod != "" {
+		podReport, err := pods.Inspect(conn, cntData.Pod, nil)
+		if err == nil {
+			podName = podReport.Name
+		}
+	}
+
+	return runCommandFromInspect(cntData, imgData, podName), nil
+}
+
+func runCommandFromInspect(cntData *define.InspectContainerData, imgData *inspect.ImageData, podName string) string {
+	builder := &runCmdBuilder{}
+
+	builder.add("podman", "run", "--detach")
+	builder.addValue("--name", cntData.Name)
+	builder.addValue("--pod", podName)
+
+	if cntData.Config == nil {
+		cntData.Config = &define.InspectContainerConfig{}
+	}
+
+	if cntData.HostConfig == nil {
+		cntData.HostConfig = &define.InspectContainerHostConfig{}
+	}
+
+	runCmdConfigArgs(builder, cntData, imgData)
+	runCmdEnvArgs(builder, cntData.Config, imgData)
+
+	if cntData.Pod == "" {
+		runCmdNetworkArgs(builder, cntData)
+		runCmdNamespaceArgs(builder, cntData.HostConfig)
+	}
+
+	runCmdVolumeArgs(builder, cntData)
+	runCmdResourceArgs(builder, cntData.HostConfig)
+	runCmdSecurityArgs(builder, cntData.HostConfig)
+	runCmdHealthArgs(builder, cntData.Config, imgData)
+
+	// image and command
+	image := cntData.ImageName
+	if image == "" {
+		image = cntData.Image
+	}
+
+	builder.add(append([]string{image}, containerCommand(cntData.Config, imgData)...)...)
+
+	return builder.String()
+}
+
+func runCmdConfigArgs(builder *runCmdBuilder, cntData *define.InspectContainerData, imgData *inspect.ImageData) { //nolint:cyclop
+	config := cntData.Config
+	hostConfig := cntData.HostConfig
+
+	builder.addBool("--interactive", config.OpenStdin)
+	builder.addBool("--tty", config.Tty)
+	builder.addBool("--rm", hostConfig.AutoRemove)
+	builder.addBool("--init", hostConfig.Init)
+	builder.addBool("--read-only", hostConfig.ReadonlyRootfs)
+
+	if hostConfig.RestartPolicy != nil && hostConfig.RestartPolicy.Name != "" && hostConfig.RestartPolicy.Name != "no" {
+		policy := hostConfig.RestartPolicy.Name
+		if hostConfig.RestartPolicy.MaximumRetryCount > 0 {
+			policy = fmt.Sprintf("%s:%d", policy, hostConfig.RestartPolicy.MaximumRetryCount)
+		}
+
+		builder.add("--restart", policy)
+	}
+
+	// hostname defaults to the short container ID and is inherited from the pod
+	if cntData.Pod == "" && config.Hostname != "" && !strings.HasPrefix(cntData.ID, config.Hostname) {
+		builder.add("--hostname", config.Hostname)
+	}
+
+	if config.Timeout > 0 {
+		builder.add("--timeout", strconv.FormatUint(uint64(config.Timeout), 10))
+	}
+
+	if config.Umask != "" && config.Umask != defaultUmask {
+		builder.add("--umask", config.Umask)
+	}
+
+	for _, secret := range config.Secrets {
+		if secret != nil {
+			builder.add("--secret", secret.Name)
+		}
+	}
+
+	user, workDir, entrypoint := containerProcessOverrides(config, imgData)
+
+	builder.addValue("--user", user)
+	builder.addValue("--workdir", workDir)
+	builder.addValue("--entrypoint", entrypoint)
+}
+
+func runCmdEnvArgs(builder *runCmdBuilder, config *define.InspectContainerConfig, imgData *inspect.ImageData) {
+	builder.addValues("--env", containerEnvVars(config, imgData))
+	builder.addValues("--label", containerLabels(config, imgData))
+}
+
+func runCmdNetworkArgs(builder *runCmdBuilder, cntData *define.InspectContainerData) { //nolint:cyclop
+	hostConfig := cntData.HostConfig
+
+	networkMode := hostConfig.NetworkMode
+	if strings.HasPrefix(networkMode, "container:") || networkMode == "host" || networkMode == "none" ||
+		networkMode == "slirp4netns" || networkMode == "pasta" || strings.HasPrefix(networkMode, "ns:") {
+		builder.add("--network", networkMode)
+	} else if cntData.NetworkSettings != nil {
+		networks := make([]string, 0, len(cntData.NetworkSettings.Networks))
+		for netName := range cntData.NetworkSettings.Networks {
+			networks = append(networks, netName)
+		}
+
+		sort.Strings(networks)
+
+		for _, netName := range networks {
+			var aliases []string
+
+			for _, alias := range cntData.NetworkSettings.Networks[netName].Aliases {
+				// container name and short ID are default aliases
+				if alias != cntData.Name && !strings.HasPrefix(cntData.ID, alias) {
+					aliases = append(aliases, "alias="+alias)
+				}
+			}
+
+			network := netName
+			if len(aliases) > 0 {
+				network = network + ":" + strings.Join(aliases, ",")
+			}
+
+			// the default network is used without --network option
+			if network != "podman" {
+				builder.add("--network", network)
+			}
+		}
+	}
+
+	ports := make([]string, 0, len(hostConfig.PortBindings))
+
+	for cntPort, hostPorts := range hostConfig.PortBindings {
+		for _, hostPort := range hostPorts {
+			publish := fmt.Sprintf("%s:%s", hostPort.HostPort, strings.TrimSuffix(cntPort, "/tcp"))
+			if hostPort.HostIP != "" && hostPort.HostIP != "0.0.0.0" {
+				publish = fmt.Sprintf("%s:%s", hostPort.HostIP, publish)
+			}
+
+			ports = append(ports, publish)
+		}
+	}
+
+	sort.Strings(ports)
+	builder.addValues("--publish", ports)
+	builder.addBool("--publish-all", hostConfig.PublishAllPorts)
+	builder.addValues("--dns", hostConfig.Dns)
+	builder.addValues("--dns-option", hostConfig.DnsOptions)
+	builder.addValues("--dns-search", hostConfig.DnsSearch)
+	builder.addValues("--add-host", hostConfig.ExtraHosts)
+}
+
+func runCmdNamespaceArgs(builder *runCmdBuilder, hostConfig *define.InspectContainerHostConfig) {
+	namespaces := []struct {
+		flag string
+		mode string
+	}{
+		{flag: "--ipc", mode: hostConfig.IpcMode},
+		{flag: "--pid", mode: hostConfig.PidMode},
+		{flag: "--uts", mode: hostConfig.UTSMode},
+		{flag: "--userns", mode: hostConfig.UsernsMode},
+		{flag: "--cgroupns", mode: hostConfig.CgroupMode},
+	}
+
+	for _, namespace := range namespaces {
+		if !slices.Contains(runCmdDefaultNSModes, namespace.mode) {
+			builder.add(namespace.flag, namespace.mode)
+		}
+	}
+}
+
+func runCmdVolumeArgs(builder *runCmdBuilder, cntData *define.InspectContainerData) {
+	hostConfig := cntData.HostConfig
+
+	builder.addValues("--volume", hostConfig.Binds)
+
+	builder.addValues("--mount", containerExtraMounts(cntData))
+
+	tmpfs := make([]string, 0, len(hostConfig.Tmpfs))
+
+	for path, tmpfsOpts := range hostConfig.Tmpfs {
+		spec := path
+		if tmpfsOpts != "" {
+			spec = spec + ":" + tmpfsOpts
+		}
+
+		tmpfs = append(tmpfs, spec)
+	}
+
+	sort.Strings(tmpfs)
+	builder.addValues("--tmpfs", tmpfs)
+
+	for _, device := range hostConfig.Devices {
+		spec := device.PathOnHost
+		if device.PathInContainer != "" && device.PathInContainer != device.PathOnHost {
+			spec = spec + ":" + device.PathInContainer
+		}
+
+		if device.CgroupPermissions != "" && device.CgroupPermissions != "rwm" {
+			spec = spec + ":" + device.CgroupPermissions
+		}
+
+		builder.add("--device", spec)
+	}
+
+	builder.addValues("--volumes-from", hostConfig.VolumesFrom)
+}
+
+func runCmdResourceArgs(builder *runCmdBuilder, hostConfig *define.InspectContainerHostConfig) { //nolint:cyclop
+	if hostConfig.Memory > 0 {
+		builder.add("--memory", strconv.FormatInt(hostConfig.Memory, 10))
+	}
+
+	if hostConfig.MemoryReservation > 0 {
+		builder.add("--memory-reservation", strconv.FormatInt(hostConfig.MemoryReservation, 10))
+	}
+
+	// podman sets the swap limit to twice the memory limit by default
+	if hostConfig.MemorySwap > 0 && hostConfig.MemorySwap != 2*hostConfig.Memory {
+		builder.add("--memory-swap", strconv.FormatInt(hostConfig.MemorySwap, 10))
+	}
+
+	if hostConfig.MemorySwappiness != nil && *hostConfig.MemorySwappiness >= 0 {
+		builder.add("--memory-swappiness", strconv.FormatInt(*hostConfig.MemorySwappiness, 10))
+	}
+
+	if hostConfig.NanoCpus > 0 {
+		builder.add("--cpus", strconv.FormatFloat(float64(hostConfig.NanoCpus)/nanoCPUs, 'f', -1, 64))
+	} else {
+		if hostConfig.CpuPeriod > 0 {
+			builder.add("--cpu-period", strconv.FormatUint(hostConfig.CpuPeriod, 10))
+		}
+
+		if hostConfig.CpuQuota > 0 {
+			builder.add("--cpu-quota", strconv.FormatInt(hostConfig.CpuQuota, 10))
+		}
+	}
+
+	if hostConfig.CpuShares > 0 {
+		builder.add("--cpu-shares", strconv.FormatUint(hostConfig.CpuShares, 10))
+	}
+
+	if hostConfig.CpuRealtimePeriod > 0 {
+		builder.add("--cpu-rt-period", strconv.FormatUint(hostConfig.CpuRealtimePeriod, 10))
+	}
+
+	if hostConfig.CpuRealtimeRuntime > 0 {
+		builder.add("--cpu-rt-runtime", strconv.FormatInt(hostConfig.CpuRealtimeRuntime, 10))
+	}
+
+	builder.addValue("--cpuset-cpus", hostConfig.CpusetCpus)
+	builder.addValue("--cpuset-mems", hostConfig.CpusetMems)
+
+	if hostConfig.ShmSize > 0 && hostConfig.ShmSize != defaultShmSize {
+		builder.add("--shm-size", strconv.FormatInt(hostConfig.ShmSize, 10))
+	}
+}
+
+func runCmdSecurityArgs(builder *runCmdBuilder, hostConfig *define.InspectContainerHostConfig) {
+	builder.addBool("--privileged", hostConfig.Privileged)
+
+	// privileged containers have all the capabilities
+	if !hostConfig.Privileged {
+		builder.addValues("--cap-add", hostConfig.CapAdd)
+		builder.addValues("--cap-drop", hostConfig.CapDrop)
+	}
+
+	builder.addValues("--group-add", hostConfig.GroupAdd)
+	builder.addValues("--security-opt", hostConfig.SecurityOpt)
+}
+
+func runCmdHealthArgs(builder *runCmdBuilder, config *define.InspectContainerConfig, imgData *inspect.ImageData) { //nolint:cyclop
+	health := config.Healthcheck
+
+	if health == nil || len(health.Test) == 0 {
+		// healthcheck defined by the image has been disabled
+		if imgData.HealthCheck != nil && len(imgData.HealthCheck.Test) > 0 && imgData.HealthCheck.Test[0] != healthCmdNone {
+			builder.add("--no-healthcheck")
+		}
+
+		return
+	}
+
+	if imgData.HealthCheck != nil && healthConfigEqual(health, imgData.HealthCheck) {
+		return
+	}
+
+	if !runCmdHealthCmdArgs(builder, "--health-cmd", health.Test) {
+		return
+	}
+
+	runCmdHealthDurationArg(builder, "--health-interval", health.Interval, define.DefaultHealthCheckInterval)
+	runCmdHealthDurationArg(builder, "--health-timeout", health.Timeout, define.DefaultHealthCheckTimeout)
+	runCmdHealthDurationArg(builder, "--health-start-period", health.StartPeriod, define.DefaultHealthCheckStartPeriod)
+
+	if health.Retries > 0 && uint(health.Retries) != define.DefaultHealthCheckRetries {
+		builder.add("--health-retries", strconv.Itoa(health.Retries))
+	}
+
+	if config.HealthcheckOnFailureAction != "" && config.HealthcheckOnFailureAction != "none" {
+		builder.add("--health-on-failure", config.HealthcheckOnFailureAction)
+	}
+
+	if config.HealthLogDestination != "" && config.HealthLogDestination != define.DefaultHealthCheckLocalDestination {
+		builder.add("--health-log-destination", config.HealthLogDestination)
+	}
+
+	if config.HealthMaxLogCount > 0 && config.HealthMaxLogCount != define.DefaultHealthMaxLogCount {
+		builder.add("--health-max-log-count", strconv.FormatUint(uint64(config.HealthMaxLogCount), 10))
+	}
+
+	if config.HealthMaxLogSize > 0 && config.HealthMaxLogSize != define.DefaultHealthMaxLogSize {
+		builder.add("--health-max-log-size", strconv.FormatUint(uint64(config.HealthMaxLogSize), 10))
+	}
+
+	startup := config.StartupHealthCheck
+	if startup == nil || !runCmdHealthCmdArgs(builder, "--health-startup-cmd", startup.Test) {
+		return
+	}
+
+	runCmdHealthDurationArg(builder, "--health-startup-interval", startup.Interval, define.DefaultHealthCheckInterval)
+	runCmdHealthDurationArg(builder, "--health-startup-timeout", startup.Timeout, define.DefaultHealthCheckTimeout)
+
+	if startup.Retries > 0 {
+		builder.add("--health-startup-retries", strconv.Itoa(startup.Retries))
+	}
+
+	if startup.Successes > 0 {
+		builder.add("--health-startup-success", strconv.Itoa(startup.Successes))
+	}
+}
+
+// runCmdHealthCmdArgs adds the healthcheck command and returns false if there is no command.
+func runCmdHealthCmdArgs(builder *runCmdBuilder, flag string, test []string) bool {
+	cmd := healthCmdString(test)
+	if cmd == "" {
+		return false
+	}
+
+	builder.add(flag, cmd)
+
+	return true
+}
+
+// healthCmdString returns the healthcheck command in podman --health-cmd format,
+// empty if there is no command.
+func healthCmdString(test []string) string {
+	if len(test) == 0 {
+		return ""
+	}
+
+	switch test[0] {
+	case healthCmdNone:
+		return ""
+	case healthCmdShellPrefix:
+		return strings.Join(test[1:], " ")
+	case healthCmdExecPrefix:
+		return jsonArray(test[1:])
+	default:
+		return jsonArray(test)
+	}
+}
+
+func runCmdHealthDurationArg(builder *runCmdBuilder, flag string, value time.Duration, defaultValue string) {
+	if value > 0 && value.String() != defaultValue {
+		builder.add(flag, value.String())
+	}
+}
+
+func healthConfigEqual(a *manifest.Schema2HealthConfig, b *manifest.Schema2HealthConfig) bool {
+	return slices.Equal(a.Test, b.Test) && a.Interval == b.Interval && a.Timeout == b.Timeout &&
+		a.StartPeriod == b.StartPeriod && a.Retries == b.Retries
+}
+
+func jsonArray(values []string) string {
+	data, err := json.Marshal(values)
+	if err != nil {
+		return strings.Join(values, " ")
+	}
+
+	return string(data)
+}
@@ -0,0 +1,125 @@
+package containers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	pimages "github.com/containers/podman-tui/pdcs/images"
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/rs/zerolog/log"
+	"go.podman.io/podman/v6/pkg/bindings/containers"
+	"go.podman.io/podman/v6/pkg/bindings/images"
+)
+
+var (
+	// ErrUpgradeImageUpToDate is returned when the container already uses the latest image.
+	ErrUpgradeImageUpToDate = errors.New("container image is up to date")
+	// ErrUpgradeUnmappedSettings is returned when the container has settings
+	// which would be lost by recreating it on another image.
+	ErrUpgradeUnmappedSettings = errors.New("container settings cannot be recreated")
+)
+
+// UpgradeReport container image upgrade report.
+type UpgradeReport struct {
+	ContainerID   string
+	ContainerName string
+	ImageRef      string
+	OldImageID    string
+	OldDigest     string
+	NewImageID    string
+	NewDigest     string
+	// Unmapped are the container settings which block the upgrade.
+	Unmapped []string
+}
+
+// Updated returns true if the pulled image differs from the container image.
+func (r UpgradeReport) Updated() bool {
+	return r.OldImageID != r.NewImageID
+}
+
+// UpgradeCheck pulls the image reference (container image name if empty) and
+// returns the container current and pulled image digests and the settings which block the upgrade.
+func UpgradeCheck(id string, imageRef string) (UpgradeReport, error) {
+	log.Debug().Msgf("pdcs: podman container upgrade check %s %s", id, imageRef)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return UpgradeReport{}, err
+	}
+
+	cntData, err := containers.Inspect(conn, id, new(containers.InspectOptions))
+	if err != nil {
+		return UpgradeReport{}, err
+	}
+
+	report := UpgradeReport{
+		ContainerID:   cntData.ID,
+		ContainerName: cntData.Name,
+		ImageRef:      imageRef,
+		OldImageID:    cntData.Image,
+	}
+
+	if report.ImageRef == "" {
+		report.ImageRef = cntData.ImageName
+	}
+
+	oldImage, err := images.GetImage(conn, report.OldImageID, new(images.GetOptions))
+	if err != nil {
+		return report, err
+	}
+
+	report.OldDigest = oldImage.Digest.String()
+
+	if err := pimages.Pull(report.ImageRef); err != nil {
+		return report, err
+	}
+
+	newImage, err := images.GetImage(conn, report.ImageRef, new(images.GetOptions))
+	if err != nil {
+		return report, err
+	}
+
+	report.NewImageID = newImage.ID
+	report.NewDigest = newImage.Digest.String()
+
+	_, report.Unmapped, err = ContainerCreateOptions(cntData.ID)
+
+	return report, err
+}
+
+// Upgrade recreates the container from its current configuration on the pulled image.
+// The old image is not removed and can be used for rollback.
+func Upgrade(report UpgradeReport) ([]string, string, error) {
+	log.Debug().Msgf("pdcs: podman container upgrade %s %s", report.ContainerID, report.ImageRef)
+
+	if !report.Updated() {
+		return nil, "", ErrUpgradeImageUpToDate
+	}
+
+	return recreateWithImage(report.ContainerID, report.ImageRef)
+}
+
+// UpgradeRollback recreates the upgraded container on its old image.
+func UpgradeRollback(id string, report UpgradeReport) ([]string, string, error) {
+	log.Debug().Msgf("pdcs: podman container upgrade rollback %s %s", id, report.OldImageID)
+
+	return recreateWithImage(id, report.OldImageID)
+}
+
+// recreateWithImage recreates the container on the image, the container is not recreated
+// if any of its settings cannot be mapped to the create options.
+func recreateWithImage(id string, image string) ([]string, string, error) {
+	createOpts, unmapped, err := ContainerCreateOptions(id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(unmapped) > 0 {
+		return nil, "", fmt.Errorf("%w: %s", ErrUpgradeUnmappedSettings, strings.Join(unmapped, ", "))
+	}
+
+	createOpts.Image = image
+
+	return Recreate(id, createOpts)
+}
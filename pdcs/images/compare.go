@@ -0,0 +1,161 @@
+package images
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/utils"
+	"github.com/docker/go-units"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/rs/zerolog/log"
+	"go.podman.io/podman/v6/pkg/bindings/images"
+	"go.podman.io/podman/v6/pkg/inspect"
+	"go.podman.io/storage/pkg/archive"
+)
+
+// image compare report sections.
+const (
+	CompareSectionConfig = "config"
+	CompareSectionEnv    = "env"
+	CompareSectionLabels = "labels"
+	CompareSectionPorts  = "ports"
+	CompareSectionLayers = "layers"
+	CompareSectionFiles  = "files"
+)
+
+// Compare returns the differences between config, layers and files of two images.
+func Compare(leftID string, rightID string) ([]utils.CompareEntry, error) {
+	log.Debug().Msgf("pdcs: podman image compare %s %s", leftID, rightID)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	left, err := images.GetImage(conn, leftID, new(images.GetOptions))
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := images.GetImage(conn, rightID, new(images.GetOptions))
+	if err != nil {
+		return nil, err
+	}
+
+	report := compareImagesData(left.ImageData, right.ImageData)
+
+	// file level differences of the left image against the right image
+	diffType := "image"
+	diffOpts := new(images.DiffOptions)
+	diffOpts.Parent = &rightID
+	diffOpts.DiffType = &diffType
+
+	changes, err := images.Diff(conn, leftID, diffOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	report = append(report, compareImagesChanges(changes)...)
+
+	return report, nil
+}
+
+func compareImagesData(left *inspect.ImageData, right *inspect.ImageData) []utils.CompareEntry {
+	var leftConfig, rightConfig v1.ImageConfig
+
+	if left.Config != nil {
+		leftConfig = *left.Config
+	}
+
+	if right.Config != nil {
+		rightConfig = *right.Config
+	}
+
+	report := []utils.CompareEntry{
+		utils.CompareValue(CompareSectionConfig, "os/arch",
+			left.Os+"/"+left.Architecture, right.Os+"/"+right.Architecture),
+		utils.CompareValue(CompareSectionConfig, "size",
+			units.HumanSize(float64(left.Size)), units.HumanSize(float64(right.Size))),
+		utils.CompareValue(CompareSectionConfig, "user", leftConfig.User, rightConfig.User),
+		utils.CompareValue(CompareSectionConfig, "workdir", leftConfig.WorkingDir, rightConfig.WorkingDir),
+		utils.CompareValue(CompareSectionConfig, "entrypoint",
+			strings.Join(leftConfig.Entrypoint, " "), strings.Join(rightConfig.Entrypoint, " ")),
+		utils.CompareValue(CompareSectionConfig, "cmd",
+			strings.Join(leftConfig.Cmd, " "), strings.Join(rightConfig.Cmd, " ")),
+		utils.CompareValue(CompareSectionConfig, "stop signal", leftConfig.StopSignal, rightConfig.StopSignal),
+	}
+
+	report = append(report, utils.CompareSets(CompareSectionConfig, "volume",
+		slices.Sorted(maps.Keys(leftConfig.Volumes)),
+		slices.Sorted(maps.Keys(rightConfig.Volumes)))...)
+	report = append(report, utils.CompareMaps(CompareSectionEnv,
+		utils.KeyValueToMap(leftConfig.Env), utils.KeyValueToMap(rightConfig.Env))...)
+	report = append(report, utils.CompareMaps(CompareSectionLabels, left.Labels, right.Labels)...)
+	report = append(report, utils.CompareSets(CompareSectionPorts, "exposed",
+		slices.Sorted(maps.Keys(leftConfig.ExposedPorts)),
+		slices.Sorted(maps.Keys(rightConfig.ExposedPorts)))...)
+
+	// layers are compared by position
+	leftLayers := imageLayersDigests(left)
+	rightLayers := imageLayersDigests(right)
+
+	for i := range max(len(leftLayers), len(rightLayers)) {
+		var leftLayer, rightLayer string
+
+		if i < len(leftLayers) {
+			leftLayer = leftLayers[i]
+		}
+
+		if i < len(rightLayers) {
+			rightLayer = rightLayers[i]
+		}
+
+		report = append(report, utils.CompareValue(CompareSectionLayers,
+			fmt.Sprintf("layer %d", i+1), leftLayer, rightLayer))
+	}
+
+	return report
+}
+
+func compareImagesChanges(changes []archive.Change) []utils.CompareEntry {
+	report := make([]utils.CompareEntry, 0, len(changes))
+
+	for _, change := range changes {
+		entry := utils.CompareValue(CompareSectionFiles, change.Path, "", "")
+
+		switch change.Kind {
+		case archive.ChangeAdd:
+			entry.Left = "present"
+		case archive.ChangeDelete:
+			entry.Right = "present"
+		case archive.ChangeModify:
+			entry.Left = "modified"
+			entry.Right = "original"
+		}
+
+		report = append(report, entry)
+	}
+
+	slices.SortFunc(report, func(a, b utils.CompareEntry) int {
+		return strings.Compare(a.Field, b.Field)
+	})
+
+	return report
+}
+
+func imageLayersDigests(data *inspect.ImageData) []string {
+	if data.RootFS == nil {
+		return nil
+	}
+
+	layers := make([]string, 0, len(data.RootFS.Layers))
+
+	for _, layer := range data.RootFS.Layers {
+		layers = append(layers, layer.String())
+	}
+
+	return layers
+}
@@ -0,0 +1,85 @@
+package images
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/utils"
+	"github.com/docker/go-units"
+	"github.com/rs/zerolog/log"
+	"go.podman.io/podman/v6/pkg/bindings/containers"
+	"go.podman.io/podman/v6/pkg/bindings/images"
+)
+
+// image details report sections.
+const (
+	DetailsSectionImage      = "image"
+	DetailsSectionPorts      = "ports"
+	DetailsSectionLabels     = "labels"
+	DetailsSectionContainers = "containers"
+)
+
+// Details returns summary of image config, labels and the containers which use it.
+func Details(id string) ([]utils.DetailsEntry, error) {
+	log.Debug().Msgf("pdcs: podman image details %s", id)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := images.GetImage(conn, id, new(images.GetOptions))
+	if err != nil {
+		return nil, err
+	}
+
+	data := response.ImageData
+
+	report := []utils.DetailsEntry{
+		utils.DetailsValue(DetailsSectionImage, "tags", strings.Join(data.RepoTags, ", ")),
+		utils.DetailsValue(DetailsSectionImage, "os/arch", data.Os+"/"+data.Architecture),
+		utils.DetailsValue(DetailsSectionImage, "size", units.HumanSize(float64(data.Size))),
+		utils.DetailsValue(DetailsSectionImage, "layers", strconv.Itoa(len(imageLayersDigests(data)))),
+	}
+
+	if data.Created != nil {
+		report = append(report, utils.DetailsValue(DetailsSectionImage, "created",
+			units.HumanDuration(time.Since(*data.Created))+" ago"))
+	}
+
+	if data.Config != nil {
+		report = append(report,
+			utils.DetailsValue(DetailsSectionImage, "user", data.Config.User),
+			utils.DetailsValue(DetailsSectionImage, "workdir", data.Config.WorkingDir),
+			utils.DetailsValue(DetailsSectionImage, "command",
+				strings.Join(slices.Concat(data.Config.Entrypoint, data.Config.Cmd), " ")),
+		)
+		report = append(report, utils.DetailsList(DetailsSectionPorts,
+			slices.Sorted(maps.Keys(data.Config.ExposedPorts)))...)
+	}
+
+	report = append(report, utils.DetailsMap(DetailsSectionLabels, data.Labels)...)
+
+	// containers created from the image
+	listOpts := new(containers.ListOptions).
+		WithAll(true).
+		WithFilters(map[string][]string{"ancestor": {data.ID}})
+
+	cntList, err := containers.List(conn, listOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	cntReport := make(map[string]string)
+	for _, cnt := range cntList {
+		cntReport[strings.Join(cnt.Names, ",")] = cnt.State
+	}
+
+	report = append(report, utils.DetailsMap(DetailsSectionContainers, cntReport)...)
+
+	return report, nil
+}
@@ -0,0 +1,446 @@
+package images
+
+import (
+	"archive/tar"
+	"bufio"
+	"cmp"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/rs/zerolog/log"
+	"go.podman.io/podman/v6/pkg/bindings/images"
+)
+
+// image layer file status.
+const (
+	LayerFileAdded    = "added"
+	LayerFileModified = "modified"
+	LayerFileDeleted  = "deleted"
+)
+
+const (
+	whiteoutPrefix = ".wh."
+	whiteoutOpaque = ".wh..wh..opq"
+)
+
+var errLayersManifest = errors.New("image archive manifest not found")
+
+// ImageLayer implements an image layer with its history and files.
+type ImageLayer struct {
+	ID        string
+	CreatedBy string
+	Size      int64
+	Files     []ImageLayerFile
+}
+
+// ImageLayerFile implements a file entry of an image layer.
+type ImageLayerFile struct {
+	Path    string
+	Size    int64
+	IsDir   bool
+	Deleted bool
+	Opaque  bool
+}
+
+// ImageLayerTreeEntry implements a file of the cumulative image filesystem at a layer.
+type ImageLayerTreeEntry struct {
+	Path   string
+	Size   int64
+	IsDir  bool
+	Status string
+	// Wasted is the file size if the file is overwritten or removed by a later layer.
+	Wasted int64
+}
+
+// ImageWastedFile implements a file which is overwritten or removed by a later layer.
+type ImageWastedFile struct {
+	Path  string
+	Size  int64
+	Count int
+}
+
+type archiveManifest struct {
+	Config string
+	Layers []string
+}
+
+// Layers returns image layers from the image history and root filesystem layers.
+// The layers files are not set, see LayersFiles.
+func Layers(id string) ([]ImageLayer, error) {
+	log.Debug().Msgf("pdcs: podman image layers %s", id)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	imageData, err := images.GetImage(conn, id, new(images.GetOptions))
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := images.History(conn, id, new(images.HistoryOptions))
+	if err != nil {
+		return nil, err
+	}
+
+	layerIDs := make([]string, 0)
+
+	if imageData.RootFS != nil {
+		for _, layerID := range imageData.RootFS.Layers {
+			layerIDs = append(layerIDs, layerID.String())
+		}
+	}
+
+	// history is ordered from the newest entry and empty layers have no size
+	layersHistory := make([]ImageLayer, 0, len(history))
+
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].Size > 0 {
+			layersHistory = append(layersHistory, ImageLayer{
+				CreatedBy: history[i].CreatedBy,
+				Size:      history[i].Size,
+			})
+		}
+	}
+
+	if len(layersHistory) != len(layerIDs) {
+		log.Debug().Msgf("pdcs: podman image layers %s history entries %d, layers %d",
+			id, len(layersHistory), len(layerIDs))
+	}
+
+	layers := make([]ImageLayer, 0, len(layerIDs))
+
+	for i, layerID := range layerIDs {
+		layer := ImageLayer{ID: layerID}
+
+		if i < len(layersHistory) {
+			layer = layersHistory[i]
+			layer.ID = layerID
+		}
+
+		layers = append(layers, layer)
+	}
+
+	return layers, nil
+}
+
+// LayersFiles returns the files of each image layer, in the image layers order.
+// The image is exported as docker-archive and the layers tarballs are read on the fly.
+func LayersFiles(id string) ([][]ImageLayerFile, error) {
+	log.Debug().Msgf("pdcs: podman image layers files %s", id)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	reader, writer := io.Pipe()
+
+	go func() {
+		format := "docker-archive"
+		exportOpts := new(images.ExportOptions).WithFormat(format).WithCompress(false)
+
+		writer.CloseWithError(images.Export(conn, []string{id}, writer, exportOpts))
+	}()
+
+	files, err := readImageArchive(reader)
+
+	// stops the export request if the archive is not fully read
+	reader.Close()
+
+	return files, err
+}
+
+func readImageArchive(reader io.Reader) ([][]ImageLayerFile, error) {
+	var manifest []archiveManifest
+
+	files := make(map[string][]ImageLayerFile)
+	archive := tar.NewReader(reader)
+
+	for {
+		header, err := archive.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		name := path.Clean(header.Name)
+
+		switch {
+		case name == "manifest.json":
+			if err := json.NewDecoder(archive).Decode(&manifest); err != nil {
+				return nil, err
+			}
+		case strings.HasSuffix(name, ".json"):
+			continue
+		case header.Typeflag == tar.TypeReg:
+			// other archive files (repositories, VERSION, ...) are not tarballs
+			layerFiles, err := readLayer(archive)
+			if err != nil {
+				log.Debug().Msgf("pdcs: podman image layers skipping %s: %v", name, err)
+
+				continue
+			}
+
+			files[name] = layerFiles
+		}
+	}
+
+	if len(manifest) == 0 {
+		return nil, errLayersManifest
+	}
+
+	layersFiles := make([][]ImageLayerFile, 0, len(manifest[0].Layers))
+
+	for _, layerName := range manifest[0].Layers {
+		layersFiles = append(layersFiles, files[path.Clean(layerName)])
+	}
+
+	return layersFiles, nil
+}
+
+func readLayer(reader io.Reader) ([]ImageLayerFile, error) {
+	bufReader := bufio.NewReader(reader)
+
+	// layers can be compressed, gzip magic header
+	if magic, err := bufReader.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b { //nolint:mnd
+		gzipReader, err := gzip.NewReader(bufReader)
+		if err != nil {
+			return nil, err
+		}
+
+		defer gzipReader.Close()
+
+		return readLayerTar(gzipReader)
+	}
+
+	return readLayerTar(bufReader)
+}
+
+func readLayerTar(reader io.Reader) ([]ImageLayerFile, error) {
+	files := make([]ImageLayerFile, 0)
+	layerTar := tar.NewReader(reader)
+
+	for {
+		header, err := layerTar.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		name := path.Clean("/" + header.Name)
+		if name == "/" {
+			continue
+		}
+
+		dir, base := path.Split(name)
+
+		file := ImageLayerFile{
+			Path:  name,
+			Size:  header.Size,
+			IsDir: header.Typeflag == tar.TypeDir,
+		}
+
+		switch {
+		case base == whiteoutOpaque:
+			file = ImageLayerFile{Path: path.Clean(dir), IsDir: true, Opaque: true}
+		case strings.HasPrefix(base, whiteoutPrefix):
+			file = ImageLayerFile{Path: path.Join(dir, strings.TrimPrefix(base, whiteoutPrefix)), Deleted: true}
+		case header.Typeflag != tar.TypeReg:
+			file.Size = 0
+		}
+
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+// LayerTree returns the cumulative image filesystem at the specified layer index.
+// Files are marked as added, modified or deleted by the layer and wasted size
+// is set for files which are overwritten or removed by a later layer.
+func LayerTree(layers []ImageLayer, index int) []ImageLayerTreeEntry { //nolint:cyclop
+	type treeEntry struct {
+		ImageLayerTreeEntry
+
+		layer int
+	}
+
+	entries := make(map[string]*treeEntry)
+
+	// removes the directory content added by the previous layers
+	removeTree := func(dir string, layer int, current bool) {
+		for entryPath, entry := range entries {
+			if entry.layer == layer || !isPathUnder(entryPath, dir) {
+				continue
+			}
+
+			if current {
+				entry.Status = LayerFileDeleted
+
+				continue
+			}
+
+			delete(entries, entryPath)
+		}
+	}
+
+	for i := 0; i <= index && i < len(layers); i++ {
+		current := i == index
+
+		for _, file := range layers[i].Files {
+			if file.Opaque {
+				removeTree(file.Path, i, current)
+
+				continue
+			}
+
+			if file.Deleted {
+				if entry, ok := entries[file.Path]; ok {
+					if current {
+						entry.Status = LayerFileDeleted
+					} else {
+						delete(entries, file.Path)
+					}
+				}
+
+				removeTree(file.Path, i, current)
+
+				continue
+			}
+
+			status := ""
+
+			existing, ok := entries[file.Path]
+			if current {
+				switch {
+				case !ok:
+					status = LayerFileAdded
+				case !file.IsDir:
+					status = LayerFileModified
+				case existing.Status != LayerFileDeleted:
+					status = existing.Status
+				}
+			}
+
+			entries[file.Path] = &treeEntry{
+				ImageLayerTreeEntry: ImageLayerTreeEntry{
+					Path:   file.Path,
+					Size:   file.Size,
+					IsDir:  file.IsDir,
+					Status: status,
+				},
+				layer: i,
+			}
+		}
+	}
+
+	changedAt := layersChangedPaths(layers)
+	report := make([]ImageLayerTreeEntry, 0, len(entries))
+
+	for _, entry := range entries {
+		if !entry.IsDir && entry.Status != LayerFileDeleted && isChangedAfter(changedAt, entry.Path, entry.layer) {
+			entry.Wasted = entry.Size
+		}
+
+		report = append(report, entry.ImageLayerTreeEntry)
+	}
+
+	slices.SortFunc(report, func(a, b ImageLayerTreeEntry) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return report
+}
+
+// LayersWastedFiles returns the files which are overwritten or removed by a later layer,
+// sorted by wasted size.
+func LayersWastedFiles(layers []ImageLayer) []ImageWastedFile {
+	changedAt := layersChangedPaths(layers)
+	wasted := make(map[string]*ImageWastedFile)
+
+	for i, layer := range layers {
+		for _, file := range layer.Files {
+			if file.IsDir || file.Deleted || file.Opaque {
+				continue
+			}
+
+			if !isChangedAfter(changedAt, file.Path, i) {
+				continue
+			}
+
+			if _, ok := wasted[file.Path]; !ok {
+				wasted[file.Path] = &ImageWastedFile{Path: file.Path}
+			}
+
+			wasted[file.Path].Size += file.Size
+			wasted[file.Path].Count++
+		}
+	}
+
+	report := make([]ImageWastedFile, 0, len(wasted))
+
+	for _, file := range wasted {
+		report = append(report, *file)
+	}
+
+	slices.SortFunc(report, func(a, b ImageWastedFile) int {
+		if a.Size != b.Size {
+			return cmp.Compare(b.Size, a.Size)
+		}
+
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return report
+}
+
+// layersChangedPaths returns the layers indexes which add, overwrite or remove a path.
+func layersChangedPaths(layers []ImageLayer) map[string][]int {
+	changedAt := make(map[string][]int)
+
+	for i, layer := range layers {
+		for _, file := range layer.Files {
+			if file.IsDir && !file.Opaque {
+				continue
+			}
+
+			changedAt[file.Path] = append(changedAt[file.Path], i)
+		}
+	}
+
+	return changedAt
+}
+
+// isChangedAfter returns true if the path or one of its parent directories is
+// changed (overwritten or removed) by a layer after the specified layer index.
+func isChangedAfter(changedAt map[string][]int, filePath string, index int) bool {
+	for checkPath := filePath; ; checkPath = path.Dir(checkPath) {
+		for _, layerIndex := range changedAt[checkPath] {
+			if layerIndex > index {
+				return true
+			}
+		}
+
+		if checkPath == "/" || checkPath == "." {
+			return false
+		}
+	}
+}
+
+func isPathUnder(filePath string, dir string) bool {
+	return strings.HasPrefix(filePath, strings.TrimSuffix(dir, "/")+"/")
+}
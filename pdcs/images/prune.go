@@ -1,15 +1,20 @@
 package images
 
 import (
+	"strings"
+	"time"
+
 	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/utils"
 	"github.com/rs/zerolog/log"
+	"go.podman.io/podman/v6/pkg/bindings/containers"
 	"go.podman.io/podman/v6/pkg/bindings/images"
 	"go.podman.io/podman/v6/pkg/errorhandling"
 )
 
-// Prune removes all un used specified images.
-func Prune() error {
-	log.Debug().Msgf("pdcs: podman image prune")
+// Prune removes dangling (or all unused) images matching the prune filters.
+func Prune(opts utils.PruneOptions) error {
+	log.Debug().Msgf("pdcs: podman image prune (all=%v) %v", opts.All, opts.Filters())
 
 	var errReport []error
 
@@ -18,7 +23,7 @@ func Prune() error {
 		return err
 	}
 
-	response, err := images.Prune(conn, new(images.PruneOptions).WithAll(true))
+	response, err := images.Prune(conn, new(images.PruneOptions).WithAll(opts.All).WithFilters(opts.Filters()))
 	if err != nil {
 		return err
 	}
@@ -31,3 +36,70 @@ func Prune() error {
 
 	return errorhandling.JoinErrors(errReport)
 }
+
+// PrunePreview returns the images which would be removed by prune and their unique size.
+// Parent images which become dangling once their children are removed are not listed.
+func PrunePreview(opts utils.PruneOptions) ([]utils.PruneReport, error) {
+	return PrunePreviewAfter(opts, nil)
+}
+
+// PrunePreviewAfter returns the images which would be removed by prune once the
+// pruned containers (container ID set) are removed.
+// Images used by external containers (e.g. buildah build containers) are never listed.
+func PrunePreviewAfter(opts utils.PruneOptions, prunedContainers map[string]bool) ([]utils.PruneReport, error) {
+	log.Debug().Msgf("pdcs: podman image prune preview (all=%v) %v", opts.All, opts.Filters())
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := images.List(conn, new(images.ListOptions).WithAll(false))
+	if err != nil {
+		return nil, err
+	}
+
+	cntList, err := containers.List(conn, new(containers.ListOptions).WithAll(true).WithExternal(true))
+	if err != nil {
+		return nil, err
+	}
+
+	usedImages := make(map[string]bool)
+
+	for _, cnt := range cntList {
+		if !prunedContainers[cnt.ID] {
+			usedImages[cnt.ImageID] = true
+		}
+	}
+
+	reports := make([]utils.PruneReport, 0)
+
+	for _, img := range response {
+		if usedImages[img.ID] || (!opts.All && !img.Dangling && len(img.RepoTags) > 0) {
+			continue
+		}
+
+		match, err := opts.Match(img.Labels, time.Unix(img.Created, 0))
+		if err != nil {
+			return nil, err
+		}
+
+		if !match {
+			continue
+		}
+
+		size := img.Size
+		if img.SharedSize > 0 && int64(img.SharedSize) < size {
+			size -= int64(img.SharedSize)
+		}
+
+		reports = append(reports, utils.PruneReport{
+			Type: "image",
+			ID:   img.ID,
+			Name: strings.Join(img.RepoTags, ","),
+			Size: size,
+		})
+	}
+
+	return reports, nil
+}
@@ -2,8 +2,12 @@ package networks
 
 import (
 	"net"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/utils"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
 	"go.podman.io/common/libnetwork/types"
@@ -12,6 +16,23 @@ import (
 	"go.podman.io/podman/v6/pkg/errorhandling"
 )
 
+var (
+	// ErrInvalidIPAMDriver invalid IPAM driver error.
+	ErrInvalidIPAMDriver = errors.New("invalid IPAM driver")
+	// ErrInvalidRoute invalid route error.
+	ErrInvalidRoute = errors.New("invalid route (destination,gateway[,metric])")
+	// ErrInvalidIsolateOption invalid isolate option error.
+	ErrInvalidIsolateOption = errors.New("invalid isolate option (true, false or strict)")
+	// ErrInvalidIPRange invalid ip range error.
+	ErrInvalidIPRange = errors.New("invalid ip range")
+	// ErrParentInterfaceDriver parent interface used with non macvlan/ipvlan driver.
+	ErrParentInterfaceDriver = errors.New("parent interface is only supported by macvlan and ipvlan drivers")
+	// ErrParentInterfaceName parent interface used with interface name error.
+	ErrParentInterfaceName = errors.New("interface name and parent interface cannot be used together")
+	// ErrNegativeValue negative numeric option error.
+	ErrNegativeValue = errors.New("value cannot be negative")
+)
+
 // CreateOptions implements network create options.
 type CreateOptions struct {
 	Name           string
@@ -24,77 +45,264 @@ type CreateOptions struct {
 	IPRanges       []string
 	Subnets        []string
 	DisableDNS     bool
+	DNSServers     []string
+	IPAMDriver     string
+	Routes         []string
+	InterfaceName  string
+	Parent         string
+	Isolate        string
+	MTU            int
+	Metric         int
+	VLAN           int
 }
 
-// Create creates a new pod.
+// Create creates a new network.
 func Create(opts CreateOptions) (types.Network, error) {
 	log.Debug().Msgf("pdcs: podman network create %v", opts)
 
-	var (
-		errList []error
-		report  types.Network
-	)
+	var report types.Network
 
-	createOptions := &types.Network{
-		Name:        opts.Name,
-		Labels:      opts.Labels,
-		Driver:      opts.Drivers,
-		DNSEnabled:  !opts.DisableDNS,
-		Internal:    opts.Internal,
-		IPv6Enabled: opts.IPv6,
+	createOptions, err := prepareNetwork(opts)
+	if err != nil {
+		return report, err
 	}
 
-	if len(opts.Subnets) > 0 { //nolint:nestif
-		for i := range opts.Subnets {
-			subnet, err := types.ParseCIDR(opts.Subnets[i])
-			if err != nil {
-				return report, err
-			}
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return report, err
+	}
 
-			s := types.Subnet{
-				Subnet: subnet,
-			}
+	report, err = network.Create(conn, createOptions)
+	if err != nil {
+		return report, err
+	}
 
-			if len(opts.IPRanges) > i {
-				leaseRange, err := parseRange(opts.IPRanges[i])
-				if err != nil {
-					return report, err
-				}
+	return report, nil
+}
 
-				s.LeaseRange = leaseRange
-			}
+// ValidateCreateOptions validates network create options without creating the network.
+func ValidateCreateOptions(opts CreateOptions) error {
+	_, err := prepareNetwork(opts)
+
+	return err
+}
+
+// DefaultNetworkDriver returns default network driver name.
+func DefaultNetworkDriver() string {
+	return types.DefaultNetworkDriver
+}
+
+// IPAMDrivers returns list of supported IPAM drivers.
+// The first empty item lets podman choose the default driver.
+func IPAMDrivers() []string {
+	return []string{"", types.HostLocalIPAMDriver, types.DHCPIPAMDriver, types.NoneIPAMDriver}
+}
+
+// ParentInterface implements a macvlan/ipvlan parent interface.
+type ParentInterface struct {
+	Name string
+	// Local is true if the interface is listed from the local host of an unix:// connection.
+	Local bool
+}
+
+// ParentInterfaces returns list of known interfaces which can be used
+// as macvlan/ipvlan parent interface.
+// The host interfaces are only listed for local (unix://) connections,
+// otherwise only the parent interfaces of the existing networks are returned.
+func ParentInterfaces() ([]ParentInterface, error) {
+	log.Debug().Msg("pdcs: podman network parent interfaces")
 
-			if len(opts.Gateways) > i {
-				s.Gateway = net.ParseIP(opts.Gateways[i])
+	interfaces := make(map[string]bool)
+
+	// host interfaces are only visible for local connections
+	if strings.HasPrefix(registry.ConnectionURI(), "unix://") {
+		hostInterfaces, err := net.Interfaces()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, hostInterface := range hostInterfaces {
+			if hostInterface.Flags&net.FlagLoopback != 0 {
+				continue
 			}
 
-			createOptions.Subnets = append(createOptions.Subnets, s)
+			interfaces[hostInterface.Name] = true
 		}
 	}
 
-	if len(errList) > 0 {
-		return report, errorhandling.JoinErrors(errList)
+	netList, err := List()
+	if err != nil {
+		return nil, err
 	}
 
-	conn, err := registry.GetConnection()
-	if err != nil {
-		return report, err
+	for _, netItem := range netList {
+		if netItem.Driver != types.MacVLANNetworkDriver && netItem.Driver != types.IPVLANNetworkDriver {
+			continue
+		}
+
+		if _, ok := interfaces[netItem.NetworkInterface]; !ok && netItem.NetworkInterface != "" {
+			interfaces[netItem.NetworkInterface] = false
+		}
 	}
 
-	report, err = network.Create(conn, createOptions)
-	if err != nil {
-		return report, err
+	report := make([]ParentInterface, 0, len(interfaces))
+	for name, local := range interfaces {
+		report = append(report, ParentInterface{Name: name, Local: local})
 	}
 
+	sort.Slice(report, func(i, j int) bool {
+		return report[i].Name < report[j].Name
+	})
+
 	return report, nil
 }
 
-// DefaultNetworkDriver returns default network driver name.
-func DefaultNetworkDriver() string {
-	return types.DefaultNetworkDriver
+func prepareNetwork(opts CreateOptions) (*types.Network, error) { //nolint:cyclop,funlen
+	var errList []error
+
+	createOptions := &types.Network{
+		Name:             opts.Name,
+		Labels:           opts.Labels,
+		Driver:           opts.Drivers,
+		DNSEnabled:       !opts.DisableDNS,
+		Internal:         opts.Internal,
+		IPv6Enabled:      opts.IPv6,
+		NetworkInterface: opts.InterfaceName,
+		Options:          make(map[string]string),
+	}
+
+	for key, value := range opts.DriversOptions {
+		createOptions.Options[key] = value
+	}
+
+	if opts.Parent != "" {
+		// podman uses the network interface as bridge name or as macvlan/ipvlan parent
+		if opts.InterfaceName != "" {
+			return nil, ErrParentInterfaceName
+		}
+
+		if opts.Drivers != types.MacVLANNetworkDriver && opts.Drivers != types.IPVLANNetworkDriver {
+			return nil, ErrParentInterfaceDriver
+		}
+
+		createOptions.NetworkInterface = opts.Parent
+	}
+
+	if opts.IPAMDriver != "" {
+		switch opts.IPAMDriver {
+		case types.HostLocalIPAMDriver, types.DHCPIPAMDriver, types.NoneIPAMDriver:
+			createOptions.IPAMOptions = map[string]string{types.Driver: opts.IPAMDriver}
+		default:
+			return nil, errors.Wrap(ErrInvalidIPAMDriver, opts.IPAMDriver)
+		}
+	}
+
+	switch opts.Isolate {
+	case "":
+	case "true", "false", "strict":
+		createOptions.Options[types.IsolateOption] = opts.Isolate
+	default:
+		return nil, errors.Wrap(ErrInvalidIsolateOption, opts.Isolate)
+	}
+
+	numericOptions := []struct {
+		name  string
+		value int
+	}{
+		{types.MTUOption, opts.MTU},
+		{types.MetricOption, opts.Metric},
+		{types.VLANOption, opts.VLAN},
+	}
+
+	for _, option := range numericOptions {
+		if option.value < 0 {
+			errList = append(errList, errors.Wrap(ErrNegativeValue, option.name))
+
+			continue
+		}
+
+		if option.value > 0 {
+			createOptions.Options[option.name] = strconv.Itoa(option.value)
+		}
+	}
+
+	for _, dnsServer := range opts.DNSServers {
+		if net.ParseIP(dnsServer) == nil {
+			errList = append(errList, errors.Wrap(utils.ErrInvalidDNSAddress, dnsServer))
+
+			continue
+		}
+
+		createOptions.NetworkDNSServers = append(createOptions.NetworkDNSServers, dnsServer)
+	}
+
+	for _, route := range opts.Routes {
+		netRoute, err := parseRoute(route)
+		if err != nil {
+			errList = append(errList, err)
+
+			continue
+		}
+
+		createOptions.Routes = append(createOptions.Routes, netRoute)
+	}
+
+	for i := range opts.Subnets {
+		subnet, err := types.ParseCIDR(opts.Subnets[i])
+		if err != nil {
+			return nil, err
+		}
+
+		s := types.Subnet{
+			Subnet: subnet,
+		}
+
+		if len(opts.IPRanges) > i && opts.IPRanges[i] != "" {
+			leaseRange, err := parseRange(opts.IPRanges[i])
+			if err != nil {
+				return nil, err
+			}
+
+			s.LeaseRange = leaseRange
+		}
+
+		if len(opts.Gateways) > i {
+			s.Gateway = net.ParseIP(opts.Gateways[i])
+			if s.Gateway == nil {
+				errList = append(errList, errors.Wrap(utils.ErrInvalidIPAddress, opts.Gateways[i]))
+			}
+		}
+
+		createOptions.Subnets = append(createOptions.Subnets, s)
+	}
+
+	if len(createOptions.Options) == 0 {
+		createOptions.Options = nil
+	}
+
+	if len(errList) > 0 {
+		return nil, errorhandling.JoinErrors(errList)
+	}
+
+	return createOptions, nil
 }
 
+// parseRange parses ip range in CIDR (10.89.0.0/28) or start-end (10.89.0.10-10.89.0.20) format.
 func parseRange(iprange string) (*types.LeaseRange, error) {
+	if startStr, endStr, found := strings.Cut(iprange, "-"); found {
+		startIP := net.ParseIP(strings.TrimSpace(startStr))
+		endIP := net.ParseIP(strings.TrimSpace(endStr))
+
+		if startIP == nil || endIP == nil {
+			return nil, errors.Wrap(ErrInvalidIPRange, iprange)
+		}
+
+		return &types.LeaseRange{
+			StartIP: startIP,
+			EndIP:   endIP,
+		}, nil
+	}
+
 	_, subnet, err := net.ParseCIDR(iprange)
 	if err != nil {
 		return nil, err
@@ -115,3 +323,38 @@ func parseRange(iprange string) (*types.LeaseRange, error) {
 		EndIP:   lastIP,
 	}, nil
 }
+
+// parseRoute parses route in destination,gateway[,metric] format.
+func parseRoute(route string) (types.Route, error) {
+	var netRoute types.Route
+
+	items := strings.Split(route, ",")
+	if len(items) < 2 || len(items) > 3 { //nolint:mnd
+		return netRoute, errors.Wrap(ErrInvalidRoute, route)
+	}
+
+	destination, err := types.ParseCIDR(items[0])
+	if err != nil {
+		return netRoute, errors.Wrap(ErrInvalidRoute, route)
+	}
+
+	gateway := net.ParseIP(items[1])
+	if gateway == nil {
+		return netRoute, errors.Wrap(ErrInvalidRoute, route)
+	}
+
+	netRoute.Destination = destination
+	netRoute.Gateway = gateway
+
+	if len(items) == 3 { //nolint:mnd
+		metric, err := strconv.ParseUint(items[2], 10, 32)
+		if err != nil {
+			return netRoute, errors.Wrap(ErrInvalidRoute, route)
+		}
+
+		routeMetric := uint32(metric)
+		netRoute.Metric = &routeMetric
+	}
+
+	return netRoute, nil
+}
@@ -0,0 +1,73 @@
+package networks
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/utils"
+	"github.com/docker/go-units"
+	"github.com/rs/zerolog/log"
+	"go.podman.io/podman/v6/pkg/bindings/network"
+)
+
+// network details report sections.
+const (
+	DetailsSectionNetwork    = "network"
+	DetailsSectionSubnets    = "subnets"
+	DetailsSectionLabels     = "labels"
+	DetailsSectionContainers = "containers"
+)
+
+// Details returns summary of network driver, subnets, labels and the connected containers.
+func Details(id string) ([]utils.DetailsEntry, error) {
+	log.Debug().Msgf("pdcs: podman network details %s", id)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := network.Inspect(conn, id, new(network.InspectOptions))
+	if err != nil {
+		return nil, err
+	}
+
+	report := []utils.DetailsEntry{
+		utils.DetailsValue(DetailsSectionNetwork, "name", data.Name),
+		utils.DetailsValue(DetailsSectionNetwork, "driver", data.Driver),
+		utils.DetailsValue(DetailsSectionNetwork, "interface", data.NetworkInterface),
+		utils.DetailsValue(DetailsSectionNetwork, "internal", strconv.FormatBool(data.Internal)),
+		utils.DetailsValue(DetailsSectionNetwork, "dns", strconv.FormatBool(data.DNSEnabled)),
+		utils.DetailsValue(DetailsSectionNetwork, "ipv6", strconv.FormatBool(data.IPv6Enabled)),
+		utils.DetailsValue(DetailsSectionNetwork, "created", units.HumanDuration(time.Since(data.Created))+" ago"),
+	}
+
+	subnets := make(map[string]string)
+	for _, subnet := range data.Subnets {
+		subnets[subnet.Subnet.String()] = subnet.Gateway.String()
+	}
+
+	report = append(report, utils.DetailsMap(DetailsSectionSubnets, subnets)...)
+	report = append(report, utils.DetailsMap(DetailsSectionLabels, data.Labels)...)
+
+	// connected containers and their addresses
+	containers := make(map[string]string)
+
+	for _, cnt := range data.Containers {
+		addresses := make([]string, 0)
+
+		for _, iface := range cnt.Interfaces {
+			for _, subnet := range iface.Subnets {
+				addresses = append(addresses, subnet.IPNet.String())
+			}
+		}
+
+		containers[cnt.Name] = strings.Join(addresses, ", ")
+	}
+
+	report = append(report, utils.DetailsMap(DetailsSectionContainers, containers)...)
+
+	return report, nil
+}
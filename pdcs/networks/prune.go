@@ -2,23 +2,28 @@ package networks
 
 import (
 	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/utils"
 	"github.com/rs/zerolog/log"
+	"go.podman.io/podman/v6/pkg/bindings/containers"
 	"go.podman.io/podman/v6/pkg/bindings/network"
 	"go.podman.io/podman/v6/pkg/errorhandling"
 )
 
-// Prune removes all unused network.
-func Prune() error {
+// default network which is never removed by prune.
+const defaultNetworkName = "podman"
+
+// Prune removes all unused network matching the prune filters.
+func Prune(opts utils.PruneOptions) error {
 	var errorReport []error
 
-	log.Debug().Msgf("pdcs: podman network remove")
+	log.Debug().Msgf("pdcs: podman network prune %v", opts.Filters())
 
 	conn, err := registry.GetConnection()
 	if err != nil {
 		return err
 	}
 
-	response, err := network.Prune(conn, new(network.PruneOptions))
+	response, err := network.Prune(conn, new(network.PruneOptions).WithFilters(opts.Filters()))
 	if err != nil {
 		return err
 	}
@@ -31,3 +36,66 @@ func Prune() error {
 
 	return errorhandling.JoinErrors(errorReport)
 }
+
+// PrunePreview returns the networks which would be removed by prune.
+func PrunePreview(opts utils.PruneOptions) ([]utils.PruneReport, error) {
+	return PrunePreviewAfter(opts, nil)
+}
+
+// PrunePreviewAfter returns the networks which would be removed by prune once the
+// pruned containers (container ID set) are removed.
+func PrunePreviewAfter(opts utils.PruneOptions, prunedContainers map[string]bool) ([]utils.PruneReport, error) {
+	log.Debug().Msgf("pdcs: podman network prune preview %v", opts.Filters())
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	netList, err := network.List(conn, new(network.ListOptions))
+	if err != nil {
+		return nil, err
+	}
+
+	cntList, err := containers.List(conn, new(containers.ListOptions).WithAll(true))
+	if err != nil {
+		return nil, err
+	}
+
+	usedNetworks := make(map[string]bool)
+
+	for _, cnt := range cntList {
+		if prunedContainers[cnt.ID] {
+			continue
+		}
+
+		for _, netName := range cnt.Networks {
+			usedNetworks[netName] = true
+		}
+	}
+
+	reports := make([]utils.PruneReport, 0)
+
+	for _, netItem := range netList {
+		if netItem.Name == defaultNetworkName || usedNetworks[netItem.Name] {
+			continue
+		}
+
+		match, err := opts.Match(netItem.Labels, netItem.Created)
+		if err != nil {
+			return nil, err
+		}
+
+		if !match {
+			continue
+		}
+
+		reports = append(reports, utils.PruneReport{
+			Type: "network",
+			ID:   netItem.ID,
+			Name: netItem.Name,
+		})
+	}
+
+	return reports, nil
+}
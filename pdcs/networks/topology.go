@@ -0,0 +1,150 @@
+package networks
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/rs/zerolog/log"
+	"go.podman.io/common/libnetwork/types"
+	"go.podman.io/podman/v6/libpod/define"
+	"go.podman.io/podman/v6/pkg/bindings/containers"
+	"go.podman.io/podman/v6/pkg/bindings/network"
+)
+
+// TopologyReport implements a network with its attached endpoints.
+type TopologyReport struct {
+	Network   types.Network
+	Endpoints []TopologyEndpoint
+}
+
+// TopologyEndpoint implements a container (or pod infra container) attached to a network.
+type TopologyEndpoint struct {
+	ContainerID   string
+	ContainerName string
+	PodID         string
+	PodName       string
+	IsInfra       bool
+	IPAddresses   []string
+	MacAddress    string
+	Aliases       []string
+	Networks      []string
+}
+
+// IsMultiNetwork returns true if the endpoint is attached to more than one network.
+func (ep TopologyEndpoint) IsMultiNetwork() bool {
+	return len(ep.Networks) > 1
+}
+
+// Topology returns the list of networks with their attached containers and pods.
+func Topology() ([]TopologyReport, error) {
+	log.Debug().Msg("pdcs: podman network topology")
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	netList, err := network.List(conn, new(network.ListOptions))
+	if err != nil {
+		return nil, err
+	}
+
+	cntList, err := containers.List(conn, new(containers.ListOptions).WithAll(true))
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := make(map[string][]TopologyEndpoint)
+
+	for _, cnt := range cntList {
+		if len(cnt.Networks) == 0 {
+			continue
+		}
+
+		cntData, err := containers.Inspect(conn, cnt.ID, new(containers.InspectOptions))
+		if err != nil {
+			return nil, err
+		}
+
+		if cntData.NetworkSettings == nil {
+			continue
+		}
+
+		cntName := ""
+		if len(cnt.Names) > 0 {
+			cntName = cnt.Names[0]
+		}
+
+		cntNetworks := make([]string, 0, len(cntData.NetworkSettings.Networks))
+		for netName := range cntData.NetworkSettings.Networks {
+			cntNetworks = append(cntNetworks, netName)
+		}
+
+		sort.Strings(cntNetworks)
+
+		for netName, netData := range cntData.NetworkSettings.Networks {
+			if netData == nil {
+				continue
+			}
+
+			endpoint := TopologyEndpoint{
+				ContainerID:   cnt.ID,
+				ContainerName: cntName,
+				PodID:         cnt.Pod,
+				PodName:       cnt.PodName,
+				IsInfra:       cnt.IsInfra,
+				MacAddress:    netData.MacAddress,
+				Aliases:       netData.Aliases,
+				Networks:      cntNetworks,
+			}
+
+			endpoint.IPAddresses = endpointAddresses(netData.InspectBasicNetworkConfig)
+
+			endpoints[netName] = append(endpoints[netName], endpoint)
+		}
+	}
+
+	report := make([]TopologyReport, 0, len(netList))
+
+	for _, netItem := range netList {
+		netEndpoints := endpoints[netItem.Name]
+
+		sort.Slice(netEndpoints, func(i, j int) bool {
+			return netEndpoints[i].ContainerName < netEndpoints[j].ContainerName
+		})
+
+		report = append(report, TopologyReport{
+			Network:   netItem,
+			Endpoints: netEndpoints,
+		})
+	}
+
+	sort.Slice(report, func(i, j int) bool {
+		return report[i].Network.Name < report[j].Network.Name
+	})
+
+	return report, nil
+}
+
+func endpointAddresses(netConfig define.InspectBasicNetworkConfig) []string {
+	addresses := make([]string, 0)
+
+	if netConfig.IPAddress != "" {
+		addresses = append(addresses, fmt.Sprintf("%s/%d", netConfig.IPAddress, netConfig.IPPrefixLen))
+	}
+
+	for _, addr := range netConfig.SecondaryIPAddresses {
+		addresses = append(addresses, fmt.Sprintf("%s/%d", addr.Addr, addr.PrefixLength))
+	}
+
+	if netConfig.GlobalIPv6Address != "" {
+		addresses = append(addresses, fmt.Sprintf("%s/%d", netConfig.GlobalIPv6Address, netConfig.GlobalIPv6PrefixLen))
+	}
+
+	for _, addr := range netConfig.SecondaryIPv6Addresses {
+		addresses = append(addresses, fmt.Sprintf("%s/%d", addr.Addr, addr.PrefixLength))
+	}
+
+	return addresses
+}
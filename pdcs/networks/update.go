@@ -0,0 +1,48 @@
+package networks
+
+import (
+	"net"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/utils"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.podman.io/podman/v6/pkg/bindings/network"
+)
+
+// ErrEmptyNetworkUpdate empty network update options error.
+var ErrEmptyNetworkUpdate = errors.New("no DNS server to add or remove")
+
+// UpdateOptions implements network update options.
+type UpdateOptions struct {
+	AddDNSServers    []string
+	RemoveDNSServers []string
+}
+
+// Update adds or removes network DNS servers of an existing network.
+func Update(name string, opts UpdateOptions) error {
+	log.Debug().Msgf("pdcs: podman network update %s %v", name, opts)
+
+	if len(opts.AddDNSServers) == 0 && len(opts.RemoveDNSServers) == 0 {
+		return ErrEmptyNetworkUpdate
+	}
+
+	for _, dnsServers := range [][]string{opts.AddDNSServers, opts.RemoveDNSServers} {
+		for _, dnsServer := range dnsServers {
+			if net.ParseIP(dnsServer) == nil {
+				return errors.Wrap(utils.ErrInvalidDNSAddress, dnsServer)
+			}
+		}
+	}
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return err
+	}
+
+	updateOptions := new(network.UpdateOptions).
+		WithAddDNSServers(opts.AddDNSServers).
+		WithRemoveDNSServers(opts.RemoveDNSServers)
+
+	return network.Update(conn, name, updateOptions)
+}
@@ -0,0 +1,59 @@
+package pods
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/utils"
+	"github.com/docker/go-units"
+	"github.com/rs/zerolog/log"
+	"go.podman.io/podman/v6/pkg/bindings/pods"
+	"go.podman.io/storage/pkg/stringid"
+)
+
+// pod details report sections.
+const (
+	DetailsSectionPod        = "pod"
+	DetailsSectionContainers = "containers"
+	DetailsSectionLabels     = "labels"
+)
+
+// Details returns summary of pod status, shared namespaces, containers and labels.
+func Details(id string) ([]utils.DetailsEntry, error) {
+	log.Debug().Msgf("pdcs: podman pod details %s", id)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := pods.Inspect(conn, id, new(pods.InspectOptions))
+	if err != nil {
+		return nil, err
+	}
+
+	data := response.InspectPodData
+
+	report := []utils.DetailsEntry{
+		utils.DetailsValue(DetailsSectionPod, "name", data.Name),
+		utils.DetailsValue(DetailsSectionPod, "status", data.State),
+		utils.DetailsValue(DetailsSectionPod, "hostname", data.Hostname),
+		utils.DetailsValue(DetailsSectionPod, "infra", stringid.TruncateID(data.InfraContainerID)),
+		utils.DetailsValue(DetailsSectionPod, "namespaces", strings.Join(data.SharedNamespaces, ",")),
+		utils.DetailsValue(DetailsSectionPod, "exit policy", data.ExitPolicy),
+		utils.DetailsValue(DetailsSectionPod, "created", units.HumanDuration(time.Since(data.Created))+" ago"),
+		utils.DetailsValue(DetailsSectionPod, "containers", strconv.FormatUint(uint64(data.NumContainers), 10)),
+	}
+
+	containers := make(map[string]string)
+	for _, cnt := range data.Containers {
+		containers[cnt.Name] = cnt.State
+	}
+
+	report = append(report, utils.DetailsMap(DetailsSectionContainers, containers)...)
+	report = append(report, utils.DetailsMap(DetailsSectionLabels, data.Labels)...)
+
+	return report, nil
+}
@@ -2,15 +2,22 @@ package pods
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/utils"
 	"github.com/rs/zerolog/log"
 	"go.podman.io/podman/v6/pkg/bindings/pods"
 )
 
-// Prune removes all stop pods.
-func Prune() ([]string, error) {
-	log.Debug().Msgf("pdcs: podman pod prune")
+// pod states which are removed by prune.
+var prunePodStates = []string{"Exited", "Stopped"}
+
+// Prune removes all stop pods matching the prune filters.
+// The pod prune API does not support filters, the matching pods are removed one by one
+// and each pod state is checked again right before its removal.
+func Prune(opts utils.PruneOptions) ([]string, error) {
+	log.Debug().Msgf("pdcs: podman pod prune %v", opts.Filters())
 
 	var report []string
 
@@ -19,17 +26,88 @@ func Prune() ([]string, error) {
 		return report, err
 	}
 
-	response, err := pods.Prune(conn, new(pods.PruneOptions))
+	if !opts.HasFilters() {
+		response, err := pods.Prune(conn, new(pods.PruneOptions))
+		if err != nil {
+			return report, err
+		}
+
+		for _, r := range response {
+			if r.Err != nil {
+				respData := fmt.Sprintf("error removing %s: %s", r.Id, r.Err.Error())
+				report = append(report, respData)
+			}
+		}
+
+		return report, nil
+	}
+
+	podList, err := PrunePreview(opts)
 	if err != nil {
 		return report, err
 	}
 
-	for _, r := range response {
-		if r.Err != nil {
-			respData := fmt.Sprintf("error removing %s: %s", r.Id, r.Err.Error())
-			report = append(report, respData)
+	for _, pod := range podList {
+		podData, err := pods.Inspect(conn, pod.ID, new(pods.InspectOptions))
+		if err != nil {
+			report = append(report, fmt.Sprintf("error removing %s: %s", pod.ID, err.Error()))
+
+			continue
 		}
+
+		if !slices.Contains(prunePodStates, podData.State) {
+			continue
+		}
+
+		rmReport, err := Remove(pod.ID)
+		if err != nil {
+			report = append(report, fmt.Sprintf("error removing %s: %s", pod.ID, err.Error()))
+
+			continue
+		}
+
+		report = append(report, rmReport...)
 	}
 
 	return report, nil
 }
+
+// PrunePreview returns the pods which would be removed by prune.
+func PrunePreview(opts utils.PruneOptions) ([]utils.PruneReport, error) {
+	log.Debug().Msgf("pdcs: podman pod prune preview %v", opts.Filters())
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := pods.List(conn, new(pods.ListOptions))
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]utils.PruneReport, 0)
+
+	for _, pod := range response {
+		if !slices.Contains(prunePodStates, pod.Status) {
+			continue
+		}
+
+		match, err := opts.Match(pod.Labels, pod.Created)
+		if err != nil {
+			return nil, err
+		}
+
+		if !match {
+			continue
+		}
+
+		reports = append(reports, utils.PruneReport{
+			Type: "pod",
+			ID:   pod.Id,
+			Name: pod.Name,
+		})
+	}
+
+	return reports, nil
+}
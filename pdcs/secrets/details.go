@@ -0,0 +1,56 @@
+package secrets
+
+import (
+	"time"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/utils"
+	"github.com/docker/go-units"
+	"github.com/rs/zerolog/log"
+	"go.podman.io/podman/v6/pkg/bindings/secrets"
+)
+
+// secret details report sections.
+const (
+	DetailsSectionSecret     = "secret"
+	DetailsSectionLabels     = "labels"
+	DetailsSectionContainers = "containers"
+)
+
+// Details returns summary of secret driver, labels and the containers which reference it.
+func Details(id string) ([]utils.DetailsEntry, error) {
+	log.Debug().Msgf("pdcs: podman secret details %s", id)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := secrets.Inspect(conn, id, new(secrets.InspectOptions))
+	if err != nil {
+		return nil, err
+	}
+
+	report := []utils.DetailsEntry{
+		utils.DetailsValue(DetailsSectionSecret, "name", data.Spec.Name),
+		utils.DetailsValue(DetailsSectionSecret, "driver", data.Spec.Driver.Name),
+		utils.DetailsValue(DetailsSectionSecret, "created", units.HumanDuration(time.Since(data.CreatedAt))+" ago"),
+		utils.DetailsValue(DetailsSectionSecret, "updated", units.HumanDuration(time.Since(data.UpdatedAt))+" ago"),
+	}
+
+	report = append(report, utils.DetailsMap(DetailsSectionLabels, data.Spec.Labels)...)
+
+	usedBy, err := UsedBy(id)
+	if err != nil {
+		return nil, err
+	}
+
+	containers := make(map[string]string)
+	for _, cnt := range usedBy {
+		containers[cnt.ContainerName] = cnt.State
+	}
+
+	report = append(report, utils.DetailsMap(DetailsSectionContainers, containers)...)
+
+	return report, nil
+}
@@ -8,8 +8,9 @@ import (
 )
 
 // Inspect inspects the specified secret.
-func Inspect(id string) (string, error) {
-	log.Debug().Msgf("pdcs: podman secret inspect %s", id)
+// The secret data is only included in the report if showSecret is set.
+func Inspect(id string, showSecret bool) (string, error) {
+	log.Debug().Msgf("pdcs: podman secret inspect %s (showsecret=%t)", id, showSecret)
 
 	var report string
 
@@ -18,7 +19,7 @@ func Inspect(id string) (string, error) {
 		return report, err
 	}
 
-	response, err := secrets.Inspect(conn, id, new(secrets.InspectOptions).WithShowSecret(true))
+	response, err := secrets.Inspect(conn, id, new(secrets.InspectOptions).WithShowSecret(showSecret))
 	if err != nil {
 		return report, err
 	}
@@ -0,0 +1,80 @@
+package secrets
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/rs/zerolog/log"
+	"go.podman.io/podman/v6/pkg/bindings/secrets"
+)
+
+var (
+	errSecretUpdateFileAndText = errors.New("cannot select secret file and secret text together")
+	errSecretUpdateEmptyData   = errors.New("secret content not provided")
+)
+
+// SecretUpdateOptions secret update (replace) options.
+type SecretUpdateOptions struct {
+	File string
+	Text string
+}
+
+// Update replaces the secret data in place.
+// The secret name, driver, driver options and labels are preserved.
+func Update(id string, opts *SecretUpdateOptions) error {
+	log.Debug().Msgf("pdcs: podman secret update %s", id)
+
+	var reader io.Reader
+
+	if opts.File != "" && opts.Text != "" {
+		return errSecretUpdateFileAndText
+	}
+
+	if opts.File == "" && opts.Text == "" {
+		return errSecretUpdateEmptyData
+	}
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return err
+	}
+
+	secretInfo, err := secrets.Inspect(conn, id, new(secrets.InspectOptions))
+	if err != nil {
+		return err
+	}
+
+	createOpts := new(secrets.CreateOptions).
+		WithReplace(true).
+		WithName(secretInfo.Spec.Name).
+		WithDriver(secretInfo.Spec.Driver.Name).
+		WithDriverOpts(secretInfo.Spec.Driver.Options).
+		WithLabels(secretInfo.Spec.Labels)
+
+	if opts.File != "" {
+		file, err := os.Open(opts.File)
+		if err != nil {
+			return err
+		}
+
+		defer func() {
+			err := file.Close()
+			if err != nil {
+				log.Error().Msgf("failed to close secret file input: %s", err.Error())
+			}
+		}()
+
+		reader = file
+	}
+
+	if opts.Text != "" {
+		reader = strings.NewReader(opts.Text)
+	}
+
+	_, err = secrets.Create(conn, reader, createOpts)
+
+	return err
+}
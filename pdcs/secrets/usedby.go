@@ -0,0 +1,73 @@
+package secrets
+
+import (
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/rs/zerolog/log"
+	"go.podman.io/podman/v6/pkg/bindings/containers"
+	"go.podman.io/podman/v6/pkg/bindings/secrets"
+)
+
+// SecretUsageReport implements a container which references a secret.
+type SecretUsageReport struct {
+	ContainerID   string
+	ContainerName string
+	State         string
+}
+
+// UsedBy returns list of containers which reference the specified secret.
+func UsedBy(id string) ([]SecretUsageReport, error) {
+	log.Debug().Msgf("pdcs: podman secret used by %s", id)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	secretInfo, err := secrets.Inspect(conn, id, new(secrets.InspectOptions))
+	if err != nil {
+		return nil, err
+	}
+
+	cntList, err := containers.List(conn, new(containers.ListOptions).WithAll(true))
+	if err != nil {
+		return nil, err
+	}
+
+	report := make([]SecretUsageReport, 0)
+
+	for _, cnt := range cntList {
+		cntData, err := containers.Inspect(conn, cnt.ID, new(containers.InspectOptions))
+		if err != nil {
+			return nil, err
+		}
+
+		if cntData.Config == nil {
+			continue
+		}
+
+		for _, cntSecret := range cntData.Config.Secrets {
+			if cntSecret == nil {
+				continue
+			}
+
+			if cntSecret.ID != secretInfo.ID && cntSecret.Name != secretInfo.Spec.Name {
+				continue
+			}
+
+			cntName := ""
+			if len(cnt.Names) > 0 {
+				cntName = cnt.Names[0]
+			}
+
+			report = append(report, SecretUsageReport{
+				ContainerID:   cnt.ID,
+				ContainerName: cntName,
+				State:         cnt.State,
+			})
+
+			break
+		}
+	}
+
+	return report, nil
+}
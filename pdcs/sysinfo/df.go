@@ -3,6 +3,7 @@ package sysinfo
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/containers/podman-tui/pdcs/registry"
 	"github.com/docker/go-units"
@@ -11,6 +12,13 @@ import (
 	"go.podman.io/podman/v6/pkg/domain/entities"
 )
 
+// df report types.
+const (
+	DfTypeImages     = "Images"
+	DfTypeContainers = "Containers"
+	DfTypeVolumes    = "Local Volumes"
+)
+
 // DfSummary implements df summary report.
 type DfSummary struct {
 	rType       string
@@ -20,24 +28,79 @@ type DfSummary struct {
 	reclaimable int64
 }
 
+// DfItem implements df verbose report item (image, container or volume).
+type DfItem struct {
+	Type   string
+	ID     string
+	Name   string
+	Image  string
+	Status string
+	// Links is number of containers using the image or volume.
+	Links       int
+	Size        int64
+	SharedSize  int64
+	UniqueSize  int64
+	Reclaimable int64
+}
+
 // DiskUsage returns information about image, container, and volume disk
-// consumption.
-func DiskUsage() ([]*DfSummary, error) {
+// consumption, summarized per type and per item.
+func DiskUsage() ([]*DfSummary, []*DfItem, error) {
 	log.Debug().Msgf("pdcs: podman system disk usage")
 
 	conn, err := registry.GetConnection()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	dfRawReport, err := system.DiskUsage(conn, new(system.DiskOptions))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	dfreport := prepDfSummary(dfRawReport)
 
-	return dfreport, nil
+	return dfreport, prepDfItems(dfRawReport), nil
+}
+
+func prepDfItems(reports *entities.SystemDfReport) []*DfItem {
+	items := make([]*DfItem, 0, len(reports.Images)+len(reports.Containers)+len(reports.Volumes))
+
+	for _, img := range reports.Images {
+		items = append(items, &DfItem{
+			Type:       DfTypeImages,
+			ID:         img.ImageID,
+			Name:       img.Repository + ":" + img.Tag,
+			Links:      img.Containers,
+			Size:       img.Size,
+			SharedSize: img.SharedSize,
+			UniqueSize: img.UniqueSize,
+		})
+	}
+
+	for _, cnt := range reports.Containers {
+		items = append(items, &DfItem{
+			Type:   DfTypeContainers,
+			ID:     cnt.ContainerID,
+			Name:   strings.TrimPrefix(cnt.Names, "/"),
+			Image:  cnt.Image,
+			Status: cnt.Status,
+			Size:   cnt.RWSize,
+		})
+	}
+
+	for _, vol := range reports.Volumes {
+		items = append(items, &DfItem{
+			Type:        DfTypeVolumes,
+			ID:          vol.VolumeName,
+			Name:        vol.VolumeName,
+			Links:       vol.Links,
+			Size:        vol.Size,
+			Reclaimable: vol.ReclaimableSize,
+		})
+	}
+
+	return items
 }
 
 func prepDfSummary(reports *entities.SystemDfReport) []*DfSummary { //nolint:funlen
@@ -61,7 +124,7 @@ func prepDfSummary(reports *entities.SystemDfReport) []*DfSummary { //nolint:fun
 	}
 
 	imageSummary := DfSummary{
-		rType:       "Images",
+		rType:       DfTypeImages,
 		total:       len(reports.Images),
 		active:      active,
 		size:        size,
@@ -86,7 +149,7 @@ func prepDfSummary(reports *entities.SystemDfReport) []*DfSummary { //nolint:fun
 	}
 
 	containerSummary := DfSummary{
-		rType:       "Containers",
+		rType:       DfTypeContainers,
 		total:       len(reports.Containers),
 		active:      conActive,
 		size:        conSize,
@@ -107,7 +170,7 @@ func prepDfSummary(reports *entities.SystemDfReport) []*DfSummary { //nolint:fun
 	}
 
 	volumeSummary := DfSummary{
-		rType:       "Local Volumes",
+		rType:       DfTypeVolumes,
 		total:       len(reports.Volumes),
 		active:      activeVolumes,
 		size:        volumesSize,
@@ -0,0 +1,194 @@
+package sysinfo
+
+import (
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/containers/podman-tui/pdcs/images"
+	"github.com/containers/podman-tui/pdcs/networks"
+	"github.com/containers/podman-tui/pdcs/pods"
+	"github.com/containers/podman-tui/pdcs/secrets"
+	"github.com/containers/podman-tui/pdcs/utils"
+	"github.com/containers/podman-tui/pdcs/volumes"
+	"github.com/rs/zerolog/log"
+	"go.podman.io/podman/v6/pkg/domain/entities"
+)
+
+// GraphNode implements a resource node of the dependency graph.
+type GraphNode struct {
+	utils.ResourceReference
+
+	// Orphaned is true for images, volumes, networks and secrets without any container.
+	Orphaned bool
+	Children []*GraphNode
+}
+
+// DependencyGraph returns pods, their containers and the images, volumes, networks and
+// secrets used by the containers. Unused images, volumes, networks and secrets are
+// returned at the end of the graph and marked as orphaned.
+func DependencyGraph() ([]*GraphNode, error) {
+	log.Debug().Msg("pdcs: podman dependency graph")
+
+	podList, err := pods.List()
+	if err != nil {
+		return nil, err
+	}
+
+	cntList, err := containers.List()
+	if err != nil {
+		return nil, err
+	}
+
+	used := make(map[string]bool)
+	graph := make([]*GraphNode, 0, len(podList))
+	podNodes := make(map[string]*GraphNode)
+
+	for _, pod := range podList {
+		node := &GraphNode{ResourceReference: utils.ResourceReference{
+			Kind: utils.ResourceKindPod,
+			ID:   pod.Id,
+			Name: pod.Name,
+			Info: strings.ToLower(pod.Status),
+		}}
+
+		podNodes[pod.Id] = node
+		graph = append(graph, node)
+	}
+
+	standalone := make([]*GraphNode, 0, len(cntList))
+
+	for _, cnt := range cntList {
+		node, err := containerGraphNode(cnt, used)
+		if err != nil {
+			return nil, err
+		}
+
+		if podNode, ok := podNodes[cnt.Pod]; ok {
+			podNode.Children = append(podNode.Children, node)
+
+			continue
+		}
+
+		standalone = append(standalone, node)
+	}
+
+	graph = append(graph, standalone...)
+
+	orphaned, err := orphanedGraphNodes(used)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(graph, orphaned...), nil
+}
+
+func containerGraphNode(cnt entities.ListContainer, used map[string]bool) (*GraphNode, error) {
+	node := &GraphNode{ResourceReference: utils.ResourceReference{
+		Kind: utils.ResourceKindContainer,
+		ID:   cnt.ID,
+		Name: strings.Join(cnt.Names, ","),
+		Info: cnt.State,
+	}}
+
+	refs, err := containers.References(cnt.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ref := range refs {
+		// pod is the parent node of the container
+		if ref.Kind == utils.ResourceKindPod {
+			continue
+		}
+
+		used[graphUsedKey(ref.Kind, ref.ID)] = true
+		used[graphUsedKey(ref.Kind, ref.Name)] = true
+
+		node.Children = append(node.Children, &GraphNode{ResourceReference: ref})
+	}
+
+	return node, nil
+}
+
+func orphanedGraphNodes(used map[string]bool) ([]*GraphNode, error) { //nolint:cyclop
+	orphaned := make([]*GraphNode, 0)
+
+	imgList, err := images.List()
+	if err != nil {
+		return nil, err
+	}
+
+	imgNames := make(map[string][]string)
+	imgIDs := make([]string, 0, len(imgList))
+
+	for _, img := range imgList {
+		// intermediate images are used by their child images
+		if img.Repository == "<none>" && !img.Dangling {
+			continue
+		}
+
+		if _, ok := imgNames[img.ID]; !ok {
+			imgIDs = append(imgIDs, img.ID)
+		}
+
+		imgNames[img.ID] = append(imgNames[img.ID], img.Repository+":"+img.Tag)
+	}
+
+	for _, id := range imgIDs {
+		if used[graphUsedKey(utils.ResourceKindImage, id)] {
+			continue
+		}
+
+		orphaned = append(orphaned, newOrphanedGraphNode(utils.ResourceKindImage, id, strings.Join(imgNames[id], ",")))
+	}
+
+	volList, err := volumes.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, vol := range volList {
+		if !used[graphUsedKey(utils.ResourceKindVolume, vol.Name)] {
+			orphaned = append(orphaned, newOrphanedGraphNode(utils.ResourceKindVolume, "", vol.Name))
+		}
+	}
+
+	netList, err := networks.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, network := range netList {
+		if !used[graphUsedKey(utils.ResourceKindNetwork, network.Name)] {
+			orphaned = append(orphaned, newOrphanedGraphNode(utils.ResourceKindNetwork, network.ID, network.Name))
+		}
+	}
+
+	secList, err := secrets.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, secret := range secList {
+		if !used[graphUsedKey(utils.ResourceKindSecret, secret.ID)] {
+			orphaned = append(orphaned, newOrphanedGraphNode(utils.ResourceKindSecret, secret.ID, secret.Spec.Name))
+		}
+	}
+
+	return orphaned, nil
+}
+
+func newOrphanedGraphNode(kind string, id string, name string) *GraphNode {
+	return &GraphNode{
+		ResourceReference: utils.ResourceReference{
+			Kind: kind,
+			ID:   id,
+			Name: name,
+		},
+		Orphaned: true,
+	}
+}
+
+func graphUsedKey(kind string, id string) string {
+	return kind + "/" + id
+}
@@ -1,15 +1,21 @@
 package sysinfo
 
 import (
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/containers/podman-tui/pdcs/images"
+	"github.com/containers/podman-tui/pdcs/networks"
+	"github.com/containers/podman-tui/pdcs/pods"
 	"github.com/containers/podman-tui/pdcs/registry"
 	"github.com/containers/podman-tui/pdcs/utils"
+	"github.com/containers/podman-tui/pdcs/volumes"
 	"github.com/rs/zerolog/log"
 	"go.podman.io/podman/v6/pkg/bindings/system"
 )
 
-// Prune removes all unused pod, container, image and volume data.
-func Prune() (string, error) {
-	log.Debug().Msgf("pdcs: podman system prune")
+// Prune removes all unused pod, container, image, network and (optionally) volume data
+// matching the prune filters.
+func Prune(opts utils.PruneOptions) (string, error) {
+	log.Debug().Msgf("pdcs: podman system prune (all=%v volumes=%v) %v", opts.All, opts.Volumes, opts.Filters())
 
 	var report string
 
@@ -18,13 +24,10 @@ func Prune() (string, error) {
 		return report, err
 	}
 
-	all := true
-	allVolumes := true
-
-	response, err := system.Prune(conn, &system.PruneOptions{
-		All:     &all,
-		Volumes: &allVolumes,
-	})
+	response, err := system.Prune(conn, new(system.PruneOptions).
+		WithAll(opts.All).
+		WithVolumes(opts.Volumes).
+		WithFilters(opts.Filters()))
 	if err != nil {
 		return report, err
 	}
@@ -36,3 +39,81 @@ func Prune() (string, error) {
 
 	return report, nil
 }
+
+// PrunePreview returns the resources which would be removed by system prune.
+// The images, networks and volumes used only by pruned pods and containers are listed as well.
+func PrunePreview(opts utils.PruneOptions) ([]utils.PruneReport, error) {
+	log.Debug().Msgf("pdcs: podman system prune preview (all=%v volumes=%v) %v",
+		opts.All, opts.Volumes, opts.Filters())
+
+	podReports, err := pods.PrunePreview(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	cntReports, err := containers.PrunePreview(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	prunedContainers, err := prunedContainerSet(podReports, cntReports)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]utils.PruneReport, 0, len(podReports)+len(cntReports))
+	reports = append(reports, podReports...)
+	reports = append(reports, cntReports...)
+
+	previewFuncs := []func(utils.PruneOptions, map[string]bool) ([]utils.PruneReport, error){
+		images.PrunePreviewAfter,
+		networks.PrunePreviewAfter,
+	}
+
+	if opts.Volumes {
+		previewFuncs = append(previewFuncs, volumes.PrunePreviewAfter)
+	}
+
+	for _, preview := range previewFuncs {
+		items, err := preview(opts, prunedContainers)
+		if err != nil {
+			return nil, err
+		}
+
+		reports = append(reports, items...)
+	}
+
+	return reports, nil
+}
+
+// prunedContainerSet returns the IDs of the pruned containers, including the pruned pods containers.
+func prunedContainerSet(podReports, cntReports []utils.PruneReport) (map[string]bool, error) {
+	prunedContainers := make(map[string]bool)
+
+	for _, cnt := range cntReports {
+		prunedContainers[cnt.ID] = true
+	}
+
+	if len(podReports) == 0 {
+		return prunedContainers, nil
+	}
+
+	prunedPods := make(map[string]bool)
+
+	for _, pod := range podReports {
+		prunedPods[pod.ID] = true
+	}
+
+	cntList, err := containers.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cnt := range cntList {
+		if prunedPods[cnt.Pod] {
+			prunedContainers[cnt.ID] = true
+		}
+	}
+
+	return prunedContainers, nil
+}
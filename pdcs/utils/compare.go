@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"slices"
+	"strings"
+)
+
+// CompareEntry implements a compared field of two resources.
+type CompareEntry struct {
+	Section string
+	Field   string
+	Left    string
+	Right   string
+}
+
+// Differs returns true if left and right values are not equal.
+func (entry CompareEntry) Differs() bool {
+	return entry.Left != entry.Right
+}
+
+// CompareReportDiffers returns number of differing entries.
+func CompareReportDiffers(entries []CompareEntry) int {
+	count := 0
+
+	for _, entry := range entries {
+		if entry.Differs() {
+			count++
+		}
+	}
+
+	return count
+}
+
+// CompareValue returns compare entry of a single value field.
+func CompareValue(section string, field string, left string, right string) CompareEntry {
+	return CompareEntry{
+		Section: section,
+		Field:   field,
+		Left:    left,
+		Right:   right,
+	}
+}
+
+// CompareMaps returns compare entries of the union of left and right map keys.
+func CompareMaps(section string, left map[string]string, right map[string]string) []CompareEntry {
+	keys := make([]string, 0, len(left)+len(right))
+
+	for key := range left {
+		keys = append(keys, key)
+	}
+
+	for key := range right {
+		if _, ok := left[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	slices.Sort(keys)
+
+	entries := make([]CompareEntry, 0, len(keys))
+
+	for _, key := range keys {
+		entries = append(entries, CompareValue(section, key, left[key], right[key]))
+	}
+
+	return entries
+}
+
+// CompareSets returns compare entries of the union of left and right items.
+// Each item is shown on the side it is present.
+func CompareSets(section string, field string, left []string, right []string) []CompareEntry {
+	items := make([]string, 0, len(left)+len(right))
+	items = append(items, left...)
+	items = append(items, right...)
+
+	slices.Sort(items)
+	items = slices.Compact(items)
+
+	entries := make([]CompareEntry, 0, len(items))
+
+	for _, item := range items {
+		entry := CompareValue(section, field, "", "")
+
+		if slices.Contains(left, item) {
+			entry.Left = item
+		}
+
+		if slices.Contains(right, item) {
+			entry.Right = item
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// KeyValueToMap converts key=value list (i.e. environment variables) to map.
+func KeyValueToMap(items []string) map[string]string {
+	report := make(map[string]string)
+
+	for _, item := range items {
+		key, value, _ := strings.Cut(item, "=")
+		report[key] = value
+	}
+
+	return report
+}
@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"slices"
+	"strings"
+)
+
+// DetailsEntry implements a field of a resource details report.
+type DetailsEntry struct {
+	Section string
+	Field   string
+	Value   string
+}
+
+// DetailsValue returns details entry of a single value field.
+func DetailsValue(section string, field string, value string) DetailsEntry {
+	return DetailsEntry{
+		Section: section,
+		Field:   field,
+		Value:   value,
+	}
+}
+
+// DetailsMap returns details entries of a map, sorted by keys.
+func DetailsMap(section string, values map[string]string) []DetailsEntry {
+	report := make([]DetailsEntry, 0, len(values))
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		report = append(report, DetailsValue(section, key, values[key]))
+	}
+
+	return report
+}
+
+// DetailsList returns details entries of a list of values without field names.
+func DetailsList(section string, values []string) []DetailsEntry {
+	report := make([]DetailsEntry, 0, len(values))
+
+	for _, value := range values {
+		report = append(report, DetailsValue(section, "", value))
+	}
+
+	return report
+}
+
+// TailLines returns the last count non empty lines of the text chunks.
+func TailLines(chunks []string, count int) []string {
+	lines := make([]string, 0)
+
+	for _, chunk := range chunks {
+		for line := range strings.SplitSeq(chunk, "\n") {
+			line = strings.TrimRight(line, "\r")
+			if strings.TrimSpace(line) == "" {
+				continue
+			}
+
+			lines = append(lines, line)
+		}
+	}
+
+	if len(lines) > count {
+		lines = lines[len(lines)-count:]
+	}
+
+	return lines
+}
@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"time"
+
+	"go.podman.io/common/pkg/filters"
+)
+
+// prune filters keys.
+const (
+	PruneFilterUntil    = "until"
+	PruneFilterLabel    = "label"
+	PruneFilterNotLabel = "label!"
+)
+
+// PruneOptions prune filters and options.
+type PruneOptions struct {
+	Until     string
+	Labels    []string
+	NotLabels []string
+	// All removes all unused images and not only dangling images.
+	All bool
+	// Volumes removes unused volumes (system prune).
+	Volumes bool
+}
+
+// PruneReport is a resource which is (or would be) removed by prune.
+type PruneReport struct {
+	Type string
+	ID   string
+	Name string
+	Size int64
+}
+
+// Filters returns prune options as API filters.
+func (opts PruneOptions) Filters() map[string][]string {
+	pruneFilters := make(map[string][]string)
+
+	if opts.Until != "" {
+		pruneFilters[PruneFilterUntil] = []string{opts.Until}
+	}
+
+	if len(opts.Labels) > 0 {
+		pruneFilters[PruneFilterLabel] = opts.Labels
+	}
+
+	if len(opts.NotLabels) > 0 {
+		pruneFilters[PruneFilterNotLabel] = opts.NotLabels
+	}
+
+	return pruneFilters
+}
+
+// HasFilters returns true if any filter is set.
+func (opts PruneOptions) HasFilters() bool {
+	return len(opts.Filters()) > 0
+}
+
+// Match returns true if resource labels and creation time match the prune filters.
+func (opts PruneOptions) Match(labels map[string]string, created time.Time) (bool, error) {
+	if opts.Until != "" {
+		until, err := filters.ComputeUntilTimestamp([]string{opts.Until})
+		if err != nil {
+			return false, err
+		}
+
+		if !created.Before(until) {
+			return false, nil
+		}
+	}
+
+	if !filters.MatchLabelFilters(opts.Labels, labels) {
+		return false, nil
+	}
+
+	return filters.MatchNegatedLabelFilters(opts.NotLabels, labels), nil
+}
+
+// PruneReportsSize returns total size of the prune reports.
+func PruneReportsSize(reports []PruneReport) int64 {
+	var size int64
+
+	for _, report := range reports {
+		size += report.Size
+	}
+
+	return size
+}
@@ -0,0 +1,31 @@
+package utils
+
+// podman resource kinds.
+const (
+	ResourceKindContainer = "container"
+	ResourceKindPod       = "pod"
+	ResourceKindImage     = "image"
+	ResourceKindVolume    = "volume"
+	ResourceKindNetwork   = "network"
+	ResourceKindSecret    = "secret"
+)
+
+// ResourceKindConnection podman service connection kind.
+const ResourceKindConnection = "connection"
+
+// ResourceReference implements a reference to a podman resource.
+type ResourceReference struct {
+	Kind string
+	ID   string
+	Name string
+	Info string
+}
+
+// Target returns the resource ID, or its name if the resource ID is not known.
+func (ref ResourceReference) Target() string {
+	if ref.ID != "" {
+		return ref.ID
+	}
+
+	return ref.Name
+}
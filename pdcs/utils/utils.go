@@ -3,6 +3,7 @@ package utils
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -10,6 +11,9 @@ import (
 	"go.podman.io/common/libnetwork/types"
 )
 
+// shell safe characters which do not require quoting.
+var shellSafeArg = regexp.MustCompile(`^[A-Za-z0-9_@%+=:,./-]+$`)
+
 // SizeToStr converts size to human readable format.
 func SizeToStr(size int64) string {
 	return units.HumanSizeWithPrecision(float64(size), 3) //nolint:mnd
@@ -68,3 +72,43 @@ func PortsToString(ports []types.PortMapping) string {
 	// make sure to trim the last ", " of the string
 	return display[:len(display)-2]
 }
+
+// ResolveHomeDir converts a path referencing the home directory via "~"
+// to an absolute path.
+func ResolveHomeDir(path string) (string, error) {
+	// check if the path references the home dir to avoid work
+	// don't use strings.HasPrefix(path, "~") as this doesn't match "~" alone
+	// use strings.HasPrefix(...) to not match "something/~/something"
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		// path does not reference home dir -> Nothing to do
+		return path, nil
+	}
+
+	// only get HomeDir when necessary
+	home, err := UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	// replace the first "~" (start of path) with the HomeDir to resolve "~"
+	return strings.Replace(path, "~", home, 1), nil
+}
+
+// ShellQuote quotes the argument for POSIX shells if required.
+func ShellQuote(arg string) string {
+	if shellSafeArg.MatchString(arg) {
+		return arg
+	}
+
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
+
+// ShellJoin returns the arguments as a shell command line.
+func ShellJoin(args []string) string {
+	quoted := make([]string, 0, len(args))
+	for _, arg := range args {
+		quoted = append(quoted, ShellQuote(arg))
+	}
+
+	return strings.Join(quoted, " ")
+}
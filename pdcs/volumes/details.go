@@ -0,0 +1,68 @@
+package volumes
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/utils"
+	"github.com/docker/go-units"
+	"github.com/rs/zerolog/log"
+	"go.podman.io/podman/v6/pkg/bindings/containers"
+	"go.podman.io/podman/v6/pkg/bindings/volumes"
+)
+
+// volume details report sections.
+const (
+	DetailsSectionVolume     = "volume"
+	DetailsSectionOptions    = "options"
+	DetailsSectionLabels     = "labels"
+	DetailsSectionContainers = "containers"
+)
+
+// Details returns summary of volume driver, mountpoint, options, labels and the containers which use it.
+func Details(id string) ([]utils.DetailsEntry, error) {
+	log.Debug().Msgf("pdcs: podman volume details %s", id)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := volumes.Inspect(conn, id, new(volumes.InspectOptions))
+	if err != nil {
+		return nil, err
+	}
+
+	report := []utils.DetailsEntry{
+		utils.DetailsValue(DetailsSectionVolume, "name", data.Name),
+		utils.DetailsValue(DetailsSectionVolume, "driver", data.Driver),
+		utils.DetailsValue(DetailsSectionVolume, "mountpoint", data.Mountpoint),
+		utils.DetailsValue(DetailsSectionVolume, "scope", data.Scope),
+		utils.DetailsValue(DetailsSectionVolume, "created", units.HumanDuration(time.Since(data.CreatedAt))+" ago"),
+		utils.DetailsValue(DetailsSectionVolume, "mount count", strconv.FormatUint(uint64(data.MountCount), 10)),
+	}
+
+	report = append(report, utils.DetailsMap(DetailsSectionOptions, data.Options)...)
+	report = append(report, utils.DetailsMap(DetailsSectionLabels, data.Labels)...)
+
+	// containers which mount the volume
+	listOpts := new(containers.ListOptions).
+		WithAll(true).
+		WithFilters(map[string][]string{"volume": {data.Name}})
+
+	cntList, err := containers.List(conn, listOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	cntReport := make(map[string]string)
+	for _, cnt := range cntList {
+		cntReport[strings.Join(cnt.Names, ",")] = cnt.State
+	}
+
+	report = append(report, utils.DetailsMap(DetailsSectionContainers, cntReport)...)
+
+	return report, nil
+}
@@ -1,16 +1,22 @@
 package volumes
 
 import (
+	"context"
 	"fmt"
+	"slices"
 
 	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/utils"
 	"github.com/rs/zerolog/log"
+	"go.podman.io/podman/v6/pkg/bindings/containers"
+	"go.podman.io/podman/v6/pkg/bindings/system"
 	"go.podman.io/podman/v6/pkg/bindings/volumes"
+	"go.podman.io/podman/v6/pkg/domain/entities"
 )
 
-// Prune removes all unused volumes.
-func Prune() ([]string, error) {
-	log.Debug().Msg("pdcs: podman volume prune")
+// Prune removes all unused volumes matching the prune filters.
+func Prune(opts utils.PruneOptions) ([]string, error) {
+	log.Debug().Msgf("pdcs: podman volume prune %v", opts.Filters())
 
 	var (
 		report   []string
@@ -22,7 +28,7 @@ func Prune() ([]string, error) {
 		return report, err
 	}
 
-	response, err := volumes.Prune(conn, new(volumes.PruneOptions))
+	response, err := volumes.Prune(conn, new(volumes.PruneOptions).WithFilters(opts.Filters()))
 	if err != nil {
 		return report, err
 	}
@@ -36,3 +42,109 @@ func Prune() ([]string, error) {
 
 	return report, nil
 }
+
+// PrunePreview returns the unused volumes which would be removed by prune and their size.
+// The volumes are listed rather than using prune dry run, which older services ignore.
+func PrunePreview(opts utils.PruneOptions) ([]utils.PruneReport, error) {
+	return PrunePreviewAfter(opts, nil)
+}
+
+// PrunePreviewAfter returns the volumes which would be removed by prune once the
+// pruned containers (container ID set) are removed.
+func PrunePreviewAfter(opts utils.PruneOptions, prunedContainers map[string]bool) ([]utils.PruneReport, error) {
+	log.Debug().Msgf("pdcs: podman volume prune preview %v", opts.Filters())
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	filters := map[string][]string{"dangling": {"true"}}
+
+	response, err := volumes.List(conn, new(volumes.ListOptions).WithFilters(filters))
+	if err != nil {
+		return nil, err
+	}
+
+	released, err := releasedVolumes(conn, prunedContainers)
+	if err != nil {
+		return nil, err
+	}
+
+	response = append(response, released...)
+
+	dfReport, err := system.DiskUsage(conn, new(system.DiskOptions))
+	if err != nil {
+		return nil, err
+	}
+
+	volSizes := make(map[string]int64)
+
+	for _, vol := range dfReport.Volumes {
+		volSizes[vol.VolumeName] = vol.Size
+	}
+
+	reports := make([]utils.PruneReport, 0)
+
+	for _, vol := range response {
+		match, err := opts.Match(vol.Labels, vol.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		if !match {
+			continue
+		}
+
+		reports = append(reports, utils.PruneReport{
+			Type: "volume",
+			ID:   vol.Name,
+			Name: vol.Name,
+			Size: volSizes[vol.Name],
+		})
+	}
+
+	return reports, nil
+}
+
+// releasedVolumes returns the volumes of the pruned containers which are not used
+// by any other container.
+func releasedVolumes(conn context.Context, prunedContainers map[string]bool) ([]*entities.VolumeListReport, error) {
+	checked := make(map[string]bool)
+	released := make([]*entities.VolumeListReport, 0)
+
+	for cntID := range prunedContainers {
+		cntData, err := containers.Inspect(conn, cntID, new(containers.InspectOptions))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, mount := range cntData.Mounts {
+			if mount.Type != "volume" || checked[mount.Name] {
+				continue
+			}
+
+			checked[mount.Name] = true
+
+			filters := map[string][]string{"volume": {mount.Name}}
+
+			users, err := containers.List(conn, new(containers.ListOptions).WithAll(true).WithFilters(filters))
+			if err != nil {
+				return nil, err
+			}
+
+			if slices.ContainsFunc(users, func(cnt entities.ListContainer) bool { return !prunedContainers[cnt.ID] }) {
+				continue
+			}
+
+			volData, err := volumes.Inspect(conn, mount.Name, new(volumes.InspectOptions))
+			if err != nil {
+				return nil, err
+			}
+
+			released = append(released, &entities.VolumeListReport{VolumeConfigResponse: *volData})
+		}
+	}
+
+	return released, nil
+}
@@ -0,0 +1,474 @@
+package cntdialogs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	cntAutoUpdateDialogMaxWidth  = 120
+	cntAutoUpdateDialogMaxHeight = 22
+	cntAutoUpdateSelectedMark    = "[x]"
+	cntAutoUpdateUnselectedMark  = "[ ]"
+)
+
+const (
+	cntAutoUpdateTableFocus = 0 + iota
+	cntAutoUpdateFormFocus
+)
+
+const (
+	viewAutoUpdateSelectedColIndex = 0 + iota
+	viewAutoUpdateUnitColIndex
+	viewAutoUpdateNameColIndex
+	viewAutoUpdateImageColIndex
+	viewAutoUpdatePolicyColIndex
+	viewAutoUpdateUpdatedColIndex
+)
+
+// ContainerAutoUpdateDialog implements containers auto-update dialog primitive.
+// It lists containers with auto-update label, runs dry-run check and updates the selected units.
+type ContainerAutoUpdateDialog struct {
+	*tview.Box
+
+	layout        *tview.Flex
+	table         *tview.Table
+	details       *tview.TextView
+	form          *tview.Form
+	display       bool
+	focusElement  int
+	tableHeaders  []string
+	reports       []containers.AutoUpdateReport
+	selected      map[string]bool
+	dryRunHandler func()
+	updateHandler func()
+	cancelHandler func()
+}
+
+// NewContainerAutoUpdateDialog returns new containers auto-update dialog primitive.
+func NewContainerAutoUpdateDialog() *ContainerAutoUpdateDialog {
+	dialog := &ContainerAutoUpdateDialog{
+		Box:          tview.NewBox(),
+		layout:       tview.NewFlex().SetDirection(tview.FlexRow),
+		table:        tview.NewTable(),
+		details:      tview.NewTextView(),
+		form:         tview.NewForm(),
+		tableHeaders: []string{"", "unit", "container", "image", "policy", "updated"},
+		selected:     make(map[string]bool),
+	}
+
+	bgColor := style.DialogBgColor
+
+	// containers table
+	dialog.table.SetBackgroundColor(bgColor)
+	dialog.table.SetBorder(true)
+	dialog.table.SetBorderColor(style.DialogSubBoxBorderColor)
+	dialog.table.SetSelectedFunc(func(row, _ int) {
+		dialog.toggleUnit(row)
+	})
+	dialog.table.SetSelectionChangedFunc(func(row, _ int) {
+		dialog.setDetails(row)
+	})
+	dialog.initTable()
+
+	// details text view
+	dialog.details.SetBackgroundColor(bgColor)
+	dialog.details.SetTextColor(style.DialogFgColor)
+	dialog.details.SetDynamicColors(true)
+	dialog.details.SetWrap(true)
+
+	// form
+	dialog.form.AddButton("Close", nil)
+	dialog.form.AddButton("Dry Run", nil)
+	dialog.form.AddButton("Update", nil)
+	dialog.form.SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	tableLayout := tview.NewFlex().SetDirection(tview.FlexRow)
+	tableLayout.SetBackgroundColor(bgColor)
+	tableLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	tableLayout.AddItem(dialog.table, 0, 1, true)
+	tableLayout.AddItem(dialog.details, 2, 0, false) //nolint:mnd
+
+	mainLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	mainLayout.SetBackgroundColor(bgColor)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainLayout.AddItem(tableLayout, 0, 1, true)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetTitle("PODMAN AUTO-UPDATE")
+	dialog.layout.AddItem(mainLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *ContainerAutoUpdateDialog) Display() {
+	d.display = true
+	d.focusElement = cntAutoUpdateTableFocus
+	d.form.SetFocus(0)
+}
+
+// IsDisplay returns true if this primitive is shown.
+func (d *ContainerAutoUpdateDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *ContainerAutoUpdateDialog) Hide() {
+	d.display = false
+	d.focusElement = cntAutoUpdateTableFocus
+	d.SetReports(nil)
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *ContainerAutoUpdateDialog) HasFocus() bool {
+	if d.table.HasFocus() || d.form.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus() || d.layout.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *ContainerAutoUpdateDialog) Focus(delegate func(p tview.Primitive)) {
+	if d.focusElement == cntAutoUpdateTableFocus {
+		delegate(d.table)
+
+		return
+	}
+
+	button := d.form.GetButton(d.form.GetButtonCount() - 1)
+	button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == utils.SwitchFocusKey.Key {
+			d.focusElement = cntAutoUpdateTableFocus
+
+			d.Focus(delegate)
+			d.form.SetFocus(0)
+
+			return nil
+		}
+
+		return event
+	})
+
+	delegate(d.form)
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *ContainerAutoUpdateDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("container auto-update dialog: event %v received", event)
+
+		if event.Key() == utils.CloseDialogKey.Key {
+			d.cancelHandler()
+
+			return
+		}
+
+		if d.table.HasFocus() {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = cntAutoUpdateFormFocus
+				d.Focus(setFocus)
+
+				return
+			}
+
+			if event.Rune() == ' ' {
+				row, _ := d.table.GetSelection()
+				d.toggleUnit(row)
+
+				return
+			}
+
+			if handler := d.table.InputHandler(); handler != nil {
+				handler(utils.ParseKeyEventKey(event), setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+// SetRect set rects for this primitive.
+func (d *ContainerAutoUpdateDialog) SetRect(x, y, width, height int) {
+	if width > cntAutoUpdateDialogMaxWidth {
+		emptySpace := (width - cntAutoUpdateDialogMaxWidth) / 2 //nolint:mnd
+		x += emptySpace
+		width = cntAutoUpdateDialogMaxWidth
+	}
+
+	if height > cntAutoUpdateDialogMaxHeight {
+		emptySpace := (height - cntAutoUpdateDialogMaxHeight) / 2 //nolint:mnd
+		y += emptySpace
+		height = cntAutoUpdateDialogMaxHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// Draw draws this primitive into the screen.
+func (d *ContainerAutoUpdateDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.DrawForSubclass(screen, d)
+
+	x, y, width, height := d.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetDryRunFunc sets form dry run button selected function.
+func (d *ContainerAutoUpdateDialog) SetDryRunFunc(handler func()) *ContainerAutoUpdateDialog {
+	d.dryRunHandler = handler
+	dryRunButton := d.form.GetButton(d.form.GetButtonCount() - 2) //nolint:mnd
+
+	dryRunButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetUpdateFunc sets form update button selected function.
+func (d *ContainerAutoUpdateDialog) SetUpdateFunc(handler func()) *ContainerAutoUpdateDialog {
+	d.updateHandler = handler
+	updateButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+	updateButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetCancelFunc sets form close button selected function.
+func (d *ContainerAutoUpdateDialog) SetCancelFunc(handler func()) *ContainerAutoUpdateDialog {
+	d.cancelHandler = handler
+	closeButton := d.form.GetButton(d.form.GetButtonCount() - 3) //nolint:mnd
+
+	closeButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetReports sets auto-update containers table content.
+func (d *ContainerAutoUpdateDialog) SetReports(reports []containers.AutoUpdateReport) {
+	d.reports = reports
+	d.selected = make(map[string]bool)
+
+	d.refreshTable()
+}
+
+// UpdateReports updates the table rows from auto-update reports, matched by container name.
+// The units selection is kept.
+func (d *ContainerAutoUpdateDialog) UpdateReports(reports []containers.AutoUpdateReport) {
+	for _, report := range reports {
+		for i := range d.reports {
+			if d.reports[i].ContainerName == report.ContainerName {
+				d.reports[i] = report
+
+				break
+			}
+		}
+	}
+
+	d.refreshTable()
+}
+
+// GetSelectedContainers returns containers ID of the selected units.
+// The current row unit is used if no unit has been selected.
+func (d *ContainerAutoUpdateDialog) GetSelectedContainers() []string {
+	selectedUnits := d.selected
+
+	if !d.hasSelection() {
+		row, _ := d.table.GetSelection()
+		if row < 1 || row > len(d.reports) {
+			return nil
+		}
+
+		selectedUnits = map[string]bool{autoUpdateUnitKey(d.reports[row-1]): true}
+	}
+
+	cntIDs := make([]string, 0)
+
+	for _, report := range d.reports {
+		if selectedUnits[autoUpdateUnitKey(report)] {
+			cntIDs = append(cntIDs, report.ContainerID)
+		}
+	}
+
+	return cntIDs
+}
+
+func (d *ContainerAutoUpdateDialog) hasSelection() bool {
+	for _, selected := range d.selected {
+		if selected {
+			return true
+		}
+	}
+
+	return false
+}
+
+// toggleUnit toggles selection of all the containers of the row unit.
+func (d *ContainerAutoUpdateDialog) toggleUnit(row int) {
+	if row < 1 || row > len(d.reports) {
+		return
+	}
+
+	unit := autoUpdateUnitKey(d.reports[row-1])
+	d.selected[unit] = !d.selected[unit]
+
+	d.refreshTable()
+}
+
+func (d *ContainerAutoUpdateDialog) refreshTable() {
+	row, _ := d.table.GetSelection()
+
+	d.initTable()
+
+	for i, report := range d.reports {
+		mark := cntAutoUpdateUnselectedMark
+		if d.selected[autoUpdateUnitKey(report)] {
+			mark = cntAutoUpdateSelectedMark
+		}
+
+		unit := report.SystemdUnit
+		if unit == "" {
+			unit = "-"
+		}
+
+		updated := report.Updated
+		if updated == "" {
+			updated = "-"
+		}
+
+		rowIndex := i + 1
+
+		d.table.SetCell(rowIndex, viewAutoUpdateSelectedColIndex,
+			tview.NewTableCell(mark).
+				SetExpansion(0).
+				SetAlign(tview.AlignLeft))
+
+		d.table.SetCell(rowIndex, viewAutoUpdateUnitColIndex,
+			tview.NewTableCell(unit).
+				SetExpansion(1).
+				SetAlign(tview.AlignLeft))
+
+		d.table.SetCell(rowIndex, viewAutoUpdateNameColIndex,
+			tview.NewTableCell(report.ContainerName).
+				SetExpansion(1).
+				SetAlign(tview.AlignLeft))
+
+		d.table.SetCell(rowIndex, viewAutoUpdateImageColIndex,
+			tview.NewTableCell(report.ImageName).
+				SetExpansion(1).
+				SetAlign(tview.AlignLeft))
+
+		d.table.SetCell(rowIndex, viewAutoUpdatePolicyColIndex,
+			tview.NewTableCell(report.Policy).
+				SetExpansion(0).
+				SetAlign(tview.AlignLeft))
+
+		d.table.SetCell(rowIndex, viewAutoUpdateUpdatedColIndex,
+			tview.NewTableCell(updated).
+				SetExpansion(0).
+				SetTextColor(autoUpdateStatusColor(report.Updated)).
+				SetAlign(tview.AlignLeft))
+	}
+
+	if len(d.reports) == 0 {
+		d.details.SetText("")
+
+		return
+	}
+
+	if row < 1 || row > len(d.reports) {
+		row = 1
+	}
+
+	d.table.Select(row, 0)
+	d.setDetails(row)
+}
+
+// setDetails displays the row container ID and auto-update error.
+func (d *ContainerAutoUpdateDialog) setDetails(row int) {
+	if row < 1 || row > len(d.reports) {
+		d.details.SetText("")
+
+		return
+	}
+
+	report := d.reports[row-1]
+	details := fmt.Sprintf("container id: %s", utils.GetIDWithLimit(report.ContainerID))
+
+	if report.Error != "" {
+		details = fmt.Sprintf("%s\n[red::]%s[-::]", details, tview.Escape(report.Error))
+	}
+
+	d.details.SetText(details)
+}
+
+func (d *ContainerAutoUpdateDialog) initTable() {
+	bgColor := style.TableHeaderBgColor
+	fgColor := style.TableHeaderFgColor
+
+	d.table.Clear()
+	d.table.SetFixed(1, 1)
+	d.table.SetSelectable(true, false)
+
+	for i := range d.tableHeaders {
+		d.table.SetCell(0, i,
+			tview.NewTableCell(fmt.Sprintf("[%s::b]%s", style.GetColorHex(fgColor), strings.ToUpper(d.tableHeaders[i]))).
+				SetExpansion(1).
+				SetBackgroundColor(bgColor).
+				SetTextColor(fgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
+	}
+}
+
+// autoUpdateUnitKey returns the container systemd unit or its name if not managed by systemd.
+// The container name is used as the container ID changes once updated.
+func autoUpdateUnitKey(report containers.AutoUpdateReport) string {
+	if report.SystemdUnit != "" {
+		return report.SystemdUnit
+	}
+
+	return report.ContainerName
+}
+
+func autoUpdateStatusColor(status string) tcell.Color {
+	switch status {
+	case containers.AutoUpdateStatusTrue:
+		return style.PrgBarOKColor
+	case containers.AutoUpdateStatusPending:
+		return style.PrgBarWarnColor
+	case containers.AutoUpdateStatusFailed, containers.AutoUpdateStatusRolledBack:
+		return style.PrgBarCritColor
+	}
+
+	return style.DialogFgColor
+}
@@ -0,0 +1,114 @@
+package cntdialogs
+
+import (
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("container auto-update", Ordered, func() {
+	var autoUpdateDialogApp *tview.Application
+	var autoUpdateDialogScreen tcell.SimulationScreen
+	var autoUpdateDialog *ContainerAutoUpdateDialog
+	var runApp func()
+
+	dryRunAction := "initial"
+	updateAction := "initial"
+	cancelAction := "initial"
+
+	reports := []containers.AutoUpdateReport{
+		{ContainerID: "cnt01", ContainerName: "web", Policy: "registry", SystemdUnit: "web.service"},
+		{ContainerID: "cnt02", ContainerName: "web-sidecar", Policy: "registry", SystemdUnit: "web.service"},
+		{ContainerID: "cnt03", ContainerName: "db", Policy: "local"},
+	}
+
+	BeforeAll(func() {
+		autoUpdateDialogApp = tview.NewApplication()
+		autoUpdateDialog = NewContainerAutoUpdateDialog()
+		autoUpdateDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := autoUpdateDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := autoUpdateDialogApp.SetScreen(autoUpdateDialogScreen).SetRoot(autoUpdateDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		autoUpdateDialog.SetDryRunFunc(func() { dryRunAction = "dry-run" })
+		autoUpdateDialog.SetUpdateFunc(func() { updateAction = "update" })
+		autoUpdateDialog.SetCancelFunc(func() { cancelAction = "cancel" })
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		autoUpdateDialog.SetReports(reports)
+		autoUpdateDialog.Display()
+		autoUpdateDialogApp.SetFocus(autoUpdateDialog)
+		autoUpdateDialogApp.Draw()
+		Expect(autoUpdateDialog.IsDisplay()).To(Equal(true))
+		Expect(autoUpdateDialog.HasFocus()).To(Equal(true))
+		Expect(autoUpdateDialog.table.GetRowCount()).To(Equal(4))
+	})
+
+	It("current row unit containers", func() {
+		Expect(autoUpdateDialog.GetSelectedContainers()).To(Equal([]string{"cnt01", "cnt02"}))
+	})
+
+	It("select unit", func() {
+		autoUpdateDialog.table.Select(3, 0)
+		autoUpdateDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyRune, ' ', tcell.ModNone))
+		autoUpdateDialogApp.Draw()
+		autoUpdateDialog.table.Select(1, 0)
+		Expect(autoUpdateDialog.GetSelectedContainers()).To(Equal([]string{"cnt03"}))
+	})
+
+	It("update reports", func() {
+		autoUpdateDialog.UpdateReports([]containers.AutoUpdateReport{
+			{ContainerID: "cnt04", ContainerName: "db", Policy: "local", Updated: containers.AutoUpdateStatusTrue},
+		})
+		Expect(autoUpdateDialog.table.GetCell(3, viewAutoUpdateUpdatedColIndex).Text).To(Equal("true"))
+		Expect(autoUpdateDialog.GetSelectedContainers()).To(Equal([]string{"cnt04"}))
+	})
+
+	It("auto-update error details", func() {
+		autoUpdateDialog.UpdateReports([]containers.AutoUpdateReport{
+			{ContainerID: "cnt05", ContainerName: "web", Policy: "registry", SystemdUnit: "web.service",
+				Updated: containers.AutoUpdateStatusFailed, Error: "unit restart failed"},
+		})
+		autoUpdateDialog.setDetails(1)
+		Expect(autoUpdateDialog.details.GetText(true)).To(ContainSubstring("unit restart failed"))
+	})
+
+	It("form actions", func() {
+		autoUpdateDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		autoUpdateDialogApp.Draw()
+		autoUpdateDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		autoUpdateDialogApp.Draw()
+		autoUpdateDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		autoUpdateDialogApp.Draw()
+		Expect(dryRunAction).To(Equal("dry-run"))
+		autoUpdateDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		autoUpdateDialogApp.Draw()
+		autoUpdateDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		autoUpdateDialogApp.Draw()
+		Expect(updateAction).To(Equal("update"))
+		autoUpdateDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
+		autoUpdateDialogApp.Draw()
+		Expect(cancelAction).To(Equal("cancel"))
+	})
+
+	It("hide", func() {
+		autoUpdateDialog.Hide()
+		Expect(autoUpdateDialog.IsDisplay()).To(Equal(false))
+		Expect(autoUpdateDialog.table.GetRowCount()).To(Equal(1))
+	})
+
+	AfterAll(func() {
+		autoUpdateDialogApp.Stop()
+	})
+})
@@ -0,0 +1,420 @@
+package cntdialogs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	cntCloneDialogMaxWidth     = 90
+	cntCloneDialogMaxHeight    = 15
+	cntCloneDialogLabelPadding = 1
+)
+
+const (
+	cntCloneNameFocus = 0 + iota
+	cntClonePodFocus
+	cntCloneImageFocus
+	cntCloneCPUsFocus
+	cntCloneMemoryFocus
+	cntCloneDestroyFocus
+	cntCloneRunFocus
+	cntCloneFormFocus
+)
+
+// ContainerCloneDialog represents container clone dialog primitive.
+type ContainerCloneDialog struct {
+	*tview.Box
+
+	layout        *tview.Flex
+	cntInfo       *tview.InputField
+	name          *tview.InputField
+	pod           *tview.InputField
+	image         *tview.InputField
+	cpus          *tview.InputField
+	memory        *tview.InputField
+	destroy       *tview.Checkbox
+	run           *tview.Checkbox
+	form          *tview.Form
+	display       bool
+	cloneHandler  func()
+	cancelHandler func()
+	focusElement  int
+}
+
+// NewContainerCloneDialog returns new container clone dialog primitive.
+func NewContainerCloneDialog() *ContainerCloneDialog {
+	dialog := &ContainerCloneDialog{
+		Box:     tview.NewBox(),
+		cntInfo: tview.NewInputField(),
+		layout:  tview.NewFlex(),
+		name:    tview.NewInputField(),
+		pod:     tview.NewInputField(),
+		image:   tview.NewInputField(),
+		cpus:    tview.NewInputField(),
+		memory:  tview.NewInputField(),
+		destroy: tview.NewCheckbox(),
+		run:     tview.NewCheckbox(),
+		form:    tview.NewForm(),
+	}
+
+	labelWidth := 8
+
+	// container info input field
+	dialog.cntInfo.SetBackgroundColor(style.DialogBgColor)
+	dialog.cntInfo.SetLabel("[::b]" + utils.ContainerIDLabel)
+	dialog.cntInfo.SetFieldBackgroundColor(style.DialogBgColor)
+	dialog.cntInfo.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(style.DialogFgColor))
+
+	// name field
+	dialog.name.SetBackgroundColor(style.DialogBgColor)
+	dialog.name.SetLabel(utils.StringToInputLabel("name:", labelWidth))
+	dialog.name.SetFieldStyle(style.InputFieldStyle)
+	dialog.name.SetLabelStyle(style.InputLabelStyle)
+
+	// pod field
+	dialog.pod.SetBackgroundColor(style.DialogBgColor)
+	dialog.pod.SetLabel(utils.StringToInputLabel("pod:", labelWidth))
+	dialog.pod.SetFieldStyle(style.InputFieldStyle)
+	dialog.pod.SetLabelStyle(style.InputLabelStyle)
+
+	// image field
+	dialog.image.SetBackgroundColor(style.DialogBgColor)
+	dialog.image.SetLabel(utils.StringToInputLabel("image:", labelWidth))
+	dialog.image.SetFieldStyle(style.InputFieldStyle)
+	dialog.image.SetLabelStyle(style.InputLabelStyle)
+
+	// cpus field
+	dialog.cpus.SetBackgroundColor(style.DialogBgColor)
+	dialog.cpus.SetLabel(utils.StringToInputLabel("cpus:", labelWidth))
+	dialog.cpus.SetFieldStyle(style.InputFieldStyle)
+	dialog.cpus.SetLabelStyle(style.InputLabelStyle)
+
+	// memory field
+	dialog.memory.SetBackgroundColor(style.DialogBgColor)
+	dialog.memory.SetLabel(utils.StringToInputLabel("memory:", labelWidth))
+	dialog.memory.SetFieldStyle(style.InputFieldStyle)
+	dialog.memory.SetLabelStyle(style.InputLabelStyle)
+
+	// destroy checkbox
+	destroyLabel := "destroy original:"
+
+	dialog.destroy.SetBackgroundColor(style.DialogBgColor)
+	dialog.destroy.SetLabelColor(style.DialogFgColor)
+	dialog.destroy.SetLabel(destroyLabel)
+	dialog.destroy.SetLabelWidth(len(destroyLabel) + 1)
+	dialog.destroy.SetFieldBackgroundColor(style.FieldBackgroundColor)
+
+	// run checkbox
+	runLabel := "start clone:"
+
+	dialog.run.SetBackgroundColor(style.DialogBgColor)
+	dialog.run.SetLabelColor(style.DialogFgColor)
+	dialog.run.SetLabel(runLabel)
+	dialog.run.SetLabelWidth(len(runLabel) + 1)
+	dialog.run.SetFieldBackgroundColor(style.FieldBackgroundColor)
+
+	// form
+	dialog.form.AddButton("Cancel", nil)
+	dialog.form.AddButton("Clone", nil)
+	dialog.form.SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(style.DialogBgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// name and pod layout row
+	npLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	npLayout.SetBackgroundColor(style.DialogBgColor)
+	npLayout.AddItem(dialog.name, 0, 1, true)
+	npLayout.AddItem(utils.EmptyBoxSpace(style.DialogBgColor), 2, 0, false) //nolint:mnd
+	npLayout.AddItem(dialog.pod, 0, 1, true)
+
+	// cpus and memory layout row
+	cmLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	cmLayout.SetBackgroundColor(style.DialogBgColor)
+	cmLayout.AddItem(dialog.cpus, 0, 1, true)
+	cmLayout.AddItem(utils.EmptyBoxSpace(style.DialogBgColor), 2, 0, false) //nolint:mnd
+	cmLayout.AddItem(dialog.memory, 0, 1, true)
+
+	// checkbox layout row
+	cbLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	cbLayout.SetBackgroundColor(style.DialogBgColor)
+	cbLayout.AddItem(dialog.destroy, 0, 1, true)
+	cbLayout.AddItem(dialog.run, 0, 1, true)
+	cbLayout.AddItem(utils.EmptyBoxSpace(style.DialogBgColor), 0, 1, false)
+
+	// inputs layout
+	layout := tview.NewFlex().SetDirection(tview.FlexRow)
+	layout.AddItem(utils.EmptyBoxSpace(style.DialogBgColor), 0, 1, false)
+	layout.AddItem(dialog.cntInfo, 0, 1, true)
+	layout.AddItem(utils.EmptyBoxSpace(style.DialogBgColor), 0, 1, false)
+	layout.AddItem(npLayout, 0, 1, true)
+	layout.AddItem(utils.EmptyBoxSpace(style.DialogBgColor), 0, 1, false)
+	layout.AddItem(dialog.image, 0, 1, true)
+	layout.AddItem(utils.EmptyBoxSpace(style.DialogBgColor), 0, 1, false)
+	layout.AddItem(cmLayout, 0, 1, true)
+	layout.AddItem(utils.EmptyBoxSpace(style.DialogBgColor), 0, 1, false)
+	layout.AddItem(cbLayout, 0, 1, true)
+
+	inputLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	inputLayout.SetBackgroundColor(style.DialogBgColor)
+	inputLayout.AddItem(utils.EmptyBoxSpace(style.DialogBgColor), 1, 0, false)
+	inputLayout.AddItem(layout, 0, 1, true)
+	inputLayout.AddItem(utils.EmptyBoxSpace(style.DialogBgColor), 1, 0, false)
+
+	// main layout
+	dialog.layout.SetDirection(tview.FlexRow)
+	dialog.layout.SetBackgroundColor(style.DialogBgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetTitle("PODMAN CONTAINER CLONE")
+	dialog.layout.AddItem(inputLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
+
+	dialog.Hide()
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *ContainerCloneDialog) Display() {
+	d.display = true
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *ContainerCloneDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *ContainerCloneDialog) Hide() {
+	d.display = false
+	d.focusElement = cntCloneNameFocus
+	d.name.SetText("")
+	d.pod.SetText("")
+	d.image.SetText("")
+	d.cpus.SetText("")
+	d.memory.SetText("")
+	d.destroy.SetChecked(false)
+	d.run.SetChecked(false)
+	d.SetContainerInfo("", "")
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *ContainerCloneDialog) HasFocus() bool { //nolint:cyclop
+	if d.name.HasFocus() || d.pod.HasFocus() {
+		return true
+	}
+
+	if d.image.HasFocus() || d.cpus.HasFocus() {
+		return true
+	}
+
+	if d.memory.HasFocus() || d.destroy.HasFocus() {
+		return true
+	}
+
+	if d.run.HasFocus() || d.form.HasFocus() {
+		return true
+	}
+
+	if d.layout.HasFocus() || d.Box.HasFocus() {
+		return true
+	}
+
+	return false
+}
+
+// Focus is called when this primitive receives focus.
+func (d *ContainerCloneDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case cntCloneNameFocus:
+		delegate(d.name)
+	case cntClonePodFocus:
+		delegate(d.pod)
+	case cntCloneImageFocus:
+		delegate(d.image)
+	case cntCloneCPUsFocus:
+		delegate(d.cpus)
+	case cntCloneMemoryFocus:
+		delegate(d.memory)
+	case cntCloneDestroyFocus:
+		delegate(d.destroy)
+	case cntCloneRunFocus:
+		delegate(d.run)
+	case cntCloneFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = cntCloneNameFocus
+
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *ContainerCloneDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("container clone dialog: event %v received", event)
+
+		if event.Key() == tcell.KeyEsc {
+			d.cancelHandler()
+
+			return
+		}
+
+		if event.Key() == utils.SwitchFocusKey.Key {
+			d.setFocusElement()
+		}
+
+		for _, primitive := range d.inputPrimitives() {
+			if primitive.HasFocus() {
+				if handler := primitive.InputHandler(); handler != nil {
+					handler(event, setFocus)
+
+					return
+				}
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+// SetRect set rects for this primitive.
+func (d *ContainerCloneDialog) SetRect(x, y, width, height int) {
+	if width > cntCloneDialogMaxWidth {
+		emptySpace := (width - cntCloneDialogMaxWidth) / 2 //nolint:mnd
+		x += emptySpace
+		width = cntCloneDialogMaxWidth
+	}
+
+	if height > cntCloneDialogMaxHeight {
+		emptySpace := (height - cntCloneDialogMaxHeight) / 2 //nolint:mnd
+		y += emptySpace
+		height = cntCloneDialogMaxHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *ContainerCloneDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.DrawForSubclass(screen, d)
+
+	x, y, width, height := d.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetCloneFunc sets form clone button selected function.
+func (d *ContainerCloneDialog) SetCloneFunc(handler func()) *ContainerCloneDialog {
+	d.cloneHandler = handler
+	cloneButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+	cloneButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *ContainerCloneDialog) SetCancelFunc(handler func()) *ContainerCloneDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 2) //nolint:mnd
+
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetContainerInfo sets selected container ID and name in clone dialog.
+func (d *ContainerCloneDialog) SetContainerInfo(id string, name string) {
+	containerInfo := fmt.Sprintf("%s (%s)", id, name)
+	containerInfo = utils.LabelWidthLeftPadding(containerInfo, cntCloneDialogLabelPadding)
+
+	d.cntInfo.SetText(containerInfo)
+}
+
+// SetCloneOptions sets clone dialog fields from the original container values.
+func (d *ContainerCloneDialog) SetCloneOptions(opts containers.CloneOptions) {
+	d.name.SetText(opts.Name)
+	d.pod.SetText(opts.Pod)
+	d.image.SetText(opts.Image)
+	d.cpus.SetText(opts.CPUs)
+	d.memory.SetText(opts.Memory)
+	d.destroy.SetChecked(opts.Destroy)
+	d.run.SetChecked(opts.Run)
+}
+
+// GetCloneOptions returns container clone options.
+func (d *ContainerCloneDialog) GetCloneOptions() containers.CloneOptions {
+	return containers.CloneOptions{
+		Name:    strings.TrimSpace(d.name.GetText()),
+		Pod:     strings.TrimSpace(d.pod.GetText()),
+		Image:   strings.TrimSpace(d.image.GetText()),
+		CPUs:    strings.TrimSpace(d.cpus.GetText()),
+		Memory:  strings.TrimSpace(d.memory.GetText()),
+		Destroy: d.destroy.IsChecked(),
+		Run:     d.run.IsChecked(),
+	}
+}
+
+func (d *ContainerCloneDialog) inputPrimitives() []tview.Primitive {
+	return []tview.Primitive{
+		d.name,
+		d.pod,
+		d.image,
+		d.cpus,
+		d.memory,
+		d.destroy,
+		d.run,
+	}
+}
+
+func (d *ContainerCloneDialog) setFocusElement() {
+	switch d.focusElement {
+	case cntCloneNameFocus:
+		d.focusElement = cntClonePodFocus
+	case cntClonePodFocus:
+		d.focusElement = cntCloneImageFocus
+	case cntCloneImageFocus:
+		d.focusElement = cntCloneCPUsFocus
+	case cntCloneCPUsFocus:
+		d.focusElement = cntCloneMemoryFocus
+	case cntCloneMemoryFocus:
+		d.focusElement = cntCloneDestroyFocus
+	case cntCloneDestroyFocus:
+		d.focusElement = cntCloneRunFocus
+	case cntCloneRunFocus:
+		d.focusElement = cntCloneFormFocus
+	}
+}
@@ -0,0 +1,160 @@
+package cntdialogs
+
+import (
+	"fmt"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("container clone", Ordered, func() {
+	var containerCloneApp *tview.Application
+	var containerCloneScreen tcell.SimulationScreen
+	var cloneDialog *ContainerCloneDialog
+	var runApp func()
+
+	BeforeAll(func() {
+		containerCloneApp = tview.NewApplication()
+		cloneDialog = NewContainerCloneDialog()
+		containerCloneScreen = tcell.NewSimulationScreen("UTF-8")
+		err := containerCloneScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := containerCloneApp.SetScreen(containerCloneScreen).SetRoot(cloneDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		cloneDialog.Display()
+		Expect(cloneDialog.IsDisplay()).To(Equal(true))
+	})
+
+	It("set focus", func() {
+		containerCloneApp.SetFocus(cloneDialog)
+		Expect(cloneDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("set container info", func() {
+		cntID := "cntID"
+		cntName := "cntName"
+		cntInfoWants := fmt.Sprintf(" %s (%s)", cntID, cntName)
+		cloneDialog.SetContainerInfo(cntID, cntName)
+		Expect(cloneDialog.cntInfo.GetText()).To(Equal(cntInfoWants))
+	})
+
+	It("cancel button selected", func() {
+		cancelFunc := func() {
+			cloneDialog.Hide()
+		}
+		cloneDialog.Hide()
+		containerCloneApp.Draw()
+		cloneDialog.SetCancelFunc(cancelFunc)
+		cloneDialog.Display()
+		containerCloneApp.Draw()
+		containerCloneApp.SetFocus(cloneDialog.form)
+		containerCloneApp.Draw()
+		containerCloneApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		containerCloneApp.Draw()
+		Expect(cloneDialog.IsDisplay()).To(Equal(false))
+	})
+
+	It("clone button selected", func() {
+		cloneButton := "initial"
+		cloneButtonWants := "clone selected"
+		cloneFunc := func() {
+			cloneButton = cloneButtonWants
+		}
+		cloneDialog.Hide()
+		containerCloneApp.Draw()
+		cloneDialog.SetCloneFunc(cloneFunc)
+		cloneDialog.Display()
+		containerCloneApp.Draw()
+		containerCloneApp.SetFocus(cloneDialog.form)
+		containerCloneApp.Draw()
+		containerCloneApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		containerCloneApp.Draw()
+		containerCloneApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		containerCloneApp.Draw()
+		Expect(cloneButton).To(Equal(cloneButtonWants))
+	})
+
+	It("set clone options", func() {
+		opts := containers.CloneOptions{
+			Name:   "cnt01-clone",
+			Pod:    "pod01",
+			Image:  "docker.io/library/nginx:latest",
+			CPUs:   "1.5",
+			Memory: "536870912",
+		}
+
+		cloneDialog.Hide()
+		containerCloneApp.Draw()
+		cloneDialog.SetCloneOptions(opts)
+		cloneDialog.Display()
+		containerCloneApp.Draw()
+
+		Expect(cloneDialog.GetCloneOptions()).To(Equal(opts))
+	})
+
+	It("get clone options", func() {
+		cloneDialog.Hide()
+		containerCloneApp.Draw()
+		cloneDialog.SetCloneOptions(containers.CloneOptions{Name: "a"})
+		cloneDialog.Display()
+		containerCloneApp.Draw()
+
+		// name input field
+		cloneDialog.focusElement = cntCloneNameFocus
+		containerCloneApp.SetFocus(cloneDialog)
+		containerCloneApp.Draw()
+		containerCloneApp.QueueEvent(tcell.NewEventKey(256, 98, tcell.ModNone))
+		containerCloneApp.Draw()
+
+		// cpus input field
+		cloneDialog.focusElement = cntCloneCPUsFocus
+		containerCloneApp.SetFocus(cloneDialog)
+		containerCloneApp.Draw()
+		containerCloneApp.QueueEvent(tcell.NewEventKey(256, 50, tcell.ModNone))
+		containerCloneApp.Draw()
+
+		// destroy checkbox
+		cloneDialog.focusElement = cntCloneDestroyFocus
+		containerCloneApp.SetFocus(cloneDialog)
+		containerCloneApp.Draw()
+		containerCloneApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		containerCloneApp.Draw()
+
+		// run checkbox
+		cloneDialog.setFocusElement()
+		containerCloneApp.SetFocus(cloneDialog)
+		containerCloneApp.Draw()
+		containerCloneApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		containerCloneApp.Draw()
+
+		cloneOpts := cloneDialog.GetCloneOptions()
+		Expect(cloneOpts.Name).To(Equal("ab"))
+		Expect(cloneOpts.CPUs).To(Equal("2"))
+		Expect(cloneOpts.Destroy).To(Equal(true))
+		Expect(cloneOpts.Run).To(Equal(true))
+	})
+
+	It("hide", func() {
+		cloneDialog.Hide()
+		Expect(cloneDialog.IsDisplay()).To(Equal(false))
+		Expect(cloneDialog.GetCloneOptions()).To(Equal(containers.CloneOptions{}))
+	})
+
+	AfterAll(func() {
+		containerCloneApp.Stop()
+	})
+})
@@ -28,6 +28,7 @@ const (
 const (
 	ContainerCreateOnlyDialogMode = 0 + iota
 	ContainerCreateAndRunDialogMode
+	ContainerRecreateDialogMode
 )
 
 const (
@@ -74,9 +75,11 @@ const (
 	createContainerDNSServersFieldFocus
 	createContainerDNSOptionsFieldFocus
 	createContainerDNSSearchFieldFocus
+	createContainerMountTypeFieldFocus
+	createContainerMountVolumeFieldFocus
+	createContainerMountSpecFieldFocus
+	createContainerMountsTableFocus
 	createContainerImageVolumeFieldFocus
-	createContainerVolumeFieldFocus
-	createContainerMountFieldFocus
 	createContainerHealthCmdFieldFocus
 	createContainerHealthStartupCmdFieldFocus
 	createContainerHealthOnFailureFieldFocus
@@ -158,6 +161,7 @@ type ContainerCreateDialog struct {
 	focusElement                        int
 	imageList                           []images.ImageListReporter
 	podList                             []*entities.ListPodsReport
+	extraOptions                        containers.CreateOptions
 	containerNameField                  *tview.InputField
 	containerCommandField               *tview.InputField
 	containerImageField                 *tview.DropDown
@@ -212,9 +216,13 @@ type ContainerCreateDialog struct {
 	containerHealthLogDestField         *tview.InputField
 	containerHealthMaxLogCountField     *tview.InputField
 	containerHealthMaxLogSizeField      *tview.InputField
-	containerVolumeField                *tview.InputField
+	containerMountTypeField             *tview.DropDown
+	containerMountVolumeField           *tview.DropDown
+	containerMountSpecField             *tview.InputField
+	containerMountHint                  *tview.TextView
+	containerMountsTable                *tview.Table
 	containerImageVolumeField           *tview.DropDown
-	containerMountField                 *tview.InputField
+	mountEntries                        []mountEntry
 	containerMemoryField                *tview.InputField
 	containerMemoryReservationField     *tview.InputField
 	containerMemorySwapField            *tview.InputField
@@ -240,6 +248,8 @@ type ContainerCreateDialog struct {
 	containerNamespaceSubgidNameField   *tview.InputField
 	cancelHandler                       func()
 	enterHandler                        func()
+	presetsHandler                      func()
+	savePresetHandler                   func()
 }
 
 // NewContainerCreateDialog returns new container create dialog primitive ContainerCreateDialog.
@@ -317,9 +327,12 @@ func NewContainerCreateDialog(mode ContainerCreateDialogMode) *ContainerCreateDi
 		containerDNSServersField:            tview.NewInputField(),
 		containerDNSOptionsField:            tview.NewInputField(),
 		containerDNSSearchField:             tview.NewInputField(),
-		containerVolumeField:                tview.NewInputField(),
+		containerMountTypeField:             tview.NewDropDown(),
+		containerMountVolumeField:           tview.NewDropDown(),
+		containerMountSpecField:             tview.NewInputField(),
+		containerMountHint:                  tview.NewTextView(),
+		containerMountsTable:                tview.NewTable(),
 		containerImageVolumeField:           tview.NewDropDown(),
-		containerMountField:                 tview.NewInputField(),
 		containerHealthCmdField:             tview.NewInputField(),
 		containerHealthIntervalField:        tview.NewInputField(),
 		containerHealthOnFailureField:       tview.NewDropDown(),
@@ -370,6 +383,7 @@ func NewContainerCreateDialog(mode ContainerCreateDialogMode) *ContainerCreateDi
 func (d *ContainerCreateDialog) Display() {
 	d.display = true
 	d.initData()
+	d.form.SetFocus(0)
 	d.focusElement = createCategoryPagesFocus
 }
 
@@ -529,12 +543,16 @@ func (d *ContainerCreateDialog) Focus(delegate func(p tview.Primitive)) { //noli
 	case createContainerDNSSearchFieldFocus:
 		delegate(d.containerDNSSearchField)
 	// volume page
-	case createContainerVolumeFieldFocus:
-		delegate(d.containerVolumeField)
+	case createContainerMountTypeFieldFocus:
+		delegate(d.containerMountTypeField)
+	case createContainerMountVolumeFieldFocus:
+		delegate(d.containerMountVolumeField)
+	case createContainerMountSpecFieldFocus:
+		delegate(d.containerMountSpecField)
+	case createContainerMountsTableFocus:
+		delegate(d.containerMountsTable)
 	case createContainerImageVolumeFieldFocus:
 		delegate(d.containerImageVolumeField)
-	case createContainerMountFieldFocus:
-		delegate(d.containerMountField)
 	// health page
 	case createContainerHealthCmdFieldFocus:
 		delegate(d.containerHealthCmdField)
@@ -719,6 +737,13 @@ func (d *ContainerCreateDialog) InputHandler() func(event *tcell.EventKey, setFo
 					d.setVolumeSettingsPageNextFocus()
 				}
 
+				if event.Key() == tcell.KeyDelete && d.containerMountsTable.HasFocus() {
+					row, _ := d.containerMountsTable.GetSelection()
+					d.removeMountEntry(row)
+
+					return
+				}
+
 				handler(event, setFocus)
 
 				return
@@ -842,6 +867,243 @@ func (d *ContainerCreateDialog) SetHandlerFunc(handler func()) *ContainerCreateD
 	return d
 }
 
+// SetSavePresetFunc sets form save preset button selected function.
+func (d *ContainerCreateDialog) SetSavePresetFunc(handler func()) *ContainerCreateDialog {
+	d.savePresetHandler = handler
+	savePresetButton := d.form.GetButton(d.form.GetButtonCount() - 3) //nolint:mnd
+
+	savePresetButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetPresetsFunc sets form presets button selected function.
+func (d *ContainerCreateDialog) SetPresetsFunc(handler func()) *ContainerCreateDialog {
+	d.presetsHandler = handler
+	presetsButton := d.form.GetButton(d.form.GetButtonCount() - 4) //nolint:mnd
+
+	presetsButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// ContainerPresetOptions returns container create options to be saved as a preset.
+// The image and pod are referenced by their names instead of IDs.
+func (d *ContainerCreateDialog) ContainerPresetOptions() containers.CreateOptions {
+	opts := d.ContainerCreateOptions()
+
+	if index, image := d.containerImageField.GetCurrentOption(); index > 0 && image != "<none>" {
+		opts.Image = image
+	}
+
+	if index, pod := d.containerPodField.GetCurrentOption(); index > 0 {
+		opts.Pod = pod
+	}
+
+	return opts
+}
+
+// SetContainerCreateOptions sets the dialog fields from the given container create options.
+// The options which have no dialog fields (e.g. restart policy or additional networks)
+// are kept and returned by ContainerCreateOptions.
+func (d *ContainerCreateDialog) SetContainerCreateOptions(opts containers.CreateOptions) { //nolint:cyclop
+	d.extraOptions = opts
+	d.containerNameField.SetText(opts.Name)
+	d.containerCommandField.SetText(opts.Command)
+	d.containerImageField.SetCurrentOption(d.imageOptionIndex(opts.Image))
+	d.containerPodField.SetCurrentOption(d.podOptionIndex(opts.Pod))
+	d.containerLabelsField.SetText(strings.Join(opts.Labels, " "))
+	d.containerRemoveField.SetChecked(opts.Remove)
+	d.containerPrivilegedField.SetChecked(opts.Privileged)
+	d.containerTimeoutField.SetText(opts.Timeout)
+	d.containerSecretField.SetText(strings.Join(opts.Secret, " "))
+	d.containerInteractiveField.SetChecked(opts.Interactive)
+	d.containerTtyField.SetChecked(opts.TTY)
+	d.containerDetachField.SetChecked(opts.Detach)
+
+	// environment category
+	d.containerWorkDirField.SetText(opts.WorkDir)
+	d.containerEnvVarsField.SetText(strings.Join(opts.EnvVars, " "))
+	d.containerEnvFileField.SetText(strings.Join(opts.EnvFile, " "))
+	d.containerEnvMergeField.SetText(strings.Join(opts.EnvMerge, " "))
+	d.containerUnsetEnvField.SetText(strings.Join(opts.UnsetEnv, " "))
+	d.containerEnvHostField.SetChecked(opts.EnvHost)
+	d.containerUnsetEnvAllField.SetChecked(opts.UnsetEnvAll)
+	d.containerUmaskField.SetText(opts.Umask)
+
+	// user and groups category
+	d.containerUserField.SetText(opts.User)
+	d.containerHostUsersField.SetText(strings.Join(opts.HostUsers, " "))
+	d.containerPasswdEntryField.SetText(opts.PasswdEntry)
+	d.containerGroupEntryField.SetText(opts.GroupEntry)
+
+	// dns settings category
+	d.containerDNSServersField.SetText(strings.Join(opts.DNSServer, " "))
+	d.containerDNSSearchField.SetText(strings.Join(opts.DNSSearchDomain, " "))
+	d.containerDNSOptionsField.SetText(strings.Join(opts.DNSOptions, " "))
+
+	// health options category
+	d.containerHealthCmdField.SetText(opts.HealthCmd)
+	d.containerHealthStartupCmdField.SetText(opts.HealthStartupCmd)
+	utils.SelectDropDownOption(d.containerHealthOnFailureField, opts.HealthOnFailure)
+	d.containerHealthIntervalField.SetText(opts.HealthInterval)
+	d.containerHealthStartupIntervalField.SetText(opts.HealthStartupInterval)
+	d.containerHealthTimeoutField.SetText(opts.HealthTimeout)
+	d.containerHealthStartupTimeoutField.SetText(opts.HealthStartupTimeout)
+	d.containerHealthRetriesField.SetText(opts.HealthRetries)
+	d.containerHealthStartupRetriesField.SetText(opts.HealthStartupRetries)
+	d.containerHealthStartPeriodField.SetText(opts.HealthStartPeroid)
+	d.containerHealthStartupSuccessField.SetText(opts.HealthStartupSuccess)
+	d.containerHealthLogDestField.SetText(opts.HealthLogDestination)
+	d.containerHealthMaxLogCountField.SetText(opts.HealthMaxLogCount)
+	d.containerHealthMaxLogSizeField.SetText(opts.HealthMaxLogSize)
+
+	// network settings category
+	d.containerHostnameField.SetText(opts.Hostname)
+	d.containerIPAddrField.SetText(opts.IPAddress)
+	d.containerMacAddrField.SetText(opts.MacAddress)
+	utils.SelectDropDownOption(d.containerNetworkField, opts.Network)
+
+	// ports settings category
+	d.containerPortPublishField.SetText(strings.Join(opts.Publish, " "))
+	d.ContainerPortPublishAllField.SetChecked(opts.PublishAll)
+	d.containerPortExposeField.SetText(strings.Join(opts.Expose, " "))
+
+	// security options category
+	d.containerSecLabelField.SetText(strings.Join(opts.SelinuxOpts, " "))
+	d.containerSecApparmorField.SetText(opts.ApparmorProfile)
+	d.containerSeccompField.SetText(opts.Seccomp)
+	d.containerSecMaskField.SetText(opts.SecMask)
+	d.containerSecUnmaskField.SetText(opts.SecUnmask)
+	d.containerSecNoNewPrivField.SetChecked(opts.SecNoNewPriv)
+
+	// volumes options category
+	d.mountEntries = nil
+
+	for _, volume := range opts.Volume {
+		mountType := mountTypeVolume
+		if validateVolumeSpec(volume, false) != nil {
+			mountType = mountTypeBind
+		}
+
+		d.mountEntries = append(d.mountEntries, mountEntry{mountType: mountType, spec: volume})
+	}
+
+	for _, mount := range opts.Mount {
+		d.mountEntries = append(d.mountEntries, mountEntry{mountType: mountTypeMount, spec: mount})
+	}
+
+	for _, device := range opts.Device {
+		d.mountEntries = append(d.mountEntries, mountEntry{mountType: mountTypeDevice, spec: device})
+	}
+
+	for _, tmpfs := range opts.Tmpfs {
+		d.mountEntries = append(d.mountEntries, mountEntry{mountType: mountTypeTmpfs, spec: tmpfs})
+	}
+
+	for _, volumesFrom := range opts.VolumesFrom {
+		d.mountEntries = append(d.mountEntries, mountEntry{mountType: mountTypeVolumesFrom, spec: volumesFrom})
+	}
+
+	d.refreshMountEntries()
+	utils.SelectDropDownOption(d.containerImageVolumeField, opts.ImageVolume)
+
+	// resource settings category
+	d.containerMemoryField.SetText(opts.Memory)
+	d.containerMemoryReservationField.SetText(opts.MemoryReservation)
+	d.containerMemorySwapField.SetText(opts.MemorySwap)
+	d.containerMemorySwappinessField.SetText(opts.MemorySwappiness)
+	d.containerCPUsField.SetText(opts.CPUs)
+	d.containerCPUSharesField.SetText(opts.CPUShares)
+	d.containerCPUPeriodField.SetText(opts.CPUPeriod)
+	d.containerCPURtPeriodField.SetText(opts.CPURtPeriod)
+	d.containerCPUQuotaField.SetText(opts.CPUQuota)
+	d.containerCPURtRuntimeField.SetText(opts.CPURtRuntime)
+	d.containerCPUSetCPUsField.SetText(opts.CPUSetCPUs)
+	d.containerCPUSetMemsField.SetText(opts.CPUSetMems)
+	d.containerShmSizeField.SetText(opts.SHMSize)
+	d.containerShmSizeSystemdField.SetText(opts.SHMSizeSystemd)
+
+	// namespace options category
+	d.containerNamespaceCgroupField.SetText(opts.NamespaceCgroup)
+	d.containerNamespaceIpcField.SetText(opts.NamespaceIpc)
+	d.containerNamespacePidField.SetText(opts.NamespacePid)
+	d.containerNamespaceUserField.SetText(opts.NamespaceUser)
+	d.containerNamespaceUtsField.SetText(opts.NamespaceUts)
+	d.containerNamespaceUidmapField.SetText(opts.NamespaceUidmap)
+	d.containerNamespaceSubuidNameField.SetText(opts.NamespaceSubuidName)
+	d.containerNamespaceGidmapField.SetText(opts.NamespaceGidmap)
+	d.containerNamespaceSubgidNameField.SetText(opts.NamespaceSubgidName)
+}
+
+// imageOptionIndex returns image dropdown option index of the image
+// referenced by ID, short ID or name.
+func (d *ContainerCreateDialog) imageOptionIndex(image string) int {
+	if image == "" {
+		return 0
+	}
+
+	for i := range d.imageList {
+		if d.imageList[i].ID == image || strings.HasPrefix(d.imageList[i].ID, image) {
+			return i + 1
+		}
+	}
+
+	names := []string{image}
+	if !strings.Contains(image[strings.LastIndex(image, "/")+1:], ":") {
+		names = append(names, image+":latest")
+	}
+
+	for i := range d.imageList {
+		imgname := d.imageList[i].Repository + ":" + d.imageList[i].Tag
+
+		for _, name := range names {
+			if imgname == name || strings.HasSuffix(imgname, "/"+name) {
+				return i + 1
+			}
+		}
+	}
+
+	return 0
+}
+
+// podOptionIndex returns pod dropdown option index of the pod referenced by ID or name.
+func (d *ContainerCreateDialog) podOptionIndex(pod string) int {
+	if pod == "" {
+		return 0
+	}
+
+	for i := range d.podList {
+		if d.podList[i].Id == pod || d.podList[i].Name == pod {
+			return i + 1
+		}
+	}
+
+	return 0
+}
+
+// UnresolvedOptions returns the image, pod and network references of the given
+// create options which are not available for selection in the dialog.
+func (d *ContainerCreateDialog) UnresolvedOptions(opts containers.CreateOptions) []string {
+	var unresolved []string
+
+	if opts.Image != "" && d.imageOptionIndex(opts.Image) == 0 {
+		unresolved = append(unresolved, fmt.Sprintf("image %q not found", opts.Image))
+	}
+
+	if opts.Pod != "" && d.podOptionIndex(opts.Pod) == 0 {
+		unresolved = append(unresolved, fmt.Sprintf("pod %q not found", opts.Pod))
+	}
+
+	if opts.Network != "" {
+		if _, network := d.containerNetworkField.GetCurrentOption(); network != opts.Network {
+			unresolved = append(unresolved, fmt.Sprintf("network %q not found", opts.Network))
+		}
+	}
+
+	return unresolved
+}
+
 // ContainerCreateOptions returns new network options.
 func (d *ContainerCreateDialog) ContainerCreateOptions() containers.CreateOptions { //nolint:cyclop,gocognit,gocyclo,maintidx,lll
 	var (
@@ -854,6 +1116,11 @@ func (d *ContainerCreateDialog) ContainerCreateOptions() containers.CreateOption
 		publish          []string
 		expose           []string
 		imageVolume      string
+		volumeList       []string
+		mountList        []string
+		deviceList       []string
+		tmpfsList        []string
+		volumesFromList  []string
 		selinuxOpts      []string
 		envVars          []string
 		envFile          []string
@@ -913,6 +1180,21 @@ func (d *ContainerCreateDialog) ContainerCreateOptions() containers.CreateOption
 
 	_, imageVolume = d.containerImageVolumeField.GetCurrentOption()
 
+	for _, entry := range d.mountEntries {
+		switch entry.mountType {
+		case mountTypeVolume, mountTypeBind:
+			volumeList = append(volumeList, entry.spec)
+		case mountTypeMount:
+			mountList = append(mountList, entry.spec)
+		case mountTypeDevice:
+			deviceList = append(deviceList, entry.spec)
+		case mountTypeTmpfs:
+			tmpfsList = append(tmpfsList, entry.spec)
+		case mountTypeVolumesFrom:
+			volumesFromList = append(volumesFromList, entry.spec)
+		}
+	}
+
 	// security options
 	for selinuxLabel := range strings.SplitSeq(d.containerSecLabelField.GetText(), " ") {
 		if selinuxLabel != "" {
@@ -1001,9 +1283,12 @@ func (d *ContainerCreateDialog) ContainerCreateOptions() containers.CreateOption
 		DNSServer:             dnsServers,
 		DNSOptions:            dnsOptions,
 		DNSSearchDomain:       dnsSearchDomains,
-		Volume:                strings.TrimSpace(d.containerVolumeField.GetText()),
+		Volume:                volumeList,
 		ImageVolume:           imageVolume,
-		Mount:                 strings.TrimSpace(d.containerMountField.GetText()),
+		Mount:                 mountList,
+		Device:                deviceList,
+		Tmpfs:                 tmpfsList,
+		VolumesFrom:           volumesFromList,
 		SelinuxOpts:           selinuxOpts,
 		ApparmorProfile:       strings.TrimSpace(d.containerSecApparmorField.GetText()),
 		Seccomp:               strings.TrimSpace(d.containerSeccompField.GetText()),
@@ -1049,9 +1334,46 @@ func (d *ContainerCreateDialog) ContainerCreateOptions() containers.CreateOption
 		NamespaceSubgidName:   strings.TrimSpace(d.containerNamespaceSubgidNameField.GetText()),
 	}
 
+	// invalid command line is split on white spaces
+	if cmdArgs, err := utils.SplitCommandLine(opts.Command); err == nil {
+		opts.CommandArgs = cmdArgs
+	}
+
+	d.setExtraOptions(&opts)
+
 	return opts
 }
 
+// setExtraOptions sets the options which have no dialog fields.
+func (d *ContainerCreateDialog) setExtraOptions(opts *containers.CreateOptions) {
+	extra := d.extraOptions
+
+	opts.Entrypoint = extra.Entrypoint
+	opts.ReadOnly = extra.ReadOnly
+	opts.Init = extra.Init
+	opts.Restart = extra.Restart
+	opts.CapAdd = extra.CapAdd
+	opts.CapDrop = extra.CapDrop
+	opts.GroupAdd = extra.GroupAdd
+
+	// pod containers share the pod network namespace
+	if opts.Pod != "" {
+		return
+	}
+
+	opts.AddHost = extra.AddHost
+
+	// the previous network options are removed once another network is selected
+	for _, network := range extra.Networks {
+		name, _, _ := strings.Cut(network, ":")
+		if name == extra.Network && name != opts.Network {
+			continue
+		}
+
+		opts.Networks = append(opts.Networks, network)
+	}
+}
+
 func (d *ContainerCreateDialog) setupLayout() {
 	bgColor := style.DialogBgColor
 
@@ -1081,12 +1403,17 @@ func (d *ContainerCreateDialog) setupLayout() {
 
 	// form
 	d.form.SetBackgroundColor(bgColor)
+	d.form.AddButton("Presets", nil)
+	d.form.AddButton("Save preset", nil)
 	d.form.AddButton("Cancel", nil)
 
-	if d.mode == ContainerCreateOnlyDialogMode {
+	switch d.mode {
+	case ContainerCreateOnlyDialogMode:
 		d.form.AddButton("Create", nil)
-	} else {
+	case ContainerCreateAndRunDialogMode:
 		d.form.AddButton("Run", nil)
+	case ContainerRecreateDialogMode:
+		d.form.AddButton("Recreate", nil)
 	}
 
 	d.form.SetButtonsAlign(tview.AlignRight)
@@ -1110,10 +1437,13 @@ func (d *ContainerCreateDialog) setupLayout() {
 	d.layout.SetBorder(true)
 	d.layout.SetBorderColor(style.DialogBorderColor)
 
-	if d.mode == ContainerCreateOnlyDialogMode {
+	switch d.mode {
+	case ContainerCreateOnlyDialogMode:
 		d.layout.SetTitle("PODMAN CONTAINER CREATE")
-	} else {
+	case ContainerCreateAndRunDialogMode:
 		d.layout.SetTitle("PODMAN CONTAINER RUN")
+	case ContainerRecreateDialogMode:
+		d.layout.SetTitle("PODMAN CONTAINER RECREATE")
 	}
 
 	_, layoutWidth := utils.AlignStringListWidth(d.categoryLabels)
@@ -1718,11 +2048,48 @@ func (d *ContainerCreateDialog) setupVolumePageUI() {
 	ddselectedStyle := style.DropDownSelected
 	volumePageLabelWidth := 14
 
-	// volume
-	d.containerVolumeField.SetBackgroundColor(style.DialogBgColor)
-	d.containerVolumeField.SetLabel(utils.StringToInputLabel("volume:", volumePageLabelWidth))
-	d.containerVolumeField.SetFieldStyle(style.InputFieldStyle)
-	d.containerVolumeField.SetLabelStyle(style.InputLabelStyle)
+	// mount type
+	d.containerMountTypeField.SetLabel("mount type:")
+	d.containerMountTypeField.SetLabelWidth(volumePageLabelWidth)
+	d.containerMountTypeField.SetBackgroundColor(bgColor)
+	d.containerMountTypeField.SetLabelColor(style.DialogFgColor)
+	d.containerMountTypeField.SetListStyles(ddUnselectedStyle, ddselectedStyle)
+	d.containerMountTypeField.SetFocusedStyle(style.DropDownFocused)
+	d.containerMountTypeField.SetFieldStyle(style.InputFieldStyle)
+	d.containerMountTypeField.SetOptions(mountTypeNames(), d.mountTypeSelected)
+
+	// existing volumes
+	d.containerMountVolumeField.SetLabel(" volume: ")
+	d.containerMountVolumeField.SetBackgroundColor(bgColor)
+	d.containerMountVolumeField.SetLabelColor(style.DialogFgColor)
+	d.containerMountVolumeField.SetListStyles(ddUnselectedStyle, ddselectedStyle)
+	d.containerMountVolumeField.SetFocusedStyle(style.DropDownFocused)
+	d.containerMountVolumeField.SetFieldStyle(style.InputFieldStyle)
+
+	// mount specification
+	d.containerMountSpecField.SetBackgroundColor(bgColor)
+	d.containerMountSpecField.SetLabel(utils.StringToInputLabel("spec:", volumePageLabelWidth))
+	d.containerMountSpecField.SetFieldStyle(style.InputFieldStyle)
+	d.containerMountSpecField.SetLabelStyle(style.InputLabelStyle)
+	d.containerMountSpecField.SetDoneFunc(func(key tcell.Key) {
+		if key == tcell.KeyEnter {
+			d.addMountEntry()
+		}
+	})
+
+	// mount specification hint and validation errors
+	d.containerMountHint.SetBackgroundColor(bgColor)
+	d.containerMountHint.SetTextColor(style.DialogFgColor)
+	d.containerMountHint.SetDynamicColors(true)
+
+	// mounts table
+	d.containerMountsTable.SetBackgroundColor(bgColor)
+	d.containerMountsTable.SetBorder(true)
+	d.containerMountsTable.SetBorderColor(style.DialogSubBoxBorderColor)
+	d.containerMountsTable.SetTitle("mounts (enter: add, delete: remove)")
+	d.containerMountsTable.SetTitleColor(style.DialogFgColor)
+	d.containerMountsTable.SetSelectable(true, false)
+	d.containerMountsTable.SetFixed(1, 0)
 
 	// image volume
 	d.containerImageVolumeField.SetLabel("image volume:")
@@ -1733,22 +2100,96 @@ func (d *ContainerCreateDialog) setupVolumePageUI() {
 	d.containerImageVolumeField.SetFocusedStyle(style.DropDownFocused)
 	d.containerImageVolumeField.SetFieldStyle(style.InputFieldStyle)
 
-	// mounts
-	d.containerMountField.SetBackgroundColor(style.DialogBgColor)
-	d.containerMountField.SetLabel(utils.StringToInputLabel("mount:", volumePageLabelWidth))
-	d.containerMountField.SetFieldStyle(style.InputFieldStyle)
-	d.containerMountField.SetLabelStyle(style.InputLabelStyle)
+	mountTypeRow := tview.NewFlex().SetDirection(tview.FlexColumn)
+	mountTypeRow.AddItem(d.containerMountTypeField, 0, 1, true)
+	mountTypeRow.AddItem(d.containerMountVolumeField, 0, 1, true)
+	mountTypeRow.SetBackgroundColor(bgColor)
+
+	hintRow := tview.NewFlex().SetDirection(tview.FlexColumn)
+	hintRow.AddItem(utils.EmptyBoxSpace(bgColor), volumePageLabelWidth+1, 0, false)
+	hintRow.AddItem(d.containerMountHint, 0, 1, false)
+	hintRow.SetBackgroundColor(bgColor)
 
 	// volume settings page
 	d.volumePage.SetDirection(tview.FlexRow)
-	d.volumePage.AddItem(d.containerVolumeField, 1, 0, true)
+	d.volumePage.AddItem(mountTypeRow, 1, 0, true)
 	d.volumePage.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, true)
+	d.volumePage.AddItem(d.containerMountSpecField, 1, 0, true)
+	d.volumePage.AddItem(hintRow, 1, 0, false)
+	d.volumePage.AddItem(d.containerMountsTable, 0, 1, true)
 	d.volumePage.AddItem(d.containerImageVolumeField, 1, 0, true)
-	d.volumePage.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, true)
-	d.volumePage.AddItem(d.containerMountField, 1, 0, true)
 	d.volumePage.SetBackgroundColor(bgColor)
 }
 
+func (d *ContainerCreateDialog) mountTypeSelected(mountType string, _ int) {
+	d.containerMountHint.SetText(mountTypeFormat(mountType))
+}
+
+func (d *ContainerCreateDialog) mountVolumeSelected(volume string, _ int) {
+	if volume == "" {
+		return
+	}
+
+	d.containerMountTypeField.SetCurrentOption(0)
+	d.containerMountSpecField.SetText(volume + ":")
+}
+
+// addMountEntry validates the mount specification and adds it to the mounts table.
+func (d *ContainerCreateDialog) addMountEntry() {
+	_, mountType := d.containerMountTypeField.GetCurrentOption()
+	spec := strings.TrimSpace(d.containerMountSpecField.GetText())
+
+	if err := validateMountEntry(mountType, spec); err != nil {
+		d.containerMountHint.SetText(fmt.Sprintf("[red::]%s: %s", mountType, err.Error()))
+
+		return
+	}
+
+	d.mountEntries = append(d.mountEntries, mountEntry{mountType: mountType, spec: spec})
+	d.containerMountSpecField.SetText("")
+	d.containerMountVolumeField.SetCurrentOption(0)
+	d.refreshMountEntries()
+	d.containerMountsTable.Select(len(d.mountEntries), 0)
+}
+
+// removeMountEntry removes the mount entry at the given table row.
+func (d *ContainerCreateDialog) removeMountEntry(row int) {
+	index := row - 1
+	if index < 0 || index >= len(d.mountEntries) {
+		return
+	}
+
+	d.mountEntries = append(d.mountEntries[:index], d.mountEntries[index+1:]...)
+	d.refreshMountEntries()
+}
+
+func (d *ContainerCreateDialog) refreshMountEntries() {
+	d.containerMountsTable.Clear()
+
+	for col, header := range []string{"TYPE", "SPECIFICATION"} {
+		d.containerMountsTable.SetCell(0, col,
+			tview.NewTableCell(fmt.Sprintf("[::b]%s", header)).
+				SetTextColor(style.DialogFgColor).
+				SetSelectable(false).
+				SetExpansion(col))
+	}
+
+	for index, entry := range d.mountEntries {
+		d.containerMountsTable.SetCell(index+1, 0,
+			tview.NewTableCell(entry.mountType).SetTextColor(style.DialogFgColor))
+		d.containerMountsTable.SetCell(index+1, 1,
+			tview.NewTableCell(entry.spec).SetTextColor(style.DialogFgColor).SetExpansion(1))
+	}
+
+	if len(d.mountEntries) > 0 {
+		row, _ := d.containerMountsTable.GetSelection()
+		d.containerMountsTable.Select(min(max(row, 1), len(d.mountEntries)), 0)
+	}
+
+	_, mountType := d.containerMountTypeField.GetCurrentOption()
+	d.containerMountHint.SetText(mountTypeFormat(mountType))
+}
+
 func (d *ContainerCreateDialog) setupResourcePageUI() {
 	bgColor := style.DialogBgColor
 	resourcePageLabelWidth := 13
@@ -2030,6 +2471,10 @@ func (d *ContainerCreateDialog) dropdownHasFocus() bool {
 		return true
 	}
 
+	if d.containerMountTypeField.HasFocus() || d.containerMountVolumeField.HasFocus() {
+		return true
+	}
+
 	return d.containerHealthOnFailureField.HasFocus()
 }
 
@@ -2061,6 +2506,20 @@ func (d *ContainerCreateDialog) initCustomInputHanlers() {
 
 		return event
 	})
+
+	// container mount type dropdown
+	d.containerMountTypeField.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		event = utils.ParseKeyEventKey(event)
+
+		return event
+	})
+
+	// container mount volume dropdown
+	d.containerMountVolumeField.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		event = utils.ParseKeyEventKey(event)
+
+		return event
+	})
 }
 
 func (d *ContainerCreateDialog) setActiveCategory(index int) {
@@ -2163,6 +2622,8 @@ func (d *ContainerCreateDialog) initData() {
 	}
 
 	d.setActiveCategory(0)
+	d.extraOptions = containers.CreateOptions{}
+
 	// container category
 	d.containerNameField.SetText("")
 	d.containerCommandField.SetText("")
@@ -2237,8 +2698,12 @@ func (d *ContainerCreateDialog) initData() {
 	d.containerSecNoNewPrivField.SetChecked(false)
 
 	// volumes options category
-	d.containerVolumeField.SetText("")
-	d.containerMountField.SetText("")
+	d.mountEntries = nil
+	d.containerMountTypeField.SetCurrentOption(0)
+	d.containerMountVolumeField.SetOptions(volumeOptions, d.mountVolumeSelected)
+	d.containerMountVolumeField.SetCurrentOption(0)
+	d.containerMountSpecField.SetText("")
+	d.refreshMountEntries()
 	d.containerImageVolumeField.SetOptions(imageVolumeOptions, nil)
 	d.containerImageVolumeField.SetCurrentOption(0)
 
@@ -2382,7 +2847,7 @@ func (d *ContainerCreateDialog) setContainerInfoPageNextFocus() { //nolint:cyclo
 	}
 
 	if d.containerTimeoutField.HasFocus() {
-		if d.mode == ContainerCreateOnlyDialogMode {
+		if d.mode != ContainerCreateAndRunDialogMode {
 			d.focusElement = createContainerSecretFieldFocus
 
 			return
@@ -2719,14 +3184,26 @@ func (d *ContainerCreateDialog) setHealthSettingsPageNextFocus() { //nolint:cycl
 }
 
 func (d *ContainerCreateDialog) setVolumeSettingsPageNextFocus() {
-	if d.containerVolumeField.HasFocus() {
-		d.focusElement = createContainerImageVolumeFieldFocus
+	if d.containerMountTypeField.HasFocus() {
+		d.focusElement = createContainerMountVolumeFieldFocus
+
+		return
+	}
+
+	if d.containerMountVolumeField.HasFocus() {
+		d.focusElement = createContainerMountSpecFieldFocus
 
 		return
 	}
 
-	if d.containerImageVolumeField.HasFocus() {
-		d.focusElement = createContainerMountFieldFocus
+	if d.containerMountSpecField.HasFocus() {
+		d.focusElement = createContainerMountsTableFocus
+
+		return
+	}
+
+	if d.containerMountsTable.HasFocus() {
+		d.focusElement = createContainerImageVolumeFieldFocus
 
 		return
 	}
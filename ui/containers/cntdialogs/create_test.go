@@ -1,6 +1,7 @@
 package cntdialogs
 
 import (
+	"github.com/containers/podman-tui/pdcs/containers"
 	"github.com/gdamore/tcell/v2"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -78,6 +79,11 @@ var _ = Describe("container create", Ordered, func() {
 		createDialog.focusElement = createContainerFormFocus
 		createDialogApp.SetFocus(createDialog)
 		createDialogApp.Draw()
+		// presets and save preset buttons
+		createDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTAB, 0, tcell.ModNone))
+		createDialogApp.Draw()
+		createDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTAB, 0, tcell.ModNone))
+		createDialogApp.Draw()
 		createDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
 		createDialogApp.Draw()
 		Expect(cancelAction).To(Equal(cancelWants))
@@ -95,8 +101,12 @@ var _ = Describe("container create", Ordered, func() {
 		createDialog.focusElement = createContainerFormFocus
 		createDialogApp.SetFocus(createDialog)
 		createDialogApp.Draw()
-		createDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTAB, 0, tcell.ModNone))
-		createDialogApp.Draw()
+
+		for range 3 {
+			createDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTAB, 0, tcell.ModNone))
+			createDialogApp.Draw()
+		}
+
 		createDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
 		createDialogApp.Draw()
 		Expect(createAction).To(Equal(createWants))
@@ -369,6 +379,110 @@ var _ = Describe("container create", Ordered, func() {
 		Expect(createDialog.focusElement).To(Equal(createContainerShmSizeSystemdFieldFocus))
 	})
 
+	It("setVolumeSettingsPageNextFocus", func() {
+		createDialog.focusElement = createContainerMountTypeFieldFocus
+		createDialogApp.SetFocus(createDialog)
+		createDialogApp.Draw()
+		createDialog.setVolumeSettingsPageNextFocus()
+		Expect(createDialog.focusElement).To(Equal(createContainerMountVolumeFieldFocus))
+
+		createDialogApp.SetFocus(createDialog)
+		createDialogApp.Draw()
+		createDialog.setVolumeSettingsPageNextFocus()
+		Expect(createDialog.focusElement).To(Equal(createContainerMountSpecFieldFocus))
+
+		createDialogApp.SetFocus(createDialog)
+		createDialogApp.Draw()
+		createDialog.setVolumeSettingsPageNextFocus()
+		Expect(createDialog.focusElement).To(Equal(createContainerMountsTableFocus))
+
+		createDialogApp.SetFocus(createDialog)
+		createDialogApp.Draw()
+		createDialog.setVolumeSettingsPageNextFocus()
+		Expect(createDialog.focusElement).To(Equal(createContainerImageVolumeFieldFocus))
+
+		createDialogApp.SetFocus(createDialog)
+		createDialogApp.Draw()
+		createDialog.setVolumeSettingsPageNextFocus()
+		Expect(createDialog.focusElement).To(Equal(createContainerFormFocus))
+	})
+
+	It("mount entries", func() {
+		addEntry := func(index int, spec string) {
+			createDialog.containerMountTypeField.SetCurrentOption(index)
+			createDialog.containerMountSpecField.SetText(spec)
+			createDialog.addMountEntry()
+		}
+
+		addEntry(0, "vol01:/data:ro")
+		addEntry(0, "vol01/data")
+		addEntry(1, "/srv:/srv:z")
+		addEntry(1, "srv:/srv")
+		addEntry(2, "type=tmpfs,destination=/cache")
+		addEntry(2, "destination=/cache")
+		addEntry(3, "/dev/fuse:rwm")
+		addEntry(3, "/dev/fuse:xyz")
+		addEntry(4, "/run")
+		addEntry(5, "cnt01:ro")
+		addEntry(5, "cnt01:xx")
+		Expect(createDialog.mountEntries).To(HaveLen(6))
+
+		opts := createDialog.ContainerCreateOptions()
+		Expect(opts.Volume).To(Equal([]string{"vol01:/data:ro", "/srv:/srv:z"}))
+		Expect(opts.Mount).To(Equal([]string{"type=tmpfs,destination=/cache"}))
+		Expect(opts.Device).To(Equal([]string{"/dev/fuse:rwm"}))
+		Expect(opts.Tmpfs).To(Equal([]string{"/run"}))
+		Expect(opts.VolumesFrom).To(Equal([]string{"cnt01:ro"}))
+
+		createDialog.removeMountEntry(1)
+		Expect(createDialog.mountEntries).To(HaveLen(5))
+		Expect(createDialog.ContainerCreateOptions().Volume).To(Equal([]string{"/srv:/srv:z"}))
+	})
+
+	It("set create options", func() {
+		createDialog.SetContainerCreateOptions(containers.CreateOptions{
+			Name:     "cnt01",
+			Labels:   []string{"env=dev", "team=qa"},
+			Volume:   []string{"vol01:/data", "/srv:/srv"},
+			Tmpfs:    []string{"/run"},
+			Memory:   "512m",
+			Hostname: "dev01",
+		})
+
+		opts := createDialog.ContainerPresetOptions()
+		Expect(opts.Name).To(Equal("cnt01"))
+		Expect(opts.Labels).To(Equal([]string{"env=dev", "team=qa"}))
+		Expect(opts.Volume).To(Equal([]string{"vol01:/data", "/srv:/srv"}))
+		Expect(opts.Tmpfs).To(Equal([]string{"/run"}))
+		Expect(opts.Memory).To(Equal("512m"))
+		Expect(opts.Hostname).To(Equal("dev01"))
+		Expect(createDialog.mountEntries[1].mountType).To(Equal(mountTypeBind))
+	})
+
+	It("keep command arguments and extra options", func() {
+		createDialog.SetContainerCreateOptions(containers.CreateOptions{
+			Name:     "cnt01",
+			Command:  `sh -c 'echo "hello world"'`,
+			Restart:  "always",
+			CapAdd:   []string{"NET_ADMIN"},
+			Networks: []string{"net02:alias=db"},
+		})
+
+		opts := createDialog.ContainerCreateOptions()
+		Expect(opts.CommandArgs).To(Equal([]string{"sh", "-c", `echo "hello world"`}))
+		Expect(opts.Restart).To(Equal("always"))
+		Expect(opts.CapAdd).To(Equal([]string{"NET_ADMIN"}))
+		Expect(opts.Networks).To(Equal([]string{"net02:alias=db"}))
+	})
+
+	It("recreate mode", func() {
+		recreateDialog := NewContainerCreateDialog(ContainerRecreateDialogMode)
+		actionButton := recreateDialog.form.GetButton(recreateDialog.form.GetButtonCount() - 1)
+
+		Expect(actionButton.GetLabel()).To(Equal("Recreate"))
+		Expect(recreateDialog.layout.GetTitle()).To(Equal("PODMAN CONTAINER RECREATE"))
+	})
+
 	It("hide", func() {
 		createDialog.Hide()
 		Expect(createDialog.IsDisplay()).To(Equal(false))
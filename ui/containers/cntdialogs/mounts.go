@@ -0,0 +1,201 @@
+package cntdialogs
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	mountTypeVolume      = "volume"
+	mountTypeBind        = "bind"
+	mountTypeMount       = "mount"
+	mountTypeDevice      = "device"
+	mountTypeTmpfs       = "tmpfs"
+	mountTypeVolumesFrom = "volumes-from"
+)
+
+var (
+	errEmptyMountSpec         = errors.New("empty specification")
+	errInvalidMountSpec       = errors.New("invalid specification")
+	errMountPathNotAbsolute   = errors.New("path must be absolute")
+	errInvalidVolumeName      = errors.New("invalid volume name")
+	errInvalidMountOption     = errors.New("invalid mount option")
+	errMissingMountType       = errors.New("missing mount type")
+	errMissingMountTarget     = errors.New("missing mount destination")
+	errInvalidDevicePerm      = errors.New("invalid device permissions")
+	errInvalidVolumesFromOpts = errors.New("invalid volumes-from option")
+)
+
+// mountTypes holds the supported mount entry types and their specification format.
+var mountTypes = []struct {
+	name   string
+	format string
+}{
+	{name: mountTypeVolume, format: "name:/container/path[:options]"},
+	{name: mountTypeBind, format: "/host/path:/container/path[:options]"},
+	{name: mountTypeMount, format: "type=bind,source=/host/path,destination=/container/path"},
+	{name: mountTypeDevice, format: "/dev/host[:/dev/container][:rwm]"},
+	{name: mountTypeTmpfs, format: "/container/path[:options]"},
+	{name: mountTypeVolumesFrom, format: "container[:ro|rw|z]"},
+}
+
+// mountEntry represents a single volume, mount, device, tmpfs or volumes-from item.
+type mountEntry struct {
+	mountType string
+	spec      string
+}
+
+func mountTypeNames() []string {
+	names := make([]string, 0, len(mountTypes))
+
+	for _, mtype := range mountTypes {
+		names = append(names, mtype.name)
+	}
+
+	return names
+}
+
+func mountTypeFormat(mountType string) string {
+	for _, mtype := range mountTypes {
+		if mtype.name == mountType {
+			return mtype.format
+		}
+	}
+
+	return ""
+}
+
+// validateMountEntry validates the specification of the given mount type.
+func validateMountEntry(mountType string, spec string) error {
+	if spec == "" {
+		return errEmptyMountSpec
+	}
+
+	if strings.ContainsAny(spec, " \t") {
+		return fmt.Errorf("%w: %q contains white space", errInvalidMountSpec, spec)
+	}
+
+	switch mountType {
+	case mountTypeVolume:
+		return validateVolumeSpec(spec, false)
+	case mountTypeBind:
+		return validateVolumeSpec(spec, true)
+	case mountTypeMount:
+		return validateMountSpec(spec)
+	case mountTypeDevice:
+		return validateDeviceSpec(spec)
+	case mountTypeTmpfs:
+		return validateAbsPath(strings.SplitN(spec, ":", 2)[0]) //nolint:mnd
+	case mountTypeVolumesFrom:
+		return validateVolumesFromSpec(spec)
+	}
+
+	return fmt.Errorf("%w: unknown type %q", errInvalidMountSpec, mountType)
+}
+
+func validateAbsPath(path string) error {
+	if !filepath.IsAbs(path) {
+		return fmt.Errorf("%w: %q", errMountPathNotAbsolute, path)
+	}
+
+	return nil
+}
+
+func validateVolumeSpec(spec string, bind bool) error {
+	parts := strings.Split(spec, ":")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("%w: %q", errInvalidMountSpec, spec)
+	}
+
+	if bind {
+		if !filepath.IsAbs(parts[0]) && !strings.HasPrefix(parts[0], ".") && !strings.HasPrefix(parts[0], "~") {
+			return fmt.Errorf("%w: %q", errMountPathNotAbsolute, parts[0])
+		}
+	} else if strings.Contains(parts[0], "/") {
+		return fmt.Errorf("%w: %q", errInvalidVolumeName, parts[0])
+	}
+
+	return validateAbsPath(parts[1])
+}
+
+func validateMountSpec(spec string) error {
+	var hasType, hasTarget bool
+
+	for option := range strings.SplitSeq(spec, ",") {
+		key, _, _ := strings.Cut(option, "=")
+
+		switch key {
+		case "type":
+			hasType = true
+		case "target", "destination", "dst":
+			hasTarget = true
+		case "":
+			return fmt.Errorf("%w: %q", errInvalidMountOption, option)
+		}
+	}
+
+	if !hasType {
+		return errMissingMountType
+	}
+
+	if !hasTarget {
+		return errMissingMountTarget
+	}
+
+	return nil
+}
+
+func validateDeviceSpec(spec string) error {
+	parts := strings.Split(spec, ":")
+	if len(parts) > 3 { //nolint:mnd
+		return fmt.Errorf("%w: %q", errInvalidMountSpec, spec)
+	}
+
+	if err := validateAbsPath(parts[0]); err != nil {
+		return err
+	}
+
+	isPerm := func(perm string) bool {
+		return perm != "" && strings.Trim(perm, "rwm") == ""
+	}
+
+	switch len(parts) {
+	case 2: //nolint:mnd
+		if !isPerm(parts[1]) {
+			return validateAbsPath(parts[1])
+		}
+	case 3: //nolint:mnd
+		if err := validateAbsPath(parts[1]); err != nil {
+			return err
+		}
+
+		if !isPerm(parts[2]) {
+			return fmt.Errorf("%w: %q", errInvalidDevicePerm, parts[2])
+		}
+	}
+
+	return nil
+}
+
+func validateVolumesFromSpec(spec string) error {
+	container, options, found := strings.Cut(spec, ":")
+	if container == "" {
+		return fmt.Errorf("%w: %q", errInvalidMountSpec, spec)
+	}
+
+	if !found {
+		return nil
+	}
+
+	for option := range strings.SplitSeq(options, ",") {
+		switch option {
+		case "ro", "rw", "z":
+		default:
+			return fmt.Errorf("%w: %q", errInvalidVolumesFromOpts, option)
+		}
+	}
+
+	return nil
+}
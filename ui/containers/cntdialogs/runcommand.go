@@ -0,0 +1,345 @@
+package cntdialogs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	cntRunCommandDialogMaxWidth     = 120
+	cntRunCommandDialogLabelPadding = 1
+	cntRunCommandFilePerm           = 0o600
+	cntRunCommandSaveLabel          = "save to:"
+)
+
+const (
+	cntRunCommandTextFocus = 0 + iota
+	cntRunCommandPathFocus
+	cntRunCommandFormFocus
+)
+
+var errEmptySavePath = errors.New("empty save path")
+
+// ContainerRunCommandDialog implements the container run command dialog primitive.
+// It displays the podman run command which recreates a container and allows
+// to copy it to the clipboard or save it to a file.
+type ContainerRunCommandDialog struct {
+	*tview.Box
+
+	layout        *tview.Flex
+	cntInfo       *tview.InputField
+	command       *tview.TextView
+	savePath      *tview.InputField
+	status        *tview.TextView
+	form          *tview.Form
+	display       bool
+	focusElement  int
+	runCommand    string
+	clipboard     []byte
+	cancelHandler func()
+}
+
+// NewContainerRunCommandDialog returns new container run command dialog primitive.
+func NewContainerRunCommandDialog() *ContainerRunCommandDialog {
+	dialog := &ContainerRunCommandDialog{
+		Box:      tview.NewBox(),
+		layout:   tview.NewFlex().SetDirection(tview.FlexRow),
+		cntInfo:  tview.NewInputField(),
+		command:  tview.NewTextView(),
+		savePath: tview.NewInputField(),
+		status:   tview.NewTextView(),
+		form:     tview.NewForm(),
+	}
+
+	bgColor := style.DialogBgColor
+
+	// container info input field
+	dialog.cntInfo.SetBackgroundColor(bgColor)
+	dialog.cntInfo.SetLabel("[::b]" + utils.ContainerIDLabel)
+	dialog.cntInfo.SetFieldBackgroundColor(bgColor)
+	dialog.cntInfo.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(style.DialogFgColor))
+
+	// command text view
+	dialog.command.SetBackgroundColor(style.BgColor)
+	dialog.command.SetTextColor(style.FgColor)
+	dialog.command.SetBorder(true)
+	dialog.command.SetBorderColor(style.DialogSubBoxBorderColor)
+	dialog.command.SetWrap(true)
+	dialog.command.SetDynamicColors(false)
+
+	// save path field
+	dialog.savePath.SetBackgroundColor(bgColor)
+	dialog.savePath.SetLabel(cntRunCommandSaveLabel + " ")
+	dialog.savePath.SetFieldStyle(style.InputFieldStyle)
+	dialog.savePath.SetLabelStyle(style.InputLabelStyle)
+
+	// status text view
+	dialog.status.SetBackgroundColor(bgColor)
+	dialog.status.SetTextColor(style.DialogFgColor)
+	dialog.status.SetDynamicColors(true)
+
+	// form
+	dialog.form.AddButton("Copy", dialog.copyCommand)
+	dialog.form.AddButton("Save", dialog.saveCommand)
+	dialog.form.AddButton("Close", nil)
+	dialog.form.SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	inputLayout := tview.NewFlex().SetDirection(tview.FlexRow)
+	inputLayout.SetBackgroundColor(bgColor)
+	inputLayout.AddItem(dialog.cntInfo, 1, 0, false)
+	inputLayout.AddItem(dialog.command, 0, 1, true)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.savePath, 1, 0, true)
+	inputLayout.AddItem(dialog.status, 1, 0, false)
+
+	mainLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	mainLayout.SetBackgroundColor(bgColor)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainLayout.AddItem(inputLayout, 0, 1, true)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetTitle("PODMAN CONTAINER RUN COMMAND")
+	dialog.layout.AddItem(mainLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *ContainerRunCommandDialog) Display() {
+	d.display = true
+	d.focusElement = cntRunCommandTextFocus
+	d.form.SetFocus(0)
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *ContainerRunCommandDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *ContainerRunCommandDialog) Hide() {
+	d.display = false
+	d.focusElement = cntRunCommandTextFocus
+	d.runCommand = ""
+	d.command.SetText("")
+	d.savePath.SetText("")
+	d.status.SetText("")
+}
+
+// SetContainerInfo sets selected container ID and name.
+// The default save path is set from the container name.
+func (d *ContainerRunCommandDialog) SetContainerInfo(id string, name string) {
+	containerInfo := fmt.Sprintf("%s (%s)", id, name)
+	containerInfo = utils.LabelWidthLeftPadding(containerInfo, cntRunCommandDialogLabelPadding)
+
+	d.cntInfo.SetText(containerInfo)
+	d.savePath.SetText(filepath.Join("~", name+"-run.sh"))
+}
+
+// SetRunCommand sets the run command to display.
+func (d *ContainerRunCommandDialog) SetRunCommand(cmd string) {
+	d.runCommand = cmd
+
+	d.command.SetText(cmd)
+	d.command.ScrollToBeginning()
+	d.status.SetText("")
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *ContainerRunCommandDialog) HasFocus() bool {
+	if d.command.HasFocus() || d.savePath.HasFocus() || d.form.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus() || d.layout.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *ContainerRunCommandDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case cntRunCommandTextFocus:
+		delegate(d.command)
+	case cntRunCommandPathFocus:
+		delegate(d.savePath)
+	case cntRunCommandFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = cntRunCommandTextFocus
+
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *ContainerRunCommandDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("container run command dialog: event %v received", event)
+
+		if event.Key() == utils.CloseDialogKey.Key {
+			d.cancelHandler()
+
+			return
+		}
+
+		if event.Key() == utils.SwitchFocusKey.Key && !d.form.HasFocus() {
+			d.focusElement++
+			d.Focus(setFocus)
+
+			return
+		}
+
+		if d.command.HasFocus() {
+			if commandHandler := d.command.InputHandler(); commandHandler != nil {
+				commandHandler(utils.ParseKeyEventKey(event), setFocus)
+
+				return
+			}
+		}
+
+		if d.savePath.HasFocus() {
+			if event.Key() == tcell.KeyEnter {
+				d.saveCommand()
+
+				return
+			}
+
+			if savePathHandler := d.savePath.InputHandler(); savePathHandler != nil {
+				savePathHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+// SetRect set rects for this primitive.
+func (d *ContainerRunCommandDialog) SetRect(x, y, width, height int) {
+	if width > cntRunCommandDialogMaxWidth {
+		emptySpace := (width - cntRunCommandDialogMaxWidth) / 2 //nolint:mnd
+		x += emptySpace
+		width = cntRunCommandDialogMaxWidth
+	}
+
+	// the dialog fits the command lines
+	dHeight := len(strings.Split(d.runCommand, "\n")) + 7 + dialogs.DialogFormHeight //nolint:mnd
+	if height > dHeight {
+		emptySpace := (height - dHeight) / 2 //nolint:mnd
+		y += emptySpace
+		height = dHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *ContainerRunCommandDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.DrawForSubclass(screen, d)
+
+	x, y, width, height := d.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+
+	if len(d.clipboard) > 0 {
+		screen.SetClipboard(d.clipboard)
+
+		d.clipboard = nil
+	}
+}
+
+// SetCancelFunc sets form close button selected function.
+func (d *ContainerRunCommandDialog) SetCancelFunc(handler func()) *ContainerRunCommandDialog {
+	d.cancelHandler = handler
+	closeButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+	closeButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// copyCommand copies the command to the terminal clipboard (OSC 52) on next draw.
+func (d *ContainerRunCommandDialog) copyCommand() {
+	if d.runCommand == "" {
+		return
+	}
+
+	d.clipboard = []byte(d.runCommand)
+
+	d.status.SetText("copied to clipboard")
+}
+
+func (d *ContainerRunCommandDialog) saveCommand() {
+	path, err := d.saveCommandPath()
+	if err == nil {
+		err = os.WriteFile(path, []byte(d.runCommand+"\n"), cntRunCommandFilePerm)
+	}
+
+	if err != nil {
+		log.Error().Msgf("container run command dialog: failed to save command: %v", err)
+		d.status.SetText(fmt.Sprintf("[red::]%s", tview.Escape(err.Error())))
+
+		return
+	}
+
+	d.status.SetText("saved to " + tview.Escape(path))
+}
+
+// saveCommandPath returns the save path with home directory (~) expanded.
+func (d *ContainerRunCommandDialog) saveCommandPath() (string, error) {
+	path := strings.TrimSpace(d.savePath.GetText())
+	if path == "" {
+		return "", errEmptySavePath
+	}
+
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		home, err := utils.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+
+		path = filepath.Join(home, strings.TrimPrefix(path, "~"))
+	}
+
+	return path, nil
+}
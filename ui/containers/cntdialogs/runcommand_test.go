@@ -0,0 +1,106 @@
+package cntdialogs
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("container run command", Ordered, func() {
+	var runCommandDialogApp *tview.Application
+	var runCommandDialogScreen tcell.SimulationScreen
+	var runCommandDialog *ContainerRunCommandDialog
+	var runApp func()
+
+	runCmd := "podman run --detach \\\n  --name web \\\n  nginx"
+
+	BeforeAll(func() {
+		runCommandDialogApp = tview.NewApplication()
+		runCommandDialog = NewContainerRunCommandDialog()
+		runCommandDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := runCommandDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := runCommandDialogApp.SetScreen(runCommandDialogScreen).SetRoot(runCommandDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		runCommandDialog.Display()
+		Expect(runCommandDialog.IsDisplay()).To(Equal(true))
+	})
+
+	It("set focus", func() {
+		runCommandDialogApp.SetFocus(runCommandDialog)
+		Expect(runCommandDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("set container info", func() {
+		runCommandDialog.SetContainerInfo("cntID", "web")
+		Expect(runCommandDialog.cntInfo.GetText()).To(Equal(" cntID (web)"))
+		Expect(runCommandDialog.savePath.GetText()).To(Equal("~/web-run.sh"))
+	})
+
+	It("set run command", func() {
+		runCommandDialog.SetRunCommand(runCmd)
+		Expect(runCommandDialog.command.GetText(false)).To(Equal(runCmd))
+	})
+
+	It("copy command", func() {
+		runCommandDialogApp.SetFocus(runCommandDialog)
+		runCommandDialogApp.Draw()
+		runCommandDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		runCommandDialogApp.Draw()
+		runCommandDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		runCommandDialogApp.Draw()
+		runCommandDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		runCommandDialogApp.Draw()
+		Expect(runCommandDialog.status.GetText(true)).To(Equal("copied to clipboard"))
+	})
+
+	It("save command", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "web-run.sh")
+		runCommandDialog.savePath.SetText(path)
+		runCommandDialog.saveCommand()
+		data, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(Equal(runCmd + "\n"))
+		Expect(runCommandDialog.status.GetText(true)).To(Equal("saved to " + path))
+
+		runCommandDialog.savePath.SetText("")
+		runCommandDialog.saveCommand()
+		Expect(runCommandDialog.status.GetText(true)).To(Equal(errEmptySavePath.Error()))
+	})
+
+	It("close button selected", func() {
+		closeAction := "initial"
+		closeWants := "close selected"
+		runCommandDialog.SetCancelFunc(func() {
+			closeAction = closeWants
+		})
+		runCommandDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
+		runCommandDialogApp.Draw()
+		Expect(closeAction).To(Equal(closeWants))
+	})
+
+	It("hide", func() {
+		runCommandDialog.Hide()
+		Expect(runCommandDialog.IsDisplay()).To(Equal(false))
+		Expect(runCommandDialog.command.GetText(false)).To(Equal(""))
+	})
+
+	AfterAll(func() {
+		runCommandDialogApp.Stop()
+	})
+})
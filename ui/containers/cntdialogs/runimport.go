@@ -0,0 +1,236 @@
+package cntdialogs
+
+import (
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	cntRunImportDialogMaxWidth  = 100
+	cntRunImportDialogMaxHeight = 18
+)
+
+const (
+	cntRunImportCommandFocus = 0 + iota
+	cntRunImportFormFocus
+)
+
+// ContainerRunImportDialog implements container run command import dialog primitive.
+// It accepts a pasted podman or docker run command line.
+type ContainerRunImportDialog struct {
+	*tview.Box
+
+	layout        *tview.Flex
+	command       *tview.TextArea
+	form          *tview.Form
+	display       bool
+	focusElement  int
+	importHandler func()
+	cancelHandler func()
+}
+
+// NewContainerRunImportDialog returns new container run command import dialog primitive.
+func NewContainerRunImportDialog() *ContainerRunImportDialog {
+	dialog := &ContainerRunImportDialog{
+		Box:     tview.NewBox(),
+		layout:  tview.NewFlex().SetDirection(tview.FlexRow),
+		command: tview.NewTextArea(),
+		form:    tview.NewForm(),
+	}
+
+	bgColor := style.DialogBgColor
+
+	// description
+	description := tview.NewTextView()
+	description.SetBackgroundColor(bgColor)
+	description.SetTextColor(style.DialogFgColor)
+	description.SetText("paste a podman or docker run (or create) command line, " +
+		"multi-line commands with backslash continuations are supported.")
+	description.SetWrap(true)
+
+	// command text area
+	dialog.command.SetBackgroundColor(style.FieldBackgroundColor)
+	dialog.command.SetTextStyle(style.InputFieldStyle)
+	dialog.command.SetPlaceholder("podman run -d --name web -p 8080:80 nginx")
+	dialog.command.SetPlaceholderStyle(style.InputFieldStyle.Foreground(style.DialogSubBoxBorderColor))
+	dialog.command.SetWrap(true)
+
+	// form
+	dialog.form.AddButton("Cancel", nil)
+	dialog.form.AddButton("Import", nil)
+	dialog.form.SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	inputLayout := tview.NewFlex().SetDirection(tview.FlexRow)
+	inputLayout.SetBackgroundColor(bgColor)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(description, 2, 0, false) //nolint:mnd
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.command, 0, 1, true)
+
+	mainLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	mainLayout.SetBackgroundColor(bgColor)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainLayout.AddItem(inputLayout, 0, 1, true)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetTitle("PODMAN CONTAINER RUN COMMAND IMPORT")
+	dialog.layout.AddItem(mainLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *ContainerRunImportDialog) Display() {
+	d.display = true
+	d.focusElement = cntRunImportCommandFocus
+	d.form.SetFocus(0)
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *ContainerRunImportDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *ContainerRunImportDialog) Hide() {
+	d.display = false
+	d.focusElement = cntRunImportCommandFocus
+	d.command.SetText("", false)
+}
+
+// GetCommand returns the pasted command line.
+func (d *ContainerRunImportDialog) GetCommand() string {
+	return d.command.GetText()
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *ContainerRunImportDialog) HasFocus() bool {
+	if d.command.HasFocus() || d.form.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus() || d.layout.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *ContainerRunImportDialog) Focus(delegate func(p tview.Primitive)) {
+	if d.focusElement == cntRunImportCommandFocus {
+		delegate(d.command)
+
+		return
+	}
+
+	button := d.form.GetButton(d.form.GetButtonCount() - 1)
+	button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == utils.SwitchFocusKey.Key {
+			d.focusElement = cntRunImportCommandFocus
+
+			d.Focus(delegate)
+			d.form.SetFocus(0)
+
+			return nil
+		}
+
+		return event
+	})
+
+	delegate(d.form)
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *ContainerRunImportDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("container run import dialog: event %v received", event)
+
+		if event.Key() == utils.CloseDialogKey.Key {
+			d.cancelHandler()
+
+			return
+		}
+
+		if d.command.HasFocus() {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = cntRunImportFormFocus
+				d.Focus(setFocus)
+
+				return
+			}
+
+			if commandHandler := d.command.InputHandler(); commandHandler != nil {
+				commandHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+// SetRect set rects for this primitive.
+func (d *ContainerRunImportDialog) SetRect(x, y, width, height int) {
+	if width > cntRunImportDialogMaxWidth {
+		emptySpace := (width - cntRunImportDialogMaxWidth) / 2 //nolint:mnd
+		x += emptySpace
+		width = cntRunImportDialogMaxWidth
+	}
+
+	if height > cntRunImportDialogMaxHeight {
+		emptySpace := (height - cntRunImportDialogMaxHeight) / 2 //nolint:mnd
+		y += emptySpace
+		height = cntRunImportDialogMaxHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *ContainerRunImportDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.DrawForSubclass(screen, d)
+
+	x, y, width, height := d.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetImportFunc sets form import button selected function.
+func (d *ContainerRunImportDialog) SetImportFunc(handler func()) *ContainerRunImportDialog {
+	d.importHandler = handler
+	importButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+	importButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *ContainerRunImportDialog) SetCancelFunc(handler func()) *ContainerRunImportDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 2) //nolint:mnd
+
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
@@ -0,0 +1,95 @@
+package cntdialogs
+
+import (
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("container run import", Ordered, func() {
+	var runImportDialogApp *tview.Application
+	var runImportDialogScreen tcell.SimulationScreen
+	var runImportDialog *ContainerRunImportDialog
+	var runApp func()
+
+	BeforeAll(func() {
+		runImportDialogApp = tview.NewApplication()
+		runImportDialog = NewContainerRunImportDialog()
+		runImportDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := runImportDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := runImportDialogApp.SetScreen(runImportDialogScreen).SetRoot(runImportDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		runImportDialog.Display()
+		Expect(runImportDialog.IsDisplay()).To(Equal(true))
+	})
+
+	It("set focus", func() {
+		runImportDialogApp.SetFocus(runImportDialog)
+		Expect(runImportDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("get command", func() {
+		runImportDialogApp.SetFocus(runImportDialog)
+		runImportDialogApp.Draw()
+		for _, r := range "podman run" {
+			runImportDialogApp.QueueEvent(tcell.NewEventKey(256, r, tcell.ModNone))
+			runImportDialogApp.Draw()
+		}
+		runImportDialogApp.QueueEvent(tcell.NewEventKey(256, '\\', tcell.ModNone))
+		runImportDialogApp.Draw()
+		runImportDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		runImportDialogApp.Draw()
+		runImportDialogApp.QueueEvent(tcell.NewEventKey(256, 'x', tcell.ModNone))
+		runImportDialogApp.Draw()
+		Expect(runImportDialog.GetCommand()).To(Equal("podman run\\\nx"))
+	})
+
+	It("import button selected", func() {
+		importAction := "initial"
+		importWants := "import selected"
+		runImportDialog.SetImportFunc(func() {
+			importAction = importWants
+		})
+		runImportDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		runImportDialogApp.Draw()
+		runImportDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		runImportDialogApp.Draw()
+		runImportDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		runImportDialogApp.Draw()
+		Expect(importAction).To(Equal(importWants))
+	})
+
+	It("cancel button selected", func() {
+		cancelAction := "initial"
+		cancelWants := "cancel selected"
+		runImportDialog.SetCancelFunc(func() {
+			cancelAction = cancelWants
+		})
+		runImportDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
+		runImportDialogApp.Draw()
+		Expect(cancelAction).To(Equal(cancelWants))
+	})
+
+	It("hide", func() {
+		runImportDialog.Hide()
+		Expect(runImportDialog.IsDisplay()).To(Equal(false))
+		Expect(runImportDialog.GetCommand()).To(Equal(""))
+	})
+
+	AfterAll(func() {
+		runImportDialogApp.Stop()
+	})
+})
@@ -0,0 +1,561 @@
+package cntdialogs
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	putils "github.com/containers/podman-tui/pdcs/utils"
+	"github.com/containers/podman-tui/ui/utils"
+)
+
+var (
+	errEmptyRunCommand        = errors.New("empty command line")
+	errNotRunCommand          = errors.New("not a podman or docker run/create command")
+	errMissingRunImage        = errors.New("missing container image")
+	errMissingFlagValue       = errors.New("missing flag value")
+	errValueWhiteSpace        = errors.New("value contains white space")
+	errMultipleValues         = errors.New("only a single value is supported")
+	errUnsupportedSecurityOpt = errors.New("unsupported security option")
+)
+
+// runCommandFlag is a podman run flag which can be mapped to create options.
+type runCommandFlag struct {
+	names   []string
+	boolean bool
+	apply   func(opts *containers.CreateOptions, value string) error
+}
+
+// RunCommand holds the create options parsed from a podman or docker run command line.
+type RunCommand struct {
+	// Options are the create options mapped from the command line flags.
+	Options containers.CreateOptions
+	// Run is true for a run command and false for a create command.
+	Run bool
+	// Unmapped holds the flags (and arguments) which could not be mapped to create options.
+	Unmapped []string
+}
+
+// unmapped boolean flags, used to not take the next argument as their value.
+var runCommandBoolFlags = []string{
+	"--no-hosts", "--oom-kill-disable", "--read-only-tmpfs",
+	"--replace", "--rootfs", "--sig-proxy", "--http-proxy", "--disable-content-trust",
+	"--quiet", "-q", "--help",
+}
+
+// runCommandFlags returns the flags which can be mapped to create options.
+func runCommandFlags() []runCommandFlag { //nolint:funlen,maintidx
+	return []runCommandFlag{
+		// basic information
+		{names: []string{"--name"}, apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.Name })},
+		{names: []string{"--label", "-l"}, apply: addListValue(func(o *containers.CreateOptions) *[]string { return &o.Labels })},
+		{names: []string{"--rm"}, boolean: true, apply: setBoolValue(func(o *containers.CreateOptions) *bool { return &o.Remove })},
+		{
+			names: []string{"--privileged"}, boolean: true,
+			apply: setBoolValue(func(o *containers.CreateOptions) *bool { return &o.Privileged }),
+		},
+		{names: []string{"--timeout"}, apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.Timeout })},
+		{
+			names: []string{"--interactive", "-i"}, boolean: true,
+			apply: setBoolValue(func(o *containers.CreateOptions) *bool { return &o.Interactive }),
+		},
+		{names: []string{"--tty", "-t"}, boolean: true, apply: setBoolValue(func(o *containers.CreateOptions) *bool { return &o.TTY })},
+		{
+			names: []string{"--detach", "-d"}, boolean: true,
+			apply: setBoolValue(func(o *containers.CreateOptions) *bool { return &o.Detach }),
+		},
+		{names: []string{"--secret"}, apply: addListValue(func(o *containers.CreateOptions) *[]string { return &o.Secret })},
+		{names: []string{"--pod"}, apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.Pod })},
+		{
+			names: []string{"--entrypoint"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.Entrypoint }),
+		},
+		{names: []string{"--init"}, boolean: true, apply: setBoolValue(func(o *containers.CreateOptions) *bool { return &o.Init })},
+		{
+			names: []string{"--read-only"}, boolean: true,
+			apply: setBoolValue(func(o *containers.CreateOptions) *bool { return &o.ReadOnly }),
+		},
+		{names: []string{"--restart"}, apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.Restart })},
+
+		// environment
+		{names: []string{"--workdir", "-w"}, apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.WorkDir })},
+		{names: []string{"--env", "-e"}, apply: addListValue(func(o *containers.CreateOptions) *[]string { return &o.EnvVars })},
+		{names: []string{"--env-file"}, apply: addListValue(func(o *containers.CreateOptions) *[]string { return &o.EnvFile })},
+		{names: []string{"--env-merge"}, apply: addListValue(func(o *containers.CreateOptions) *[]string { return &o.EnvMerge })},
+		{names: []string{"--unsetenv"}, apply: addListValue(func(o *containers.CreateOptions) *[]string { return &o.UnsetEnv })},
+		{
+			names: []string{"--env-host"}, boolean: true,
+			apply: setBoolValue(func(o *containers.CreateOptions) *bool { return &o.EnvHost }),
+		},
+		{
+			names: []string{"--unsetenv-all"}, boolean: true,
+			apply: setBoolValue(func(o *containers.CreateOptions) *bool { return &o.UnsetEnvAll }),
+		},
+		{names: []string{"--umask"}, apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.Umask })},
+
+		// user and groups
+		{names: []string{"--user", "-u"}, apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.User })},
+		{names: []string{"--hostuser"}, apply: addListValue(func(o *containers.CreateOptions) *[]string { return &o.HostUsers })},
+		{
+			names: []string{"--group-entry"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.GroupEntry }),
+		},
+		{
+			names: []string{"--passwd-entry"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.PasswdEntry }),
+		},
+		{names: []string{"--group-add"}, apply: addListValue(func(o *containers.CreateOptions) *[]string { return &o.GroupAdd })},
+
+		// network and ports
+		{names: []string{"--hostname", "-h"}, apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.Hostname })},
+		{names: []string{"--ip"}, apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.IPAddress })},
+		{names: []string{"--network", "--net"}, apply: setNetworkValue},
+		{
+			names: []string{"--mac-address"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.MacAddress }),
+		},
+		{names: []string{"--publish", "-p"}, apply: addListValue(func(o *containers.CreateOptions) *[]string { return &o.Publish })},
+		{names: []string{"--expose"}, apply: addListValue(func(o *containers.CreateOptions) *[]string { return &o.Expose })},
+		{
+			names: []string{"--publish-all", "-P"}, boolean: true,
+			apply: setBoolValue(func(o *containers.CreateOptions) *bool { return &o.PublishAll }),
+		},
+		{names: []string{"--dns"}, apply: addListValue(func(o *containers.CreateOptions) *[]string { return &o.DNSServer })},
+		{
+			names: []string{"--dns-option", "--dns-opt"},
+			apply: addListValue(func(o *containers.CreateOptions) *[]string { return &o.DNSOptions }),
+		},
+		{
+			names: []string{"--dns-search"},
+			apply: addListValue(func(o *containers.CreateOptions) *[]string { return &o.DNSSearchDomain }),
+		},
+		{names: []string{"--add-host"}, apply: addListValue(func(o *containers.CreateOptions) *[]string { return &o.AddHost })},
+
+		// volumes and mounts
+		{names: []string{"--volume", "-v"}, apply: addListValue(func(o *containers.CreateOptions) *[]string { return &o.Volume })},
+		{names: []string{"--mount"}, apply: addListValue(func(o *containers.CreateOptions) *[]string { return &o.Mount })},
+		{names: []string{"--device"}, apply: addListValue(func(o *containers.CreateOptions) *[]string { return &o.Device })},
+		{names: []string{"--tmpfs"}, apply: addListValue(func(o *containers.CreateOptions) *[]string { return &o.Tmpfs })},
+		{
+			names: []string{"--volumes-from"},
+			apply: addListValue(func(o *containers.CreateOptions) *[]string { return &o.VolumesFrom }),
+		},
+		{
+			names: []string{"--image-volume"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.ImageVolume }),
+		},
+
+		// security options
+		{names: []string{"--security-opt"}, apply: setSecurityOptValue},
+		{names: []string{"--cap-add"}, apply: addListValue(func(o *containers.CreateOptions) *[]string { return &o.CapAdd })},
+		{names: []string{"--cap-drop"}, apply: addListValue(func(o *containers.CreateOptions) *[]string { return &o.CapDrop })},
+
+		// health check
+		{names: []string{"--health-cmd"}, apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.HealthCmd })},
+		{
+			names: []string{"--health-interval"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.HealthInterval }),
+		},
+		{
+			names: []string{"--health-retries"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.HealthRetries }),
+		},
+		{
+			names: []string{"--health-start-period"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.HealthStartPeroid }),
+		},
+		{
+			names: []string{"--health-timeout"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.HealthTimeout }),
+		},
+		{
+			names: []string{"--health-on-failure"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.HealthOnFailure }),
+		},
+		{
+			names: []string{"--health-startup-cmd"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.HealthStartupCmd }),
+		},
+		{
+			names: []string{"--health-startup-interval"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.HealthStartupInterval }),
+		},
+		{
+			names: []string{"--health-startup-retries"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.HealthStartupRetries }),
+		},
+		{
+			names: []string{"--health-startup-success"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.HealthStartupSuccess }),
+		},
+		{
+			names: []string{"--health-startup-timeout"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.HealthStartupTimeout }),
+		},
+		{
+			names: []string{"--health-log-destination"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.HealthLogDestination }),
+		},
+		{
+			names: []string{"--health-max-log-size"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.HealthMaxLogSize }),
+		},
+		{
+			names: []string{"--health-max-log-count"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.HealthMaxLogCount }),
+		},
+		{
+			names: []string{"--no-healthcheck"}, boolean: true,
+			apply: func(opts *containers.CreateOptions, _ string) error {
+				opts.HealthCmd = ""
+
+				return nil
+			},
+		},
+
+		// resources
+		{names: []string{"--memory", "-m"}, apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.Memory })},
+		{
+			names: []string{"--memory-reservation"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.MemoryReservation }),
+		},
+		{
+			names: []string{"--memory-swap"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.MemorySwap }),
+		},
+		{
+			names: []string{"--memory-swappiness"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.MemorySwappiness }),
+		},
+		{names: []string{"--cpus"}, apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.CPUs })},
+		{
+			names: []string{"--cpu-shares", "-c"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.CPUShares }),
+		},
+		{names: []string{"--cpu-period"}, apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.CPUPeriod })},
+		{names: []string{"--cpu-quota"}, apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.CPUQuota })},
+		{
+			names: []string{"--cpu-rt-period"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.CPURtPeriod }),
+		},
+		{
+			names: []string{"--cpu-rt-runtime"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.CPURtRuntime }),
+		},
+		{
+			names: []string{"--cpuset-cpus"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.CPUSetCPUs }),
+		},
+		{
+			names: []string{"--cpuset-mems"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.CPUSetMems }),
+		},
+		{names: []string{"--shm-size"}, apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.SHMSize })},
+		{
+			names: []string{"--shm-size-systemd"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.SHMSizeSystemd }),
+		},
+
+		// namespaces
+		{
+			names: []string{"--cgroupns"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.NamespaceCgroup }),
+		},
+		{names: []string{"--ipc"}, apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.NamespaceIpc })},
+		{names: []string{"--pid"}, apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.NamespacePid })},
+		{
+			names: []string{"--userns"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.NamespaceUser }),
+		},
+		{names: []string{"--uts"}, apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.NamespaceUts })},
+		{
+			names: []string{"--uidmap"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.NamespaceUidmap }),
+		},
+		{
+			names: []string{"--subuidname"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.NamespaceSubuidName }),
+		},
+		{
+			names: []string{"--gidmap"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.NamespaceGidmap }),
+		},
+		{
+			names: []string{"--subgidname"},
+			apply: setStringValue(func(o *containers.CreateOptions) *string { return &o.NamespaceSubgidName }),
+		},
+	}
+}
+
+// ParseRunCommand parses a podman or docker run (or create) command line
+// and maps its flags to container create options.
+// Quoted arguments and backslash line continuations are supported.
+func ParseRunCommand(cmdline string) (RunCommand, error) { //nolint:cyclop
+	var result RunCommand
+
+	args, err := utils.SplitCommandLine(cmdline)
+	if err != nil {
+		return result, err
+	}
+
+	args, err = result.stripCommandPrefix(args)
+	if err != nil {
+		return result, err
+	}
+
+	flags := runCommandFlags()
+
+	for len(args) > 0 {
+		arg := args[0]
+		args = args[1:]
+
+		if arg == "--" {
+			break
+		}
+
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			// first positional argument is the image, the rest is the container command
+			args = append([]string{arg}, args...)
+
+			break
+		}
+
+		args = result.parseFlag(flags, arg, args)
+	}
+
+	if len(args) == 0 {
+		return result, errMissingRunImage
+	}
+
+	result.Options.Image = args[0]
+
+	if len(args) > 1 {
+		result.Options.CommandArgs = args[1:]
+		result.Options.Command = putils.ShellJoin(args[1:])
+	}
+
+	return result, nil
+}
+
+// parseFlag applies the flag to create options and returns the remaining arguments.
+func (r *RunCommand) parseFlag(flags []runCommandFlag, arg string, args []string) []string { //nolint:cyclop
+	name, value, hasValue := strings.Cut(arg, "=")
+
+	// short flags may be combined (-it) or have the value attached (-p8080:80)
+	if !strings.HasPrefix(arg, "--") && len(arg) > 2 { //nolint:mnd
+		name, value, hasValue = arg[:2], strings.TrimPrefix(arg[2:], "="), true
+
+		if flag, ok := findRunCommandFlag(flags, name); ok && flag.boolean && !strings.Contains(arg, "=") {
+			r.applyFlag(flag, name, "true")
+
+			return r.parseFlag(flags, "-"+arg[2:], args)
+		}
+	}
+
+	flag, ok := findRunCommandFlag(flags, name)
+	if !ok {
+		if !hasValue && !slices.Contains(runCommandBoolFlags, name) && len(args) > 0 {
+			arg = arg + " " + args[0]
+			args = args[1:]
+		}
+
+		r.Unmapped = append(r.Unmapped, arg)
+
+		return args
+	}
+
+	if !hasValue {
+		switch {
+		case flag.boolean:
+			value = "true"
+		case len(args) > 0:
+			value = args[0]
+			args = args[1:]
+		default:
+			r.Unmapped = append(r.Unmapped, fmt.Sprintf("%s (%v)", name, errMissingFlagValue))
+
+			return args
+		}
+	}
+
+	r.applyFlag(flag, name, value)
+
+	return args
+}
+
+func (r *RunCommand) applyFlag(flag runCommandFlag, name string, value string) {
+	if flag.boolean {
+		if _, err := strconv.ParseBool(value); err != nil {
+			r.Unmapped = append(r.Unmapped, fmt.Sprintf("%s=%s (%v)", name, value, err))
+
+			return
+		}
+	}
+
+	if err := flag.apply(&r.Options, value); err != nil {
+		r.Unmapped = append(r.Unmapped, fmt.Sprintf("%s %s (%v)", name, value, err))
+	}
+}
+
+func findRunCommandFlag(flags []runCommandFlag, name string) (runCommandFlag, bool) {
+	for _, flag := range flags {
+		if slices.Contains(flag.names, name) {
+			return flag, true
+		}
+	}
+
+	return runCommandFlag{}, false
+}
+
+// stripCommandPrefix strips the command prefix (podman [global flags] [container] run)
+// and returns the remaining arguments.
+func (r *RunCommand) stripCommandPrefix(args []string) ([]string, error) {
+	// skip shell prompt and sudo
+	for len(args) > 0 && (args[0] == "$" || args[0] == "#" || args[0] == "sudo") {
+		args = args[1:]
+	}
+
+	if len(args) == 0 {
+		return nil, errEmptyRunCommand
+	}
+
+	if program := filepath.Base(args[0]); program != "podman" && program != "docker" {
+		return nil, fmt.Errorf("%w: %q", errNotRunCommand, args[0])
+	}
+
+	args = args[1:]
+
+	// global flags are not container options
+	for len(args) > 0 && strings.HasPrefix(args[0], "-") {
+		globalFlag := args[0]
+		if !strings.Contains(globalFlag, "=") && len(args) > 1 && !isRunSubCommand(args[1]) {
+			globalFlag = globalFlag + " " + args[1]
+			args = args[1:]
+		}
+
+		r.Unmapped = append(r.Unmapped, globalFlag)
+		args = args[1:]
+	}
+
+	if len(args) > 0 && args[0] == "container" {
+		args = args[1:]
+	}
+
+	if len(args) == 0 || !isRunSubCommand(args[0]) {
+		return nil, errNotRunCommand
+	}
+
+	r.Run = args[0] == "run"
+
+	return args[1:], nil
+}
+
+func isRunSubCommand(arg string) bool {
+	return arg == "run" || arg == "create" || arg == "container"
+}
+
+func setStringValue(field func(opts *containers.CreateOptions) *string) func(*containers.CreateOptions, string) error {
+	return func(opts *containers.CreateOptions, value string) error {
+		if *field(opts) != "" {
+			return errMultipleValues
+		}
+
+		*field(opts) = value
+
+		return nil
+	}
+}
+
+func setBoolValue(field func(opts *containers.CreateOptions) *bool) func(*containers.CreateOptions, string) error {
+	return func(opts *containers.CreateOptions, value string) error {
+		val, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+
+		*field(opts) = val
+
+		return nil
+	}
+}
+
+// addListValue appends the value to the list field, the create dialog
+// keeps list values space separated therefore values with white spaces are rejected.
+func addListValue(field func(opts *containers.CreateOptions) *[]string) func(*containers.CreateOptions, string) error {
+	return func(opts *containers.CreateOptions, value string) error {
+		if value == "" || strings.IndexFunc(value, unicode.IsSpace) >= 0 {
+			return errValueWhiteSpace
+		}
+
+		*field(opts) = append(*field(opts), value)
+
+		return nil
+	}
+}
+
+// setNetworkValue sets the first network and appends the other networks,
+// the networks with options (name:ip=...) are kept in podman --network format.
+func setNetworkValue(opts *containers.CreateOptions, value string) error {
+	if value == "" || strings.IndexFunc(value, unicode.IsSpace) >= 0 {
+		return errValueWhiteSpace
+	}
+
+	name, _, hasOptions := strings.Cut(value, ":")
+
+	if opts.Network == "" {
+		opts.Network = name
+
+		if !hasOptions {
+			return nil
+		}
+	}
+
+	opts.Networks = append(opts.Networks, value)
+
+	return nil
+}
+
+func setSecurityOptValue(opts *containers.CreateOptions, value string) error { //nolint:cyclop
+	// docker also accepts colon separated options (e.g. apparmor:profile)
+	key, val, _ := strings.Cut(value, "=")
+	if sep := strings.Index(value, ":"); sep >= 0 && (sep < len(key) || !strings.Contains(value, "=")) {
+		key, val = value[:sep], value[sep+1:]
+	}
+
+	switch key {
+	case "no-new-privileges":
+		enabled := true
+
+		if val != "" {
+			parsed, err := strconv.ParseBool(val)
+			if err != nil {
+				return err
+			}
+
+			enabled = parsed
+		}
+
+		opts.SecNoNewPriv = enabled
+
+		return nil
+	case "label":
+		opts.SelinuxOpts = append(opts.SelinuxOpts, val)
+
+		return nil
+	case "apparmor":
+		return setStringValue(func(o *containers.CreateOptions) *string { return &o.ApparmorProfile })(opts, val)
+	case "seccomp":
+		return setStringValue(func(o *containers.CreateOptions) *string { return &o.Seccomp })(opts, val)
+	case "mask":
+		return setStringValue(func(o *containers.CreateOptions) *string { return &o.SecMask })(opts, val)
+	case "unmask":
+		return setStringValue(func(o *containers.CreateOptions) *string { return &o.SecUnmask })(opts, val)
+	}
+
+	return errUnsupportedSecurityOpt
+}
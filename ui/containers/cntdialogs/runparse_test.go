@@ -0,0 +1,124 @@
+package cntdialogs
+
+import (
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/containers/podman-tui/ui/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("container run command parser", func() {
+	It("tokenize command line", func() {
+		_, err := ParseRunCommand("podman run 'nginx")
+		Expect(err).To(MatchError(utils.ErrUnterminatedQuote))
+
+		_, err = ParseRunCommand(" \\\n ")
+		Expect(err).To(MatchError(errEmptyRunCommand))
+	})
+
+	It("parse run command", func() {
+		cmdline := `sudo podman --remote container run -dit --rm --name web \
+			-p 8080:80 --publish=8443:443 -e A=1 --env B=2 -l app=web \
+			-v data:/data -v /srv:/srv:ro --mount type=tmpfs,destination=/tmp \
+			--network backend --security-opt no-new-privileges \
+			--security-opt apparmor:unconfined --security-opt label=type:spc_t \
+			--health-cmd "curl -f http://localhost" -m 512m --cpus=1.5 \
+			docker.io/library/nginx:latest nginx -g daemon-off`
+		runCmd, err := ParseRunCommand(cmdline)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(runCmd.Run).To(BeTrue())
+		Expect(runCmd.Unmapped).To(Equal([]string{"--remote"}))
+
+		opts := runCmd.Options
+		Expect(opts.Detach).To(BeTrue())
+		Expect(opts.Interactive).To(BeTrue())
+		Expect(opts.TTY).To(BeTrue())
+		Expect(opts.Remove).To(BeTrue())
+		Expect(opts.Name).To(Equal("web"))
+		Expect(opts.Publish).To(Equal([]string{"8080:80", "8443:443"}))
+		Expect(opts.EnvVars).To(Equal([]string{"A=1", "B=2"}))
+		Expect(opts.Labels).To(Equal([]string{"app=web"}))
+		Expect(opts.Volume).To(Equal([]string{"data:/data", "/srv:/srv:ro"}))
+		Expect(opts.Mount).To(Equal([]string{"type=tmpfs,destination=/tmp"}))
+		Expect(opts.Network).To(Equal("backend"))
+		Expect(opts.SecNoNewPriv).To(BeTrue())
+		Expect(opts.ApparmorProfile).To(Equal("unconfined"))
+		Expect(opts.SelinuxOpts).To(Equal([]string{"type:spc_t"}))
+		Expect(opts.HealthCmd).To(Equal("curl -f http://localhost"))
+		Expect(opts.Memory).To(Equal("512m"))
+		Expect(opts.CPUs).To(Equal("1.5"))
+		Expect(opts.Image).To(Equal("docker.io/library/nginx:latest"))
+		Expect(opts.Command).To(Equal("nginx -g daemon-off"))
+	})
+
+	It("parse create command with unmapped flags", func() {
+		cmdline := "docker create --no-hosts --sig-proxy --log-driver none -p8080:80 " +
+			"-e 'A=with space' --name a --name b --rm=maybe alpine sh -c 'sleep 1'"
+		runCmd, err := ParseRunCommand(cmdline)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(runCmd.Run).To(BeFalse())
+		Expect(runCmd.Options.Publish).To(Equal([]string{"8080:80"}))
+		Expect(runCmd.Options.EnvVars).To(BeEmpty())
+		Expect(runCmd.Options.Name).To(Equal("a"))
+		Expect(runCmd.Options.Image).To(Equal("alpine"))
+		Expect(runCmd.Options.Command).To(Equal("sh -c 'sleep 1'"))
+		Expect(runCmd.Options.CommandArgs).To(Equal([]string{"sh", "-c", "sleep 1"}))
+		Expect(runCmd.Unmapped).To(HaveLen(6))
+		Expect(runCmd.Unmapped[0]).To(Equal("--no-hosts"))
+		Expect(runCmd.Unmapped[1]).To(Equal("--sig-proxy"))
+		Expect(runCmd.Unmapped[2]).To(Equal("--log-driver none"))
+		Expect(runCmd.Unmapped[3]).To(ContainSubstring(errValueWhiteSpace.Error()))
+		Expect(runCmd.Unmapped[4]).To(ContainSubstring(errMultipleValues.Error()))
+		Expect(runCmd.Unmapped[5]).To(HavePrefix("--rm=maybe"))
+	})
+
+	DescribeTable("parse create dialog extra options",
+		func(flags string, check func(opts containers.CreateOptions)) {
+			runCmd, err := ParseRunCommand("podman run " + flags + " alpine")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(runCmd.Unmapped).To(BeEmpty())
+			check(runCmd.Options)
+		},
+		Entry("init", "--init", func(opts containers.CreateOptions) {
+			Expect(opts.Init).To(BeTrue())
+		}),
+		Entry("read only", "--read-only=true", func(opts containers.CreateOptions) {
+			Expect(opts.ReadOnly).To(BeTrue())
+		}),
+		Entry("restart", "--restart=on-failure:3", func(opts containers.CreateOptions) {
+			Expect(opts.Restart).To(Equal("on-failure:3"))
+		}),
+		Entry("capabilities", "--cap-add NET_ADMIN --cap-add=SYS_TIME --cap-drop ALL", func(opts containers.CreateOptions) {
+			Expect(opts.CapAdd).To(Equal([]string{"NET_ADMIN", "SYS_TIME"}))
+			Expect(opts.CapDrop).To(Equal([]string{"ALL"}))
+		}),
+		Entry("group add", "--group-add keep-groups --group-add 1000", func(opts containers.CreateOptions) {
+			Expect(opts.GroupAdd).To(Equal([]string{"keep-groups", "1000"}))
+		}),
+		Entry("entrypoint", `--entrypoint '["/bin/sh","-c"]'`, func(opts containers.CreateOptions) {
+			Expect(opts.Entrypoint).To(Equal(`["/bin/sh","-c"]`))
+		}),
+		Entry("add host", "--add-host db:10.0.0.2 --add-host=cache:10.0.0.3", func(opts containers.CreateOptions) {
+			Expect(opts.AddHost).To(Equal([]string{"db:10.0.0.2", "cache:10.0.0.3"}))
+		}),
+		Entry("networks", "--network a --network b:alias=web --net c", func(opts containers.CreateOptions) {
+			Expect(opts.Network).To(Equal("a"))
+			Expect(opts.Networks).To(Equal([]string{"b:alias=web", "c"}))
+		}),
+		Entry("network with options", "--network a:ip=10.89.0.10", func(opts containers.CreateOptions) {
+			Expect(opts.Network).To(Equal("a"))
+			Expect(opts.Networks).To(Equal([]string{"a:ip=10.89.0.10"}))
+		}),
+	)
+
+	It("parse invalid commands", func() {
+		_, err := ParseRunCommand("kubectl run nginx")
+		Expect(err).To(MatchError(errNotRunCommand))
+
+		_, err = ParseRunCommand("podman ps -a")
+		Expect(err).To(MatchError(errNotRunCommand))
+
+		_, err = ParseRunCommand("podman run --rm -d")
+		Expect(err).To(MatchError(errMissingRunImage))
+	})
+})
@@ -0,0 +1,387 @@
+package cntdialogs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	cntUpgradeDialogMaxWidth     = 100
+	cntUpgradeDialogMaxHeight    = 15
+	cntUpgradeDialogLabelPadding = 1
+	cntUpgradeDialogLabelWidth   = 11
+)
+
+const (
+	cntUpgradeImageFocus = 0 + iota
+	cntUpgradeFormFocus
+)
+
+// container upgrade dialog stages, the action button label and handler depend on the stage.
+const (
+	cntUpgradePullStage = 0 + iota
+	cntUpgradeUpgradeStage
+	cntUpgradeRollbackStage
+	cntUpgradeDoneStage
+)
+
+// ContainerUpgradeDialog represents container image upgrade dialog primitive.
+// The image reference is pulled first, the container is recreated on the new image
+// if the digests differ and can be rolled back to the old image afterwards.
+type ContainerUpgradeDialog struct {
+	*tview.Box
+
+	layout          *tview.Flex
+	cntInfo         *tview.InputField
+	image           *tview.InputField
+	result          *tview.TextView
+	form            *tview.Form
+	display         bool
+	focusElement    int
+	stage           int
+	report          containers.UpgradeReport
+	pullHandler     func()
+	upgradeHandler  func()
+	rollbackHandler func()
+	cancelHandler   func()
+}
+
+// NewContainerUpgradeDialog returns new container upgrade dialog primitive.
+func NewContainerUpgradeDialog() *ContainerUpgradeDialog {
+	dialog := &ContainerUpgradeDialog{
+		Box:     tview.NewBox(),
+		layout:  tview.NewFlex().SetDirection(tview.FlexRow),
+		cntInfo: tview.NewInputField(),
+		image:   tview.NewInputField(),
+		result:  tview.NewTextView(),
+		form:    tview.NewForm(),
+	}
+
+	bgColor := style.DialogBgColor
+
+	// container info input field
+	dialog.cntInfo.SetBackgroundColor(bgColor)
+	dialog.cntInfo.SetLabel("[::b]" + utils.ContainerIDLabel)
+	dialog.cntInfo.SetFieldBackgroundColor(bgColor)
+	dialog.cntInfo.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(style.DialogFgColor))
+
+	// image field
+	dialog.image.SetBackgroundColor(bgColor)
+	dialog.image.SetLabel(utils.StringToInputLabel("image:", cntUpgradeDialogLabelWidth))
+	dialog.image.SetFieldStyle(style.InputFieldStyle)
+	dialog.image.SetLabelStyle(style.InputLabelStyle)
+
+	// result text view
+	dialog.result.SetBackgroundColor(bgColor)
+	dialog.result.SetTextColor(style.DialogFgColor)
+	dialog.result.SetDynamicColors(true)
+	dialog.result.SetWrap(true)
+
+	// form
+	dialog.form.AddButton("Cancel", nil)
+	dialog.form.AddButton("Pull", dialog.actionSelected)
+	dialog.form.SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	inputLayout := tview.NewFlex().SetDirection(tview.FlexRow)
+	inputLayout.SetBackgroundColor(bgColor)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.cntInfo, 1, 0, false)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.image, 1, 0, true)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.result, 0, 1, false)
+
+	mainLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	mainLayout.SetBackgroundColor(bgColor)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainLayout.AddItem(inputLayout, 0, 1, true)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetTitle("PODMAN CONTAINER UPGRADE")
+	dialog.layout.AddItem(mainLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *ContainerUpgradeDialog) Display() {
+	d.display = true
+	d.focusElement = cntUpgradeImageFocus
+	d.form.SetFocus(0)
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *ContainerUpgradeDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *ContainerUpgradeDialog) Hide() {
+	d.display = false
+	d.focusElement = cntUpgradeImageFocus
+	d.report = containers.UpgradeReport{}
+	d.image.SetText("")
+	d.result.SetText("")
+	d.setStage(cntUpgradePullStage)
+}
+
+// SetContainerInfo sets selected container ID, name and image reference.
+func (d *ContainerUpgradeDialog) SetContainerInfo(id string, name string, imageRef string) {
+	containerInfo := fmt.Sprintf("%s (%s)", id, name)
+	containerInfo = utils.LabelWidthLeftPadding(containerInfo, cntUpgradeDialogLabelPadding)
+
+	d.cntInfo.SetText(containerInfo)
+	d.image.SetText(imageRef)
+}
+
+// GetImageRef returns the image reference to pull.
+func (d *ContainerUpgradeDialog) GetImageRef() string {
+	return d.image.GetText()
+}
+
+// GetUpgradeReport returns the pulled image report.
+func (d *ContainerUpgradeDialog) GetUpgradeReport() containers.UpgradeReport {
+	return d.report
+}
+
+// SetUpgradeReport sets the pulled image report and displays old versus new digest.
+// The upgrade action is enabled if the image has been changed and all the container
+// settings can be recreated.
+func (d *ContainerUpgradeDialog) SetUpgradeReport(report containers.UpgradeReport) {
+	d.report = report
+
+	status := "[green::]image is up to date[-::]"
+
+	switch {
+	case report.Updated() && len(report.Unmapped) > 0:
+		status = fmt.Sprintf("[red::]upgrade blocked, settings cannot be recreated:[-::] %s",
+			tview.Escape(strings.Join(report.Unmapped, ", ")))
+	case report.Updated():
+		status = "[orange::]new image available[-::]"
+
+		d.setStage(cntUpgradeUpgradeStage)
+	}
+
+	d.setResult(status)
+}
+
+// SetUpgraded sets the upgraded container ID, the rollback action is enabled.
+func (d *ContainerUpgradeDialog) SetUpgraded(id string) {
+	d.report.ContainerID = id
+
+	d.setStage(cntUpgradeRollbackStage)
+	d.setResult("[green::]container upgraded[-::], select rollback to restore the old image")
+}
+
+// SetRolledBack sets the rolled back container ID.
+func (d *ContainerUpgradeDialog) SetRolledBack(id string) {
+	d.report.ContainerID = id
+
+	d.setStage(cntUpgradeDoneStage)
+	d.setResult("[green::]container rolled back to the old image[-::]")
+}
+
+func (d *ContainerUpgradeDialog) setResult(status string) {
+	lineFormat := "%s %s %s\n"
+	oldLabel := utils.StringToInputLabel("old image:", cntUpgradeDialogLabelWidth)
+	newLabel := utils.StringToInputLabel("new image:", cntUpgradeDialogLabelWidth)
+	statusLabel := utils.StringToInputLabel("status:", cntUpgradeDialogLabelWidth)
+
+	result := fmt.Sprintf(lineFormat, oldLabel, utils.GetIDWithLimit(d.report.OldImageID), d.report.OldDigest)
+	result += fmt.Sprintf(lineFormat, newLabel, utils.GetIDWithLimit(d.report.NewImageID), d.report.NewDigest)
+	result += fmt.Sprintf("%s %s", statusLabel, status)
+
+	d.result.SetText(result)
+}
+
+func (d *ContainerUpgradeDialog) setStage(stage int) {
+	labels := map[int]string{
+		cntUpgradePullStage:     "Pull",
+		cntUpgradeUpgradeStage:  "Upgrade",
+		cntUpgradeRollbackStage: "Rollback",
+		cntUpgradeDoneStage:     "Close",
+	}
+
+	d.stage = stage
+	d.image.SetDisabled(stage != cntUpgradePullStage)
+	d.form.GetButton(d.form.GetButtonCount() - 1).SetLabel(labels[stage])
+
+	if stage != cntUpgradePullStage {
+		d.focusElement = cntUpgradeFormFocus
+		d.form.SetFocus(d.form.GetButtonCount() - 1)
+	}
+}
+
+func (d *ContainerUpgradeDialog) actionSelected() {
+	var handler func()
+
+	switch d.stage {
+	case cntUpgradePullStage:
+		handler = d.pullHandler
+	case cntUpgradeUpgradeStage:
+		handler = d.upgradeHandler
+	case cntUpgradeRollbackStage:
+		handler = d.rollbackHandler
+	case cntUpgradeDoneStage:
+		handler = d.cancelHandler
+	}
+
+	if handler != nil {
+		handler()
+	}
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *ContainerUpgradeDialog) HasFocus() bool {
+	if d.image.HasFocus() || d.form.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus() || d.layout.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *ContainerUpgradeDialog) Focus(delegate func(p tview.Primitive)) {
+	if d.focusElement == cntUpgradeImageFocus && d.stage == cntUpgradePullStage {
+		delegate(d.image)
+
+		return
+	}
+
+	button := d.form.GetButton(d.form.GetButtonCount() - 1)
+	button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == utils.SwitchFocusKey.Key {
+			d.focusElement = cntUpgradeImageFocus
+
+			d.Focus(delegate)
+			d.form.SetFocus(0)
+
+			return nil
+		}
+
+		return event
+	})
+
+	delegate(d.form)
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *ContainerUpgradeDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("container upgrade dialog: event %v received", event)
+
+		if event.Key() == utils.CloseDialogKey.Key {
+			d.cancelHandler()
+
+			return
+		}
+
+		if d.image.HasFocus() {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = cntUpgradeFormFocus
+				d.Focus(setFocus)
+
+				return
+			}
+
+			if event.Key() == tcell.KeyEnter {
+				d.actionSelected()
+
+				return
+			}
+
+			if imageHandler := d.image.InputHandler(); imageHandler != nil {
+				imageHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+// SetRect set rects for this primitive.
+func (d *ContainerUpgradeDialog) SetRect(x, y, width, height int) {
+	if width > cntUpgradeDialogMaxWidth {
+		emptySpace := (width - cntUpgradeDialogMaxWidth) / 2 //nolint:mnd
+		x += emptySpace
+		width = cntUpgradeDialogMaxWidth
+	}
+
+	if height > cntUpgradeDialogMaxHeight {
+		emptySpace := (height - cntUpgradeDialogMaxHeight) / 2 //nolint:mnd
+		y += emptySpace
+		height = cntUpgradeDialogMaxHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *ContainerUpgradeDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.DrawForSubclass(screen, d)
+
+	x, y, width, height := d.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetPullFunc sets pull action function.
+func (d *ContainerUpgradeDialog) SetPullFunc(handler func()) *ContainerUpgradeDialog {
+	d.pullHandler = handler
+
+	return d
+}
+
+// SetUpgradeFunc sets upgrade action function.
+func (d *ContainerUpgradeDialog) SetUpgradeFunc(handler func()) *ContainerUpgradeDialog {
+	d.upgradeHandler = handler
+
+	return d
+}
+
+// SetRollbackFunc sets rollback action function.
+func (d *ContainerUpgradeDialog) SetRollbackFunc(handler func()) *ContainerUpgradeDialog {
+	d.rollbackHandler = handler
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *ContainerUpgradeDialog) SetCancelFunc(handler func()) *ContainerUpgradeDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 2) //nolint:mnd
+
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
@@ -0,0 +1,123 @@
+package cntdialogs
+
+import (
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("container upgrade", Ordered, func() {
+	var upgradeDialogApp *tview.Application
+	var upgradeDialogScreen tcell.SimulationScreen
+	var upgradeDialog *ContainerUpgradeDialog
+	var runApp func()
+
+	pullAction := "initial"
+	upgradeAction := "initial"
+	rollbackAction := "initial"
+	cancelAction := "initial"
+
+	BeforeAll(func() {
+		upgradeDialogApp = tview.NewApplication()
+		upgradeDialog = NewContainerUpgradeDialog()
+		upgradeDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := upgradeDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := upgradeDialogApp.SetScreen(upgradeDialogScreen).SetRoot(upgradeDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		upgradeDialog.SetPullFunc(func() { pullAction = "pull" })
+		upgradeDialog.SetUpgradeFunc(func() { upgradeAction = "upgrade" })
+		upgradeDialog.SetRollbackFunc(func() { rollbackAction = "rollback" })
+		upgradeDialog.SetCancelFunc(func() { cancelAction = "cancel" })
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		upgradeDialog.SetContainerInfo("cntID", "web", "docker.io/library/nginx:latest")
+		upgradeDialog.Display()
+		upgradeDialogApp.SetFocus(upgradeDialog)
+		upgradeDialogApp.Draw()
+		Expect(upgradeDialog.IsDisplay()).To(Equal(true))
+		Expect(upgradeDialog.HasFocus()).To(Equal(true))
+		Expect(upgradeDialog.GetImageRef()).To(Equal("docker.io/library/nginx:latest"))
+	})
+
+	It("pull action", func() {
+		upgradeDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		upgradeDialogApp.Draw()
+		Expect(pullAction).To(Equal("pull"))
+	})
+
+	It("up to date image", func() {
+		upgradeDialog.SetUpgradeReport(containers.UpgradeReport{
+			ContainerID: "cntID",
+			OldImageID:  "img01",
+			NewImageID:  "img01",
+		})
+		Expect(upgradeDialog.stage).To(Equal(cntUpgradePullStage))
+		Expect(upgradeDialog.result.GetText(true)).To(ContainSubstring("image is up to date"))
+	})
+
+	It("upgrade blocked by unmapped settings", func() {
+		upgradeDialog.SetUpgradeReport(containers.UpgradeReport{
+			ContainerID: "cntID",
+			OldImageID:  "img01",
+			NewImageID:  "img02",
+			Unmapped:    []string{"--ulimit nofile=1024"},
+		})
+		Expect(upgradeDialog.stage).To(Equal(cntUpgradePullStage))
+		Expect(upgradeDialog.result.GetText(true)).To(ContainSubstring("--ulimit nofile=1024"))
+	})
+
+	It("upgrade action", func() {
+		upgradeDialog.SetUpgradeReport(containers.UpgradeReport{
+			ContainerID: "cntID",
+			OldImageID:  "img01",
+			OldDigest:   "sha256:01",
+			NewImageID:  "img02",
+			NewDigest:   "sha256:02",
+		})
+		upgradeDialogApp.SetFocus(upgradeDialog)
+		upgradeDialogApp.Draw()
+		Expect(upgradeDialog.result.GetText(true)).To(ContainSubstring("sha256:02"))
+		upgradeDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		upgradeDialogApp.Draw()
+		Expect(upgradeAction).To(Equal("upgrade"))
+	})
+
+	It("rollback action", func() {
+		upgradeDialog.SetUpgraded("newID")
+		upgradeDialogApp.SetFocus(upgradeDialog)
+		upgradeDialogApp.Draw()
+		upgradeDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		upgradeDialogApp.Draw()
+		Expect(rollbackAction).To(Equal("rollback"))
+		Expect(upgradeDialog.GetUpgradeReport().ContainerID).To(Equal("newID"))
+	})
+
+	It("cancel action", func() {
+		upgradeDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
+		upgradeDialogApp.Draw()
+		Expect(cancelAction).To(Equal("cancel"))
+	})
+
+	It("hide", func() {
+		upgradeDialog.Hide()
+		Expect(upgradeDialog.IsDisplay()).To(Equal(false))
+		Expect(upgradeDialog.stage).To(Equal(cntUpgradePullStage))
+		Expect(upgradeDialog.GetImageRef()).To(Equal(""))
+	})
+
+	AfterAll(func() {
+		upgradeDialogApp.Stop()
+	})
+})
@@ -0,0 +1,326 @@
+package vterm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// copyMode implements a vi like navigation over the scrollback buffer,
+// with selection, search and yank (OSC52 clipboard) support.
+type copyMode struct {
+	active    bool
+	lines     []string
+	row       int
+	col       int
+	top       int
+	selecting bool
+	selRow    int
+	selCol    int
+	searching bool
+	search    string
+	lastFind  string
+	message   string
+	clipboard []byte
+}
+
+// enter activates copy mode over a snapshot of the scrollback lines.
+func (cm *copyMode) enter(lines []string) {
+	// drop trailing empty lines (empty terminal rows)
+	for len(lines) > 1 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	cm.active = true
+	cm.lines = lines
+	cm.row = len(lines) - 1
+	cm.col = 0
+	cm.top = 0
+	cm.selecting = false
+	cm.searching = false
+	cm.search = ""
+	cm.message = ""
+}
+
+func (cm *copyMode) exit() {
+	cm.active = false
+	cm.lines = nil
+	cm.selecting = false
+	cm.searching = false
+}
+
+// handleKey handles copy mode key event and returns false
+// if the copy mode has been exited.
+func (cm *copyMode) handleKey(event *tcell.EventKey, pageSize int) bool { //nolint:cyclop,gocyclo
+	if cm.searching {
+		cm.handleSearchKey(event)
+
+		return true
+	}
+
+	cm.message = ""
+
+	switch event.Key() { //nolint:exhaustive
+	case tcell.KeyEsc:
+		if cm.selecting {
+			cm.selecting = false
+
+			return true
+		}
+
+		cm.exit()
+
+		return false
+	case tcell.KeyUp:
+		cm.moveRow(-1)
+	case tcell.KeyDown:
+		cm.moveRow(1)
+	case tcell.KeyLeft:
+		cm.moveCol(-1)
+	case tcell.KeyRight:
+		cm.moveCol(1)
+	case tcell.KeyPgUp, tcell.KeyCtrlB:
+		cm.moveRow(-pageSize)
+	case tcell.KeyPgDn, tcell.KeyCtrlF:
+		cm.moveRow(pageSize)
+	case tcell.KeyHome:
+		cm.moveRow(-len(cm.lines))
+	case tcell.KeyEnd:
+		cm.moveRow(len(cm.lines))
+	case tcell.KeyEnter:
+		return cm.yank()
+	case tcell.KeyRune:
+		switch event.Rune() {
+		case 'q':
+			cm.exit()
+
+			return false
+		case 'k':
+			cm.moveRow(-1)
+		case 'j':
+			cm.moveRow(1)
+		case 'h':
+			cm.moveCol(-1)
+		case 'l':
+			cm.moveCol(1)
+		case 'g':
+			cm.moveRow(-len(cm.lines))
+		case 'G':
+			cm.moveRow(len(cm.lines))
+		case '0':
+			cm.col = 0
+		case '$':
+			cm.col = max(len([]rune(cm.lines[cm.row]))-1, 0)
+		case 'v', ' ':
+			cm.selecting = !cm.selecting
+			cm.selRow = cm.row
+			cm.selCol = cm.col
+		case 'y':
+			return cm.yank()
+		case '/':
+			cm.searching = true
+			cm.search = ""
+		case 'n':
+			cm.find(cm.lastFind, true)
+		case 'N':
+			cm.find(cm.lastFind, false)
+		}
+	}
+
+	return true
+}
+
+func (cm *copyMode) handleSearchKey(event *tcell.EventKey) {
+	switch event.Key() { //nolint:exhaustive
+	case tcell.KeyEsc:
+		cm.searching = false
+	case tcell.KeyEnter:
+		cm.searching = false
+		cm.lastFind = cm.search
+		cm.find(cm.lastFind, true)
+	case tcell.KeyBackspace, tcell.KeyBackspace2:
+		if search := []rune(cm.search); len(search) > 0 {
+			cm.search = string(search[:len(search)-1])
+		}
+	case tcell.KeyRune:
+		cm.search += string(event.Rune())
+	}
+}
+
+func (cm *copyMode) moveRow(offset int) {
+	cm.row = min(max(cm.row+offset, 0), len(cm.lines)-1)
+	cm.col = min(cm.col, max(len([]rune(cm.lines[cm.row]))-1, 0))
+}
+
+func (cm *copyMode) moveCol(offset int) {
+	cm.col = min(max(cm.col+offset, 0), max(len([]rune(cm.lines[cm.row]))-1, 0))
+}
+
+// find moves the cursor to the next (or previous) line matching the text.
+func (cm *copyMode) find(text string, forward bool) {
+	if text == "" {
+		return
+	}
+
+	total := len(cm.lines)
+
+	for i := 1; i <= total; i++ {
+		row := (cm.row - i + total) % total
+		if forward {
+			row = (cm.row + i) % total
+		}
+
+		if idx := strings.Index(cm.lines[row], text); idx >= 0 {
+			cm.row = row
+			cm.col = len([]rune(cm.lines[row][:idx]))
+
+			return
+		}
+	}
+
+	cm.message = fmt.Sprintf("pattern not found: %s", text)
+}
+
+// selection returns normalized selection start and end positions.
+func (cm *copyMode) selection() (int, int, int, int) {
+	startRow, startCol, endRow, endCol := cm.selRow, cm.selCol, cm.row, cm.col
+	if startRow > endRow || (startRow == endRow && startCol > endCol) {
+		startRow, startCol, endRow, endCol = endRow, endCol, startRow, startCol
+	}
+
+	return startRow, startCol, endRow, endCol
+}
+
+func (cm *copyMode) isSelected(row int, col int) bool {
+	if !cm.selecting {
+		return false
+	}
+
+	startRow, startCol, endRow, endCol := cm.selection()
+
+	if row < startRow || row > endRow {
+		return false
+	}
+
+	if row == startRow && col < startCol {
+		return false
+	}
+
+	if row == endRow && col > endCol {
+		return false
+	}
+
+	return true
+}
+
+// selectedText returns the selected text or the current line if there is no selection.
+func (cm *copyMode) selectedText() string {
+	if !cm.selecting {
+		return cm.lines[cm.row]
+	}
+
+	startRow, startCol, endRow, endCol := cm.selection()
+	text := make([]string, 0, endRow-startRow+1)
+
+	for row := startRow; row <= endRow; row++ {
+		line := []rune(cm.lines[row])
+		lineStart := 0
+		lineEnd := len(line)
+
+		if row == startRow {
+			lineStart = min(startCol, len(line))
+		}
+
+		if row == endRow {
+			lineEnd = min(endCol+1, len(line))
+		}
+
+		if lineStart > lineEnd {
+			lineStart = lineEnd
+		}
+
+		text = append(text, string(line[lineStart:lineEnd]))
+	}
+
+	return strings.Join(text, "\n")
+}
+
+// yank stores the selected text for the clipboard and exits copy mode.
+func (cm *copyMode) yank() bool {
+	cm.clipboard = []byte(cm.selectedText())
+	cm.exit()
+
+	return false
+}
+
+// takeClipboard returns and clears pending clipboard content.
+func (cm *copyMode) takeClipboard() []byte {
+	data := cm.clipboard
+	cm.clipboard = nil
+
+	return data
+}
+
+// draw draws copy mode content within the given rect, last row is used as status line.
+func (cm *copyMode) draw(screen tcell.Screen, x, y, width, height int, textStyle tcell.Style) {
+	if height < 2 { //nolint:mnd
+		return
+	}
+
+	viewHeight := height - 1
+
+	if cm.row < cm.top {
+		cm.top = cm.row
+	}
+
+	if cm.row >= cm.top+viewHeight {
+		cm.top = cm.row - viewHeight + 1
+	}
+
+	for trow := 0; trow < viewHeight && cm.top+trow < len(cm.lines); trow++ {
+		row := cm.top + trow
+		line := []rune(cm.lines[row])
+
+		for col := 0; col < width; col++ {
+			ch := ' '
+			if col < len(line) {
+				ch = line[col]
+			}
+
+			cellStyle := textStyle
+			if cm.isSelected(row, col) {
+				cellStyle = cellStyle.Reverse(true)
+			}
+
+			if row == cm.row && col == cm.col {
+				cellStyle = cellStyle.Reverse(true).Bold(true)
+			}
+
+			screen.SetContent(x+col, y+trow, ch, nil, cellStyle)
+		}
+	}
+
+	status := fmt.Sprintf("[COPY MODE] %d/%d  v:select y:yank /:search n/N:next/prev q:quit", cm.row+1, len(cm.lines))
+
+	switch {
+	case cm.searching:
+		status = "/" + cm.search
+	case cm.message != "":
+		status = cm.message
+	case cm.selecting:
+		status = fmt.Sprintf("[COPY MODE] %d/%d  (selecting) y:yank esc:cancel", cm.row+1, len(cm.lines))
+	}
+
+	statusStyle := textStyle.Reverse(true)
+	statusText := []rune(status)
+
+	for col := 0; col < width; col++ {
+		ch := ' '
+		if col < len(statusText) {
+			ch = statusText[col]
+		}
+
+		screen.SetContent(x+col, y+viewHeight, ch, nil, statusStyle)
+	}
+}
@@ -0,0 +1,79 @@
+package vterm
+
+import (
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("terminal copy mode", func() {
+	var cm *copyMode
+
+	typeKeys := func(keys string) {
+		for _, r := range keys {
+			cm.handleKey(tcell.NewEventKey(tcell.KeyRune, r, tcell.ModNone), 10) //nolint:mnd
+		}
+	}
+
+	pressKey := func(key tcell.Key) bool {
+		return cm.handleKey(tcell.NewEventKey(key, 0, tcell.ModNone), 10) //nolint:mnd
+	}
+
+	BeforeEach(func() {
+		cm = &copyMode{}
+		cm.enter([]string{"first error", "second line", "third error here", "last line", "", ""})
+	})
+
+	It("enter", func() {
+		Expect(cm.active).To(BeTrue())
+		Expect(cm.lines).To(HaveLen(4))
+		Expect(cm.row).To(Equal(3))
+	})
+
+	It("search forward and backward", func() {
+		typeKeys("gg/error")
+		Expect(cm.searching).To(BeTrue())
+		Expect(cm.search).To(Equal("error"))
+
+		pressKey(tcell.KeyEnter)
+		Expect(cm.searching).To(BeFalse())
+		Expect(cm.row).To(Equal(2))
+		Expect(cm.col).To(Equal(6))
+
+		// wraps around to the first line
+		typeKeys("n")
+		Expect(cm.row).To(Equal(0))
+		Expect(cm.col).To(Equal(6))
+
+		typeKeys("N")
+		Expect(cm.row).To(Equal(2))
+	})
+
+	It("search edit and not found", func() {
+		typeKeys("/missingx")
+		pressKey(tcell.KeyBackspace2)
+		Expect(cm.search).To(Equal("missing"))
+
+		pressKey(tcell.KeyEnter)
+		Expect(cm.row).To(Equal(3))
+		Expect(cm.message).To(Equal("pattern not found: missing"))
+	})
+
+	It("search cancel", func() {
+		typeKeys("/line")
+		pressKey(tcell.KeyEsc)
+		Expect(cm.searching).To(BeFalse())
+		Expect(cm.active).To(BeTrue())
+		Expect(cm.lastFind).To(BeEmpty())
+	})
+
+	It("select and yank", func() {
+		typeKeys("g/second")
+		pressKey(tcell.KeyEnter)
+		typeKeys("vjl")
+		Expect(pressKey(tcell.KeyEnter)).To(BeFalse())
+		Expect(cm.active).To(BeFalse())
+		Expect(string(cm.takeClipboard())).To(Equal("second line\nth"))
+		Expect(cm.takeClipboard()).To(BeNil())
+	})
+})
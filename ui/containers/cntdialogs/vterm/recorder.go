@@ -0,0 +1,128 @@
+package vterm
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/containers/podman-tui/ui/utils"
+)
+
+const (
+	recorderDirPerm  = 0o700
+	recorderFilePerm = 0o600
+	recorderVersion  = 2
+)
+
+// recordingsDir is the directory (relative to user's home) that session
+// recordings are written to.
+var recordingsDir = filepath.Join(".config", "podman-tui", "recordings")
+
+type recorderHeader struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Env       map[string]string `json:"env"`
+}
+
+// recorder writes terminal session output in asciinema v2 (.cast) format.
+type recorder struct {
+	file  *os.File
+	path  string
+	start time.Time
+	mu    sync.Mutex
+}
+
+// newRecorder creates a new cast file for the given container and writes its header.
+func newRecorder(name string, width int, height int) (*recorder, error) {
+	home, err := utils.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+
+	dir := filepath.Join(home, recordingsDir)
+
+	if err := os.MkdirAll(dir, recorderDirPerm); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	name = strings.NewReplacer("/", "_", ":", "_", " ", "_").Replace(name)
+	path := filepath.Join(dir, fmt.Sprintf("%s-%s.cast", name, start.Format("20060102-150405")))
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, recorderFilePerm) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := json.Marshal(recorderHeader{
+		Version:   recorderVersion,
+		Width:     width,
+		Height:    height,
+		Timestamp: start.Unix(),
+		Env:       map[string]string{"TERM": "xterm-256color"},
+	})
+	if err == nil {
+		_, err = fmt.Fprintf(file, "%s\n", header)
+	}
+
+	if err != nil {
+		file.Close() //nolint:errcheck,gosec
+
+		return nil, err
+	}
+
+	return &recorder{
+		file:  file,
+		path:  path,
+		start: start,
+	}, nil
+}
+
+// Path returns the cast file path.
+func (rec *recorder) Path() string {
+	return rec.path
+}
+
+// Write implements io.Writer and writes the data as an output event.
+func (rec *recorder) Write(data []byte) (int, error) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	if rec.file == nil {
+		return 0, os.ErrClosed
+	}
+
+	elapsed := time.Since(rec.start).Seconds()
+
+	event, err := json.Marshal([]any{elapsed, "o", string(data)})
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := fmt.Fprintf(rec.file, "%s\n", event); err != nil {
+		return 0, err
+	}
+
+	return len(data), nil
+}
+
+// Close closes the cast file.
+func (rec *recorder) Close() error {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	if rec.file == nil {
+		return nil
+	}
+
+	err := rec.file.Close()
+	rec.file = nil
+
+	return err
+}
@@ -0,0 +1,70 @@
+package vterm
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("terminal session recorder", func() {
+	It("write asciinema v2 header and events", func() {
+		GinkgoT().Setenv("HOME", GinkgoT().TempDir())
+
+		rec, err := newRecorder("web/app:1", 80, 24) //nolint:mnd
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filepath.Base(rec.Path())).To(HavePrefix("web_app_1-"))
+		Expect(rec.Path()).To(HaveSuffix(".cast"))
+
+		n, err := rec.Write([]byte("hello\r\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(n).To(Equal(7))
+
+		_, err = rec.Write([]byte("\x1b[31mred\x1b[0m"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(rec.Close()).To(Succeed())
+		Expect(rec.Close()).To(Succeed())
+
+		_, err = rec.Write([]byte("closed"))
+		Expect(err).To(MatchError(os.ErrClosed))
+
+		castFile, err := os.Open(rec.Path())
+		Expect(err).NotTo(HaveOccurred())
+
+		defer castFile.Close()
+
+		lines := make([]string, 0)
+		scanner := bufio.NewScanner(castFile)
+
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+
+		Expect(lines).To(HaveLen(3))
+
+		var header recorderHeader
+
+		Expect(json.Unmarshal([]byte(lines[0]), &header)).To(Succeed())
+		Expect(header.Version).To(Equal(2))
+		Expect(header.Width).To(Equal(80))
+		Expect(header.Height).To(Equal(24))
+		Expect(header.Timestamp).To(BeNumerically(">", 0))
+		Expect(header.Env).To(HaveKeyWithValue("TERM", "xterm-256color"))
+
+		outputs := []string{"hello\r\n", "\x1b[31mred\x1b[0m"}
+
+		for i, line := range lines[1:] {
+			var event []any
+
+			Expect(json.Unmarshal([]byte(line), &event)).To(Succeed())
+			Expect(event).To(HaveLen(3))
+			Expect(event[0]).To(BeNumerically(">=", 0))
+			Expect(event[1]).To(Equal("o"))
+			Expect(event[2]).To(Equal(outputs[i]))
+		}
+	})
+})
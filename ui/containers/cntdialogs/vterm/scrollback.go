@@ -0,0 +1,176 @@
+package vterm
+
+import (
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+const (
+	scrollbackMaxLines = 5000
+	scrollbackTabWidth = 8
+)
+
+const (
+	scrollbackStateText = 0 + iota
+	scrollbackStateEsc
+	scrollbackStateCSI
+	scrollbackStateOSC
+	scrollbackStateOSCEsc
+)
+
+// scrollback keeps a plain text history of the terminal session output.
+// vt10x does not keep lines that scroll off the screen, the session output is
+// therefore fed into this buffer as well with the escape sequences stripped.
+type scrollback struct {
+	lines   []string
+	current []rune
+	col     int
+	state   int
+	pending []byte
+	mu      sync.Mutex
+}
+
+func newScrollback() *scrollback {
+	return &scrollback{}
+}
+
+// Write implements io.Writer.
+func (sb *scrollback) Write(data []byte) (int, error) {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+
+	buf := append(sb.pending, data...) //nolint:gocritic
+	sb.pending = nil
+
+	for len(buf) > 0 {
+		r, size := utf8.DecodeRune(buf)
+		if r == utf8.RuneError && size <= 1 && !utf8.FullRune(buf) {
+			// incomplete multi-byte rune, wait for the rest of it
+			sb.pending = append(sb.pending, buf...)
+
+			break
+		}
+
+		buf = buf[size:]
+
+		sb.feed(r)
+	}
+
+	return len(data), nil
+}
+
+// Lines returns a copy of the buffered lines including the current line.
+func (sb *scrollback) Lines() []string {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+
+	lines := make([]string, 0, len(sb.lines)+1)
+	lines = append(lines, sb.lines...)
+	lines = append(lines, string(sb.current))
+
+	return lines
+}
+
+// Reset clears the buffer.
+func (sb *scrollback) Reset() {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+
+	sb.lines = nil
+	sb.current = nil
+	sb.col = 0
+	sb.state = scrollbackStateText
+	sb.pending = nil
+}
+
+func (sb *scrollback) feed(r rune) { //nolint:cyclop
+	switch sb.state {
+	case scrollbackStateEsc:
+		switch r {
+		case '[':
+			sb.state = scrollbackStateCSI
+		case ']':
+			sb.state = scrollbackStateOSC
+		default:
+			sb.state = scrollbackStateText
+		}
+
+		return
+	case scrollbackStateCSI:
+		// parameter and intermediate bytes until the final byte
+		if r >= 0x40 && r <= 0x7e {
+			sb.state = scrollbackStateText
+
+			if r == 'K' {
+				// erase in line
+				if sb.col < len(sb.current) {
+					sb.current = sb.current[:sb.col]
+				}
+			}
+		}
+
+		return
+	case scrollbackStateOSC:
+		switch r {
+		case '\a':
+			sb.state = scrollbackStateText
+		case '\x1b':
+			sb.state = scrollbackStateOSCEsc
+		}
+
+		return
+	case scrollbackStateOSCEsc:
+		sb.state = scrollbackStateText
+
+		return
+	}
+
+	switch r {
+	case '\x1b':
+		sb.state = scrollbackStateEsc
+	case '\r':
+		sb.col = 0
+	case '\n':
+		sb.newLine()
+	case '\b':
+		if sb.col > 0 {
+			sb.col--
+		}
+	case '\t':
+		spaces := scrollbackTabWidth - (sb.col % scrollbackTabWidth)
+		for range spaces {
+			sb.put(' ')
+		}
+	default:
+		if r < ' ' || r == 0x7f {
+			return
+		}
+
+		sb.put(r)
+	}
+}
+
+func (sb *scrollback) put(r rune) {
+	if sb.col < len(sb.current) {
+		sb.current[sb.col] = r
+	} else {
+		for len(sb.current) < sb.col {
+			sb.current = append(sb.current, ' ')
+		}
+
+		sb.current = append(sb.current, r)
+	}
+
+	sb.col++
+}
+
+func (sb *scrollback) newLine() {
+	sb.lines = append(sb.lines, strings.TrimRight(string(sb.current), " "))
+	sb.current = nil
+	sb.col = 0
+
+	if len(sb.lines) > scrollbackMaxLines {
+		sb.lines = sb.lines[len(sb.lines)-scrollbackMaxLines:]
+	}
+}
@@ -0,0 +1,51 @@
+package vterm
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("terminal scrollback", func() {
+	DescribeTable("write",
+		func(writes []string, expected []string) {
+			sb := newScrollback()
+
+			for _, data := range writes {
+				n, err := sb.Write([]byte(data))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(n).To(Equal(len(data)))
+			}
+
+			Expect(sb.Lines()).To(Equal(expected))
+		},
+		Entry("plain lines", []string{"hello\nworld"}, []string{"hello", "world"}),
+		Entry("csi color sequences", []string{"\x1b[1;31mred\x1b[0m text\n"}, []string{"red text", ""}),
+		Entry("csi erase in line", []string{"abcdef\r\x1b[Kxy\n"}, []string{"xy", ""}),
+		Entry("csi split across writes", []string{"a\x1b[3", "2mb\n"}, []string{"ab", ""}),
+		Entry("osc terminated by bel", []string{"\x1b]0;title\aprompt$ "}, []string{"prompt$ "}),
+		Entry("osc terminated by st", []string{"\x1b]2;title\x1b\\prompt"}, []string{"prompt"}),
+		Entry("utf-8 rune split across writes", []string{"caf\xc3", "\xa9 \xe2\x82", "\xac\n"}, []string{"café €", ""}),
+		Entry("carriage return overwrite", []string{"progress 10%\rprogress 99%\n"}, []string{"progress 99%", ""}),
+		Entry("carriage return partial overwrite", []string{"abcdef\rXY\n"}, []string{"XYcdef", ""}),
+		Entry("backspace and tab", []string{"ab\bc\td\n"}, []string{"ac      d", ""}),
+		Entry("trailing spaces trimmed", []string{"text   \n"}, []string{"text", ""}),
+	)
+
+	It("reset", func() {
+		sb := newScrollback()
+		sb.Write([]byte("line\n\x1b[")) //nolint:errcheck
+		sb.Reset()
+		sb.Write([]byte("mnew")) //nolint:errcheck
+		Expect(sb.Lines()).To(Equal([]string{"mnew"}))
+	})
+
+	It("limit buffered lines", func() {
+		sb := newScrollback()
+
+		for range scrollbackMaxLines + 10 {
+			sb.Write([]byte("line\n")) //nolint:errcheck
+		}
+
+		Expect(sb.Lines()).To(HaveLen(scrollbackMaxLines + 1))
+	})
+})
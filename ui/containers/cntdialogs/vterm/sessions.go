@@ -0,0 +1,310 @@
+package vterm
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+// VtermSessionsDialog manages multiple terminal sessions and displays them as tabs.
+// Sessions can be sent to background and stay alive until they are closed.
+type VtermSessionsDialog struct {
+	*tview.Box
+
+	sessions           []*VtermDialog
+	active             int
+	display            bool
+	lock               sync.Mutex
+	fastRefreshHandler func()
+}
+
+// NewVtermSessionsDialog returns new VtermSessionsDialog primitive.
+func NewVtermSessionsDialog() *VtermSessionsDialog {
+	return &VtermSessionsDialog{
+		Box: tview.NewBox(),
+	}
+}
+
+// NewSession creates a new terminal session, adds it as a new tab
+// and make it the active one.
+func (d *VtermSessionsDialog) NewSession() *VtermDialog {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	session := NewVtermDialog()
+	session.SetFastRefreshHandler(d.fastRefreshHandler)
+	session.SetBackgroundFunc(d.Hide)
+	session.SetCancelFunc(func() {
+		d.closeSession(session)
+	})
+
+	d.sessions = append(d.sessions, session)
+	d.active = len(d.sessions) - 1
+
+	return session
+}
+
+// Exec creates a container exec session and runs it in a new terminal tab.
+// The terminal size is calculated from the size of the area the sessions are displayed in.
+func (d *VtermSessionsDialog) Exec(cntID string, cntName string, execOpts containers.ExecOption, width int, height int) error {
+	execOpts.TtyWidth = width - (2 * dialogs.DialogPadding) - 6                                      //nolint:mnd
+	execOpts.TtyHeight = height - (2 * (dialogs.DialogPadding - 1)) - 2*dialogs.DialogFormHeight - 4 //nolint:mnd
+	execOpts.DetachKeys = vtermDefaultDetachKeys
+
+	execSessionID, err := containers.NewExecSession(cntID, execOpts)
+	if err != nil {
+		return err
+	}
+
+	terminal := d.NewSession()
+	execOpts.InputStream, execOpts.OutputStream = terminal.InitExecChannels()
+
+	terminal.SetContainerInfo(cntID, cntName)
+
+	prepareAndExec := func() {
+		terminal.SetSessionID(execSessionID)
+		containers.Exec(execSessionID, execOpts)
+		terminal.SetAlreadyDetach(true)
+	}
+
+	go prepareAndExec()
+
+	terminal.Display()
+	d.Display()
+
+	return nil
+}
+
+// SessionCount returns number of sessions.
+func (d *VtermSessionsDialog) SessionCount() int {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	return len(d.sessions)
+}
+
+// Display displays this primitive.
+func (d *VtermSessionsDialog) Display() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if len(d.sessions) == 0 {
+		return
+	}
+
+	d.display = true
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *VtermSessionsDialog) IsDisplay() bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	return d.display
+}
+
+// Hide stops displaying this primitive, the sessions remain active in background.
+func (d *VtermSessionsDialog) Hide() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.display = false
+}
+
+// CloseAll closes all the sessions and their exec connections.
+func (d *VtermSessionsDialog) CloseAll() {
+	d.lock.Lock()
+	sessions := d.sessions
+	d.sessions = nil
+	d.active = 0
+	d.display = false
+	d.lock.Unlock()
+
+	for _, session := range sessions {
+		session.Hide()
+	}
+}
+
+// HasFocus returns true if active session has focus.
+func (d *VtermSessionsDialog) HasFocus() bool {
+	if session := d.activeSession(); session != nil && session.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *VtermSessionsDialog) Focus(delegate func(p tview.Primitive)) {
+	if session := d.activeSession(); session != nil {
+		delegate(session)
+	}
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *VtermSessionsDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("view: terminal sessions dialog event %v received", event)
+
+		// alt+left, alt+right and alt+[1-9] switch between the sessions
+		if event.Modifiers()&tcell.ModAlt != 0 {
+			switch {
+			case event.Key() == tcell.KeyLeft:
+				d.switchSession(d.activeIndex() - 1)
+
+				return
+			case event.Key() == tcell.KeyRight:
+				d.switchSession(d.activeIndex() + 1)
+
+				return
+			case event.Key() == tcell.KeyRune && event.Rune() >= '1' && event.Rune() <= '9':
+				d.switchSession(int(event.Rune() - '1'))
+
+				return
+			}
+		}
+
+		if session := d.activeSession(); session != nil {
+			if handler := session.InputHandler(); handler != nil {
+				handler(event, setFocus)
+			}
+		}
+	})
+}
+
+// SetRect set rects for this primitive.
+func (d *VtermSessionsDialog) SetRect(x, y, width, height int) {
+	d.Box.SetRect(x, y, width, height)
+
+	if session := d.activeSession(); session != nil {
+		session.SetRect(x, y, width, height)
+	}
+}
+
+// Draw draws this primitive onto the screen.
+func (d *VtermSessionsDialog) Draw(screen tcell.Screen) {
+	if !d.IsDisplay() {
+		return
+	}
+
+	session := d.activeSession()
+	if session == nil {
+		return
+	}
+
+	session.Draw(screen)
+
+	// tabs bar
+	x, y, width, _ := d.GetRect()
+	tabX := x + 1
+	activeStyle := tcell.StyleDefault.Background(style.DialogBorderColor).Foreground(style.DialogFgColor).Bold(true)
+	inactiveStyle := tcell.StyleDefault.Background(style.DialogBgColor).Foreground(style.DialogFgColor)
+
+	active := d.activeIndex()
+
+	for index, label := range d.tabLabels() {
+		tabStyle := inactiveStyle
+		if index == active {
+			tabStyle = activeStyle
+		}
+
+		for _, ch := range label {
+			if tabX >= x+width-1 {
+				return
+			}
+
+			screen.SetContent(tabX, y, ch, nil, tabStyle)
+			tabX++
+		}
+
+		tabX++
+	}
+}
+
+// SetFastRefreshHandler sets fast refresh handler for the sessions.
+func (d *VtermSessionsDialog) SetFastRefreshHandler(handler func()) {
+	d.fastRefreshHandler = handler
+}
+
+func (d *VtermSessionsDialog) activeSession() *VtermDialog {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.active < 0 || d.active >= len(d.sessions) {
+		return nil
+	}
+
+	return d.sessions[d.active]
+}
+
+func (d *VtermSessionsDialog) activeIndex() int {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	return d.active
+}
+
+func (d *VtermSessionsDialog) switchSession(index int) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if index < 0 || index >= len(d.sessions) {
+		return
+	}
+
+	d.active = index
+}
+
+func (d *VtermSessionsDialog) closeSession(session *VtermDialog) {
+	session.Hide()
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	for index := range d.sessions {
+		if d.sessions[index] == session {
+			d.sessions = append(d.sessions[:index], d.sessions[index+1:]...)
+
+			break
+		}
+	}
+
+	if d.active >= len(d.sessions) {
+		d.active = len(d.sessions) - 1
+	}
+
+	if len(d.sessions) == 0 {
+		d.active = 0
+		d.display = false
+	}
+}
+
+func (d *VtermSessionsDialog) tabLabels() []string {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	labels := make([]string, 0, len(d.sessions))
+
+	for index, session := range d.sessions {
+		name := session.ContainerName()
+		if name == "" && len(session.ContainerID()) > utils.IDLength {
+			name = session.ContainerID()[0:utils.IDLength]
+		}
+
+		status := ""
+		if session.IsAlreadyDetach() {
+			status = " (exited)"
+		}
+
+		labels = append(labels, fmt.Sprintf(" %d:%s%s ", index+1, name, status))
+	}
+
+	return labels
+}
@@ -0,0 +1,131 @@
+package vterm
+
+import (
+	"io"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+)
+
+var _ = Describe("terminal sessions", func() {
+	var sessions *VtermSessionsDialog
+
+	BeforeEach(func() {
+		sessions = NewVtermSessionsDialog()
+		sessions.SetFastRefreshHandler(func() {})
+	})
+
+	It("exec with failing session", func() {
+		execErr := make(chan error, 1)
+
+		// there is no connection selected, exec session creation fails
+		go func() {
+			execErr <- sessions.Exec("cnt01", "cnt01_name", containers.ExecOption{}, 80, 24)
+		}()
+
+		Eventually(execErr).Should(Receive(HaveOccurred()))
+		Expect(sessions.SessionCount()).To(Equal(0))
+		Expect(sessions.IsDisplay()).To(BeFalse())
+	})
+
+	It("hide not initialized session", func() {
+		session := NewVtermDialog()
+		hidden := make(chan bool, 1)
+
+		go func() {
+			session.Hide()
+			hidden <- true
+		}()
+
+		Eventually(hidden).Should(Receive())
+		Expect(session.IsDisplay()).To(BeFalse())
+	})
+
+	It("add sessions", func() {
+		first := sessions.NewSession()
+		second := sessions.NewSession()
+		Expect(sessions.SessionCount()).To(Equal(2))
+		Expect(sessions.activeSession()).To(Equal(second))
+		Expect(sessions.activeSession()).NotTo(Equal(first))
+
+		sessions.Display()
+		Expect(sessions.IsDisplay()).To(BeTrue())
+
+		sessions.Hide()
+		Expect(sessions.IsDisplay()).To(BeFalse())
+		Expect(sessions.SessionCount()).To(Equal(2))
+	})
+
+	It("switch session", func() {
+		first := sessions.NewSession()
+		second := sessions.NewSession()
+		handler := sessions.InputHandler()
+		setFocus := func(_ tview.Primitive) {}
+
+		handler(tcell.NewEventKey(tcell.KeyRune, '1', tcell.ModAlt), setFocus)
+		Expect(sessions.activeSession()).To(Equal(first))
+
+		handler(tcell.NewEventKey(tcell.KeyLeft, 0, tcell.ModAlt), setFocus)
+		Expect(sessions.activeSession()).To(Equal(first))
+
+		handler(tcell.NewEventKey(tcell.KeyRight, 0, tcell.ModAlt), setFocus)
+		Expect(sessions.activeSession()).To(Equal(second))
+
+		handler(tcell.NewEventKey(tcell.KeyRune, '9', tcell.ModAlt), setFocus)
+		Expect(sessions.activeSession()).To(Equal(second))
+	})
+
+	It("close session", func() {
+		first := sessions.NewSession()
+		second := sessions.NewSession()
+		sessions.Display()
+
+		second.cancelHandler()
+		Expect(sessions.SessionCount()).To(Equal(1))
+		Expect(sessions.activeSession()).To(Equal(first))
+		Expect(sessions.IsDisplay()).To(BeTrue())
+
+		first.cancelHandler()
+		Expect(sessions.SessionCount()).To(Equal(0))
+		Expect(sessions.activeSession()).To(BeNil())
+		Expect(sessions.IsDisplay()).To(BeFalse())
+	})
+
+	It("close all", func() {
+		stdinClosed := make(chan []byte, 2)
+		execStdouts := make([]io.Writer, 0, 2)
+
+		for range 2 {
+			session := sessions.NewSession()
+			stdin, stdout := session.InitExecChannels()
+			execStdouts = append(execStdouts, stdout)
+
+			// the exec session reads stdin until it is closed
+			go func() {
+				data, _ := io.ReadAll(stdin)
+				stdinClosed <- data
+			}()
+		}
+
+		sessions.Display()
+		sessions.CloseAll()
+
+		Expect(sessions.SessionCount()).To(Equal(0))
+		Expect(sessions.IsDisplay()).To(BeFalse())
+
+		for range 2 {
+			var data []byte
+
+			Eventually(stdinClosed).Should(Receive(&data))
+			Expect(data).To(Equal([]byte{byte(tcell.KeyCtrlP), byte(tcell.KeyCtrlQ), byte(tcell.KeyCtrlP)}))
+		}
+
+		for _, stdout := range execStdouts {
+			_, err := stdout.Write([]byte("data"))
+			Expect(err).To(HaveOccurred())
+		}
+	})
+})
@@ -30,7 +30,19 @@ const (
 	sessionModeExec
 )
 
-const vTermDialogLabelPadding = 1
+const (
+	vTermDialogLabelPadding = 1
+	vtermDefaultDetachKeys  = "ctrl-p,ctrl-q,ctrl-p"
+)
+
+const (
+	vtermCopyModeButtonLabel   = "Copy mode"
+	vtermRecordButtonLabel     = "Record"
+	vtermStopRecordButtonLabel = "Stop recording"
+	vtermBackgroundButtonLabel = "Background"
+	vtermRecordButtonIndex     = 1
+	vtermCopyModeKey           = tcell.KeyCtrlRightSq
+)
 
 // VtermDialog implements virtual terminal that can be used during
 // exec, attach, run activity.
@@ -47,6 +59,7 @@ type VtermDialog struct {
 	sessionStdout         Writer
 	execSessionStdout     channel.WriteCloser
 	sessionStdinWriter    *bufio.Writer
+	sessionStdinPipe      *io.PipeWriter
 	vtTerminal            vt10x.Terminal
 	vtTermBuffer          *bufio.Reader
 	vtTermPipeWriter      *io.PipeWriter
@@ -54,7 +67,13 @@ type VtermDialog struct {
 	display               bool
 	sessionOutputDoneChan chan bool
 	containerID           string
+	containerName         string
 	sessionID             string
+	sessionTitle          string
+	scrollback            *scrollback
+	copyMode              copyMode
+	recorder              *recorder
+	recorderLock          sync.Mutex
 	focusElement          int
 	init                  bool
 	ttyWidth              int
@@ -62,6 +81,7 @@ type VtermDialog struct {
 	alreadyDetached       bool
 	alreadyDetachedLock   sync.Mutex
 	cancelHandler         func()
+	backgroundHandler     func()
 	fastRefreshHandler    func()
 }
 
@@ -73,9 +93,10 @@ func NewVtermDialog() *VtermDialog {
 		form:                  tview.NewForm(),
 		containerInfo:         tview.NewInputField(),
 		termScreen:            tview.NewBox(),
+		scrollback:            newScrollback(),
 		sessionOutputDoneChan: make(chan bool),
 		detachKeys: termDetachKeys{
-			keyString: "ctrl-p,ctrl-q,ctrl-p",
+			keyString: vtermDefaultDetachKeys,
 			tcellKeys: []tcell.Key{
 				tcell.KeyCtrlP, tcell.KeyCtrlQ, tcell.KeyCtrlP,
 			},
@@ -173,26 +194,44 @@ func (d *VtermDialog) IsDisplay() bool {
 func (d *VtermDialog) Hide() {
 	d.display = false
 	d.containerID = ""
+	d.containerName = ""
 	d.sessionID = ""
+	d.sessionTitle = ""
 	d.termScreen.SetTitle("")
 	d.focusElement = vtermDialogScreenFieldFocus
 
+	d.copyMode.exit()
+	d.stopRecording()
+
+	// nothing to stop if the session channels have not been initialized
+	if !d.init {
+		return
+	}
+
+	d.init = false
 	d.sessionOutputDoneChan <- true
 
+	sessionMode := d.sessionMode
 	d.sessionMode = sessionModeNone
 
 	if !d.IsAlreadyDetach() {
 		d.sendDetachToSession()
 	}
 
-	if d.sessionMode == sessionModeExec {
+	// closing the session stdin ends the exec or attach connection
+	err := d.sessionStdinPipe.Close()
+	if err != nil {
+		log.Error().Msgf("failed to close vterm stdin session: %s", err.Error())
+	}
+
+	if sessionMode == sessionModeExec {
 		err := d.execSessionStdout.Close()
 		if err != nil {
 			log.Error().Msgf("failed to close vterm exec stdout session: %s", err.Error())
 		}
 	}
 
-	err := d.vtTermPipeReader.Close()
+	err = d.vtTermPipeReader.Close()
 	if err != nil {
 		log.Error().Msgf("failed to close vterm pipe reader: %s", err.Error())
 	}
@@ -267,6 +306,18 @@ func (d *VtermDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p
 			if handler := d.termScreen.InputHandler(); handler != nil {
 				handler(event, setFocus)
 
+				if d.copyMode.active {
+					d.copyMode.handleKey(event, d.ttyHeight-1)
+
+					return
+				}
+
+				if event.Key() == vtermCopyModeKey {
+					d.enterCopyMode()
+
+					return
+				}
+
 				if !d.IsAlreadyDetach() {
 					d.writeToSession(event)
 				}
@@ -279,6 +330,11 @@ func (d *VtermDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p
 			if handler := d.form.InputHandler(); handler != nil {
 				handler(event, setFocus)
 
+				// copy mode button moves the focus back to the terminal screen
+				if d.copyMode.active && d.focusElement == vtermDialogScreenFieldFocus {
+					d.Focus(setFocus)
+				}
+
 				return
 			}
 		}
@@ -322,6 +378,16 @@ func (d *VtermDialog) Draw(screen tcell.Screen) {
 		}
 	}
 
+	if data := d.copyMode.takeClipboard(); len(data) > 0 {
+		screen.SetClipboard(data)
+	}
+
+	if d.copyMode.active {
+		d.copyMode.draw(screen, x, y, width, height, terminalStyle)
+
+		return
+	}
+
 	content, cursor := d.vtContent()
 
 	contentLines := strings.Split(content, "\n")
@@ -349,6 +415,7 @@ func (d *VtermDialog) SetCancelFunc(handler func()) *VtermDialog {
 // SetContainerInfo sets container's ID and NAME to the terminal header.
 func (d *VtermDialog) SetContainerInfo(id string, name string) {
 	d.containerID = id
+	d.containerName = name
 	containerInfo := id[0:12]
 
 	if name != "" {
@@ -367,8 +434,98 @@ func (d *VtermDialog) SetSessionID(id string) {
 		id = id[0:utils.IDLength]
 	}
 
-	sessionIDLabel := fmt.Sprintf("TERMINAL SESSION (%s)", id)
-	d.termScreen.SetTitle(sessionIDLabel)
+	d.sessionTitle = fmt.Sprintf("TERMINAL SESSION (%s)", id)
+	d.updateTitle("")
+}
+
+// IsRecording returns true if the session output is being recorded.
+func (d *VtermDialog) IsRecording() bool {
+	d.recorderLock.Lock()
+	defer d.recorderLock.Unlock()
+
+	return d.recorder != nil
+}
+
+func (d *VtermDialog) updateTitle(info string) {
+	title := d.sessionTitle
+
+	if info != "" {
+		title = fmt.Sprintf("%s - %s", title, info)
+	}
+
+	d.termScreen.SetTitle(title)
+}
+
+func (d *VtermDialog) enterCopyMode() {
+	log.Debug().Msg("view: container terminal dialog entering copy mode")
+
+	d.copyMode.enter(d.scrollback.Lines())
+}
+
+func (d *VtermDialog) toggleRecording() {
+	if d.IsRecording() {
+		d.stopRecording()
+
+		return
+	}
+
+	name := d.containerName
+	if name == "" && len(d.containerID) > utils.IDLength {
+		name = d.containerID[0:utils.IDLength]
+	}
+
+	rec, err := newRecorder(name, d.ttyWidth, d.ttyHeight)
+	if err != nil {
+		log.Error().Msgf("view: container terminal dialog failed to start recording: %s", err.Error())
+		d.updateTitle("recording failed: " + err.Error())
+
+		return
+	}
+
+	d.recorderLock.Lock()
+	d.recorder = rec
+	d.recorderLock.Unlock()
+
+	log.Debug().Msgf("view: container terminal dialog recording to %s", rec.Path())
+	d.form.GetButton(vtermRecordButtonIndex).SetLabel(vtermStopRecordButtonLabel)
+	d.updateTitle("REC " + rec.Path())
+}
+
+func (d *VtermDialog) stopRecording() {
+	d.recorderLock.Lock()
+	defer d.recorderLock.Unlock()
+
+	d.form.GetButton(vtermRecordButtonIndex).SetLabel(vtermRecordButtonLabel)
+
+	if d.recorder == nil {
+		return
+	}
+
+	path := d.recorder.Path()
+
+	if err := d.recorder.Close(); err != nil {
+		log.Error().Msgf("view: container terminal dialog failed to close recording: %s", err.Error())
+	}
+
+	d.recorder = nil
+
+	d.updateTitle("saved " + path)
+}
+
+// sessionOutput writes session output to scrollback buffer and recorder (if active).
+func (d *VtermDialog) sessionOutput(data []byte) {
+	d.scrollback.Write(data) //nolint:errcheck,gosec
+
+	d.recorderLock.Lock()
+	defer d.recorderLock.Unlock()
+
+	if d.recorder == nil {
+		return
+	}
+
+	if _, err := d.recorder.Write(data); err != nil {
+		log.Error().Msgf("view: container terminal dialog failed to write recording: %s", err.Error())
+	}
 }
 
 // DetachKeys returns the detach keys used to detach from the session.
@@ -376,6 +533,32 @@ func (d *VtermDialog) DetachKeys() string {
 	return d.detachKeys.string()
 }
 
+// SetBackgroundFunc sets form background button selected function.
+// The background button hides the terminal without closing its session.
+func (d *VtermDialog) SetBackgroundFunc(handler func()) *VtermDialog {
+	d.backgroundHandler = handler
+
+	d.form.ClearButtons()
+	d.addFormButtons()
+
+	return d
+}
+
+// ContainerID returns terminal session container's ID.
+func (d *VtermDialog) ContainerID() string {
+	return d.containerID
+}
+
+// ContainerName returns terminal session container's name.
+func (d *VtermDialog) ContainerName() string {
+	return d.containerName
+}
+
+// SessionID returns terminal session ID.
+func (d *VtermDialog) SessionID() string {
+	return d.sessionID
+}
+
 // SetFastRefreshHandler sets fast refresh handler
 // fast refresh is used to print the outputs as fast as possible.
 func (d *VtermDialog) SetFastRefreshHandler(handler func()) {
@@ -490,6 +673,8 @@ func (d *VtermDialog) execSessionOutputStreamer() {
 				log.Error().Msgf("failed to write %s to vterm pipe writer: %s", data, err.Error())
 			}
 
+			d.sessionOutput(data)
+
 			d.fastRefreshHandler()
 		}
 	}
@@ -513,6 +698,8 @@ func (d *VtermDialog) sessionOutputStreamer() {
 				log.Error().Msgf("failed to write %s to vterm pipe writer: %s", []byte(dataString), err.Error())
 			}
 
+			d.sessionOutput([]byte(dataString))
+
 			d.fastRefreshHandler()
 		}
 	}
@@ -547,8 +734,8 @@ func (d *VtermDialog) initLayoutUI() {
 		Foreground(style.DialogFgColor))
 
 	// form fields
-	d.form.AddButton("Cancel", nil).
-		SetButtonsAlign(tview.AlignRight)
+	d.addFormButtons()
+	d.form.SetButtonsAlign(tview.AlignRight)
 	d.form.SetBackgroundColor(bgColor)
 	d.form.SetButtonBackgroundColor(style.ButtonBgColor)
 
@@ -584,11 +771,30 @@ func (d *VtermDialog) initLayoutUI() {
 	d.layout.AddItem(d.form, dialogs.DialogFormHeight, 0, true)
 }
 
+func (d *VtermDialog) addFormButtons() {
+	d.form.AddButton(vtermCopyModeButtonLabel, func() {
+		d.focusElement = vtermDialogScreenFieldFocus
+		d.enterCopyMode()
+	})
+	d.form.AddButton(vtermRecordButtonLabel, d.toggleRecording)
+
+	if d.backgroundHandler != nil {
+		d.form.AddButton(vtermBackgroundButtonLabel, func() {
+			d.focusElement = vtermDialogScreenFieldFocus
+			d.backgroundHandler()
+		})
+	}
+
+	d.form.AddButton("Cancel", nil)
+}
+
 func (d *VtermDialog) initChannelsCommon() {
 	d.sessionOutputDoneChan = make(chan bool, 2) //nolint:mnd
 	d.vtTerminal = vt10x.New()
+	d.scrollback.Reset()
 	sessionStdinPipeIn, sessionStdinPipeOut := io.Pipe()
 	d.sessionStdin = bufio.NewReader(sessionStdinPipeIn)
+	d.sessionStdinPipe = sessionStdinPipeOut
 	d.sessionStdinWriter = bufio.NewWriter(sessionStdinPipeOut)
 
 	d.vtTermPipeReader, d.vtTermPipeWriter = io.Pipe()
@@ -0,0 +1,13 @@
+package vterm_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestVterm(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Container Terminal Suite")
+}
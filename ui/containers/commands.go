@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/containers/podman-tui/config"
 	"github.com/containers/podman-tui/pdcs/containers"
 	"github.com/containers/podman-tui/pdcs/pods"
+	"github.com/containers/podman-tui/ui/containers/cntdialogs"
 	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/containers/podman-tui/ui/style"
 	"github.com/containers/podman-tui/ui/utils"
@@ -17,18 +19,31 @@ func (cnt *Containers) runCommand(cmd string) { //nolint:cyclop
 	switch cmd {
 	case "attach":
 		cnt.attach()
+	case "auto-update":
+		cnt.autoUpdateList()
 	case "checkpoint":
 		cnt.preCheckpoint()
+	case "clone":
+		cnt.preClone()
 	case "commit":
 		cnt.preCommit()
+	case "compare":
+		cnt.ccompare()
 	case "create":
+		cnt.presetName = ""
 		cnt.createDialog.Display()
 	case "diff":
 		cnt.diff()
 	case "exec":
 		cnt.cexec()
+	case "exec sessions":
+		cnt.execSessionsList()
+	case "go to":
+		cnt.references()
 	case "healthcheck":
 		cnt.preHealthcheck()
+	case "import run":
+		cnt.runImportDialog.Display()
 	case "inspect":
 		cnt.inspect()
 	case "kill":
@@ -39,6 +54,8 @@ func (cnt *Containers) runCommand(cmd string) { //nolint:cyclop
 		cnt.pause()
 	case utils.PruneCommandLabel:
 		cnt.cprune()
+	case "recreate":
+		cnt.preRecreate()
 	case "rename":
 		cnt.rename()
 	case "restore":
@@ -48,17 +65,26 @@ func (cnt *Containers) runCommand(cmd string) { //nolint:cyclop
 	case "rm":
 		cnt.rm()
 	case "run":
+		cnt.presetName = ""
 		cnt.runDialog.Display()
+	case "run command":
+		cnt.showRunCommand()
 	case "start":
 		cnt.start()
 	case "stats":
 		cnt.stats()
 	case "stop":
 		cnt.stop()
+	case "terminals":
+		cnt.terminals()
 	case "top":
 		cnt.top()
 	case "unpause":
 		cnt.unpause()
+	case "upgrade":
+		cnt.preUpgrade()
+	case "upgrade rollback":
+		cnt.preUpgradeRollback()
 	}
 }
 
@@ -317,6 +343,170 @@ func (cnt *Containers) commit() {
 	go cntCommit()
 }
 
+func (cnt *Containers) ccompare() {
+	if cnt.selectedID == "" {
+		cnt.displayError("", errNoContainerCompare)
+
+		return
+	}
+
+	cntID, cntName := cnt.getSelectedItem()
+	items := make([][]string, 0)
+
+	for _, container := range cnt.getData() {
+		if strings.HasPrefix(container.ID, cntID) {
+			continue
+		}
+
+		name := ""
+		if len(container.Names) > 0 {
+			name = container.Names[0]
+		}
+
+		items = append(items, []string{container.ID, name})
+	}
+
+	if len(items) == 0 {
+		cnt.displayError("", errNoContainerCompareTo)
+
+		return
+	}
+
+	cnt.compareDialog.SetLeftItem(cntID, cntName)
+	cnt.compareDialog.SetRightItems(items)
+	cnt.compareDialog.Display()
+}
+
+func (cnt *Containers) compare() {
+	rightID, _ := cnt.compareDialog.GetRightItem()
+	if rightID == "" {
+		return
+	}
+
+	cntID := cnt.selectedID
+
+	cnt.progressDialog.SetTitle("container compare in progress")
+	cnt.progressDialog.Display()
+
+	compareFunc := func() {
+		report, err := containers.Compare(cntID, rightID)
+
+		cnt.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("CONTAINER (%s) COMPARE ERROR", cntID)
+
+			cnt.displayError(title, err)
+			cnt.appFocusHandler()
+
+			return
+		}
+
+		cnt.compareDialog.SetResults(report)
+		cnt.appFocusHandler()
+	}
+
+	go compareFunc()
+}
+
+func (cnt *Containers) preClone() {
+	if cnt.selectedID == "" {
+		cnt.displayError("", errNoContainerClone)
+
+		return
+	}
+
+	cntID, cntName := cnt.getSelectedItem()
+
+	cnt.progressDialog.SetTitle("podman container clone")
+	cnt.progressDialog.Display()
+
+	initData := func() {
+		createOpts, _, err := containers.ContainerCreateOptions(cntID)
+
+		cnt.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("CONTAINER (%s) CLONE ERROR", cntID)
+
+			cnt.displayError(title, err)
+			cnt.appFocusHandler()
+
+			return
+		}
+
+		cloneOpts := containers.CloneOptions{
+			Name:   cntName + "-clone",
+			Image:  createOpts.Image,
+			CPUs:   createOpts.CPUs,
+			Memory: createOpts.Memory,
+		}
+
+		for _, cntItem := range cnt.getData() {
+			if strings.HasPrefix(cntItem.ID, cntID) {
+				cloneOpts.Pod = cntItem.PodName
+
+				break
+			}
+		}
+
+		cnt.cloneDialog.SetContainerInfo(cntID, cntName)
+		cnt.cloneDialog.SetCloneOptions(cloneOpts)
+		cnt.cloneDialog.Display()
+		cnt.appFocusHandler()
+	}
+
+	go initData()
+}
+
+func (cnt *Containers) clone() {
+	cloneOpts := cnt.cloneDialog.GetCloneOptions()
+	if cloneOpts.Image == "" {
+		cnt.displayError("CONTAINER CLONE ERROR", errEmptyContainerImageName)
+
+		return
+	}
+
+	cnt.cloneDialog.Hide()
+	cnt.progressDialog.SetTitle("container clone in progress")
+	cnt.progressDialog.Display()
+
+	cntClone := func(id string, name string) {
+		warnings, cloneID, err := containers.Clone(id, cloneOpts)
+
+		cnt.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("CONTAINER (%s) CLONE ERROR", id)
+
+			cnt.displayError(title, err)
+			cnt.appFocusHandler()
+
+			return
+		}
+
+		// empty clone name defaults to the original container name with -clone suffix
+		cloneName := cloneOpts.Name
+		if cloneName == "" {
+			cloneName = name + "-clone"
+		}
+
+		headerLabel := fmt.Sprintf("%s (%s)", id, name)
+		report := fmt.Sprintf("container cloned: %s (%s)", utils.GetIDWithLimit(cloneID), cloneName)
+
+		if len(warnings) > 0 {
+			report = fmt.Sprintf("%s\n\n%s", report, strings.Join(warnings, "\n"))
+		}
+
+		cnt.messageDialog.SetTitle("podman container clone")
+		cnt.messageDialog.SetText(dialogs.MessageContainerInfo, headerLabel, report)
+		cnt.messageDialog.Display()
+		cnt.appFocusHandler()
+	}
+
+	go cntClone(cnt.selectedID, cnt.selectedName)
+}
+
 func (cnt *Containers) stats() {
 	if cnt.selectedID == "" {
 		cnt.displayError("", errNoContainerStat)
@@ -375,36 +565,125 @@ func (cnt *Containers) exec() {
 	cntID, cntName := cnt.getSelectedItem()
 	_, _, width, height := cnt.table.GetInnerRect()
 
-	width = width - (2 * dialogs.DialogPadding) - 6                                      //nolint:mnd
-	height = height - (2 * (dialogs.DialogPadding - 1)) - 2*dialogs.DialogFormHeight - 4 //nolint:mnd
+	// every exec session runs in its own terminal tab
+	err := cnt.execSessions.Exec(cntID, cntName, cnt.execDialog.ContainerExecOptions(), width, height)
+	if err != nil {
+		title := fmt.Sprintf("CONTAINER (%s) EXEC ERROR", cnt.selectedID)
 
-	execOpts := cnt.execDialog.ContainerExecOptions()
-	execOpts.TtyWidth = width
-	execOpts.TtyHeight = height
+		cnt.displayError(title, err)
+	}
+}
 
-	execOpts.InputStream, execOpts.OutputStream = cnt.terminalDialog.InitExecChannels()
-	execOpts.DetachKeys = cnt.terminalDialog.DetachKeys()
+func (cnt *Containers) terminals() {
+	if cnt.execSessions.SessionCount() == 0 {
+		cnt.displayError("", errNoTerminalSessions)
 
-	cnt.terminalDialog.SetContainerInfo(cntID, cntName)
+		return
+	}
 
-	execSessionID, err := containers.NewExecSession(cnt.selectedID, execOpts)
-	if err != nil {
-		title := fmt.Sprintf("CONTAINER (%s) EXEC ERROR", cnt.selectedID)
+	cnt.execSessions.Display()
+}
 
-		cnt.displayError(title, err)
+func (cnt *Containers) execSessionsList() {
+	cntID, cntName := cnt.getSelectedItem()
+	if cntID == "" {
+		cnt.displayError("", errNoContainerExecSessions)
 
 		return
 	}
 
-	prepareAndExec := func() {
-		cnt.terminalDialog.SetSessionID(execSessionID)
-		containers.Exec(execSessionID, execOpts)
-		cnt.terminalDialog.SetAlreadyDetach(true)
+	cnt.progressDialog.SetTitle("container exec sessions in progress")
+	cnt.progressDialog.Display()
+
+	go func() {
+		reports, err := containers.ExecSessions(cntID)
+
+		cnt.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("CONTAINER (%s) EXEC SESSIONS ERROR", cntID)
+
+			cnt.displayError(title, err)
+			cnt.appFocusHandler()
+
+			return
+		}
+
+		headerLabel := fmt.Sprintf("%s (%s)", cntID, cntName)
+
+		cnt.messageDialog.SetTitle("podman container exec sessions")
+		cnt.messageDialog.SetText(dialogs.MessageContainerInfo, headerLabel, execSessionsReportText(reports))
+		cnt.messageDialog.Display()
+		cnt.appFocusHandler()
+	}()
+}
+
+func execSessionsReportText(reports []containers.ExecSessionReport) string {
+	if len(reports) == 0 {
+		return "no exec sessions"
+	}
+
+	lines := make([]string, 0, len(reports))
+
+	for _, report := range reports {
+		sessionID := report.ID
+		if len(sessionID) > utils.IDLength {
+			sessionID = sessionID[0:utils.IDLength]
+		}
+
+		status := fmt.Sprintf("exited (%d)", report.ExitCode)
+		if report.Running {
+			status = fmt.Sprintf("running (pid %d)", report.Pid)
+		}
+
+		lines = append(lines, fmt.Sprintf("%s  %-18s  %s", sessionID, status, report.Command))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (cnt *Containers) references() {
+	cntID, cntName := cnt.getSelectedItem()
+	if cntID == "" {
+		cnt.displayError("", errNoContainerGoTo)
+
+		return
 	}
 
-	go prepareAndExec()
+	cnt.progressDialog.SetTitle("container references in progress")
+	cnt.progressDialog.Display()
+
+	go func() {
+		refs, err := containers.References(cntID)
+
+		cnt.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("CONTAINER (%s) REFERENCES ERROR", cntID)
+
+			cnt.displayError(title, err)
+			cnt.appFocusHandler()
+
+			return
+		}
+
+		cnt.navigateDialog.SetResourceInfo("container id", cntID, cntName)
+		cnt.navigateDialog.SetReferences(refs)
+		cnt.navigateDialog.Display()
+		cnt.appFocusHandler()
+	}()
+}
+
+func (cnt *Containers) goTo() {
+	ref, ok := cnt.navigateDialog.GetSelectedReference()
+
+	cnt.navigateDialog.Hide()
 
-	cnt.terminalDialog.Display()
+	if !ok || cnt.navigateHandler == nil {
+		return
+	}
+
+	cnt.navigateHandler(ref.Kind, ref.Target())
 }
 
 func (cnt *Containers) run() {
@@ -415,6 +694,12 @@ func (cnt *Containers) run() {
 		return
 	}
 
+	if _, err := utils.SplitCommandLine(runOpts.Command); err != nil {
+		cnt.displayError("CONTAINER RUN ERROR", fmt.Errorf("%w: %s", err, runOpts.Command))
+
+		return
+	}
+
 	cnt.progressDialog.SetTitle("container run in progress")
 	cnt.progressDialog.Display()
 
@@ -547,6 +832,240 @@ func (cnt *Containers) runAttach(runOpts containers.CreateOptions) {
 	go run()
 }
 
+func (cnt *Containers) showRunCommand() {
+	if cnt.selectedID == "" {
+		cnt.displayError("", errNoContainerRunCommand)
+
+		return
+	}
+
+	cntID, cntName := cnt.getSelectedItem()
+
+	cnt.progressDialog.SetTitle("podman container run command")
+	cnt.progressDialog.Display()
+
+	generate := func() {
+		runCmd, err := containers.RunCommand(cntID)
+
+		cnt.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("CONTAINER (%s) RUN COMMAND ERROR", cntID)
+
+			cnt.displayError(title, err)
+			cnt.appFocusHandler()
+
+			return
+		}
+
+		cnt.runCommandDialog.SetContainerInfo(cntID, cntName)
+		cnt.runCommandDialog.SetRunCommand(runCmd)
+		cnt.runCommandDialog.Display()
+		cnt.appFocusHandler()
+	}
+
+	go generate()
+}
+
+func (cnt *Containers) preUpgrade() {
+	if cnt.selectedID == "" {
+		cnt.displayError("", errNoContainerUpgrade)
+
+		return
+	}
+
+	cntID, cntName := cnt.getSelectedItem()
+	imageRef := ""
+
+	for _, cntItem := range cnt.getData() {
+		if strings.HasPrefix(cntItem.ID, cntID) {
+			imageRef = cntItem.Image
+
+			break
+		}
+	}
+
+	cnt.upgradeDialog.SetContainerInfo(cntID, cntName, imageRef)
+	cnt.upgradeDialog.Display()
+}
+
+func (cnt *Containers) preUpgradeRollback() {
+	cntID, cntName := cnt.getSelectedItem()
+	if cntID == "" || cnt.lastUpgrade.ContainerID == "" || !strings.HasPrefix(cnt.lastUpgrade.ContainerID, cntID) {
+		cnt.displayError("", errNoUpgradeRollback)
+
+		return
+	}
+
+	cnt.upgradeDialog.SetContainerInfo(cntID, cntName, cnt.lastUpgrade.ImageRef)
+	cnt.upgradeDialog.Display()
+	cnt.upgradeDialog.SetUpgradeReport(cnt.lastUpgrade)
+	cnt.upgradeDialog.SetUpgraded(cnt.lastUpgrade.ContainerID)
+}
+
+func (cnt *Containers) upgradePull() {
+	cntID, _ := cnt.getSelectedItem()
+	imageRef := strings.TrimSpace(cnt.upgradeDialog.GetImageRef())
+
+	cnt.progressDialog.SetTitle("container image pull in progress")
+	cnt.progressDialog.Display()
+
+	pull := func() {
+		report, err := containers.UpgradeCheck(cntID, imageRef)
+
+		cnt.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("CONTAINER (%s) UPGRADE ERROR", cntID)
+
+			cnt.displayError(title, err)
+			cnt.appFocusHandler()
+
+			return
+		}
+
+		cnt.upgradeDialog.SetUpgradeReport(report)
+		cnt.appFocusHandler()
+	}
+
+	go pull()
+}
+
+func (cnt *Containers) upgrade() {
+	report := cnt.upgradeDialog.GetUpgradeReport()
+
+	cnt.progressDialog.SetTitle("container upgrade in progress")
+	cnt.progressDialog.Display()
+
+	upgrade := func() {
+		warnings, newID, err := containers.Upgrade(report)
+
+		cnt.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("CONTAINER (%s) UPGRADE ERROR", utils.GetIDWithLimit(report.ContainerID))
+
+			cnt.displayError(title, err)
+			cnt.appFocusHandler()
+
+			return
+		}
+
+		cnt.upgradeDialog.SetUpgraded(newID)
+		cnt.lastUpgrade = cnt.upgradeDialog.GetUpgradeReport()
+
+		if len(warnings) > 0 {
+			warnErr := fmt.Errorf("%w:\n%s", errUpgradeWarnings, strings.Join(warnings, "\n"))
+
+			cnt.displayError("CONTAINER UPGRADE WARNINGS", warnErr)
+		}
+
+		cnt.appFocusHandler()
+	}
+
+	go upgrade()
+}
+
+func (cnt *Containers) upgradeRollback() {
+	report := cnt.upgradeDialog.GetUpgradeReport()
+
+	cnt.progressDialog.SetTitle("container upgrade rollback in progress")
+	cnt.progressDialog.Display()
+
+	rollback := func() {
+		warnings, cntID, err := containers.UpgradeRollback(report.ContainerID, report)
+
+		cnt.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("CONTAINER (%s) UPGRADE ROLLBACK ERROR", utils.GetIDWithLimit(report.ContainerID))
+
+			cnt.displayError(title, err)
+			cnt.appFocusHandler()
+
+			return
+		}
+
+		cnt.upgradeDialog.SetRolledBack(cntID)
+		cnt.lastUpgrade = containers.UpgradeReport{}
+
+		if len(warnings) > 0 {
+			warnErr := fmt.Errorf("%w:\n%s", errUpgradeWarnings, strings.Join(warnings, "\n"))
+
+			cnt.displayError("CONTAINER UPGRADE ROLLBACK WARNINGS", warnErr)
+		}
+
+		cnt.appFocusHandler()
+	}
+
+	go rollback()
+}
+
+func (cnt *Containers) autoUpdateList() {
+	cnt.progressDialog.SetTitle("podman auto-update")
+	cnt.progressDialog.Display()
+
+	list := func() {
+		reports, err := containers.AutoUpdateList()
+
+		cnt.progressDialog.Hide()
+
+		if err != nil {
+			cnt.displayError("CONTAINER AUTO-UPDATE ERROR", err)
+			cnt.appFocusHandler()
+
+			return
+		}
+
+		cnt.autoUpdateDialog.SetReports(reports)
+		cnt.autoUpdateDialog.Display()
+		cnt.appFocusHandler()
+	}
+
+	go list()
+}
+
+func (cnt *Containers) autoUpdateDryRun() {
+	cnt.autoUpdate(nil, true)
+}
+
+func (cnt *Containers) autoUpdateApply() {
+	cntIDs := cnt.autoUpdateDialog.GetSelectedContainers()
+	if len(cntIDs) == 0 {
+		return
+	}
+
+	cnt.autoUpdate(cntIDs, false)
+}
+
+func (cnt *Containers) autoUpdate(cntIDs []string, dryRun bool) {
+	title := "podman auto-update in progress"
+	if dryRun {
+		title = "podman auto-update dry-run in progress"
+	}
+
+	cnt.progressDialog.SetTitle(title)
+	cnt.progressDialog.Display()
+
+	update := func() {
+		reports, err := containers.AutoUpdate(cntIDs, dryRun)
+
+		cnt.progressDialog.Hide()
+
+		if err != nil {
+			cnt.displayError("CONTAINER AUTO-UPDATE ERROR", err)
+			cnt.appFocusHandler()
+
+			return
+		}
+
+		cnt.autoUpdateDialog.UpdateReports(reports)
+		cnt.appFocusHandler()
+	}
+
+	go update()
+}
+
 func (cnt *Containers) create() {
 	createOpts := cnt.createDialog.ContainerCreateOptions()
 	if createOpts.Image == "" {
@@ -555,6 +1074,12 @@ func (cnt *Containers) create() {
 		return
 	}
 
+	if _, err := utils.SplitCommandLine(createOpts.Command); err != nil {
+		cnt.displayError("CONTAINER CREATE ERROR", fmt.Errorf("%w: %s", err, createOpts.Command))
+
+		return
+	}
+
 	cnt.progressDialog.SetTitle("container create in progress")
 	cnt.progressDialog.Display()
 
@@ -583,6 +1108,213 @@ func (cnt *Containers) create() {
 	go create()
 }
 
+func (cnt *Containers) preRecreate() {
+	if cnt.selectedID == "" {
+		cnt.displayError("", errNoContainerRecreate)
+
+		return
+	}
+
+	cntID, _ := cnt.getSelectedItem()
+
+	cnt.progressDialog.SetTitle("podman container recreate")
+	cnt.progressDialog.Display()
+
+	initData := func() {
+		createOpts, unmapped, err := containers.ContainerCreateOptions(cntID)
+
+		cnt.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("CONTAINER (%s) RECREATE ERROR", cntID)
+
+			cnt.displayError(title, err)
+			cnt.appFocusHandler()
+
+			return
+		}
+
+		cnt.presetName = ""
+		cnt.recreateID = cntID
+
+		cnt.recreateDialog.Display()
+		cnt.recreateDialog.SetContainerCreateOptions(createOpts)
+
+		unmapped = append(unmapped, cnt.recreateDialog.UnresolvedOptions(createOpts)...)
+		if len(unmapped) > 0 {
+			cnt.displayError("CONTAINER RECREATE WARNINGS",
+				fmt.Errorf("%w:\n%s", errRecreateUnmapped, strings.Join(unmapped, "\n")))
+		}
+
+		cnt.appFocusHandler()
+	}
+
+	go initData()
+}
+
+func (cnt *Containers) recreate() {
+	recreateOpts := cnt.recreateDialog.ContainerCreateOptions()
+	if recreateOpts.Image == "" {
+		cnt.displayError("CONTAINER RECREATE ERROR", errEmptyContainerImageName)
+
+		return
+	}
+
+	if _, err := utils.SplitCommandLine(recreateOpts.Command); err != nil {
+		cnt.displayError("CONTAINER RECREATE ERROR", fmt.Errorf("%w: %s", err, recreateOpts.Command))
+
+		return
+	}
+
+	cntID := cnt.recreateID
+
+	cnt.progressDialog.SetTitle("container recreate in progress")
+	cnt.progressDialog.Display()
+
+	recreate := func() {
+		warnings, _, err := containers.Recreate(cntID, recreateOpts)
+
+		cnt.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("CONTAINER (%s) RECREATE ERROR", cntID)
+
+			cnt.displayError(title, err)
+			cnt.appFocusHandler()
+
+			return
+		}
+
+		if len(warnings) > 0 {
+			headerLabel := fmt.Sprintf("%s (%s)", cntID, recreateOpts.Name)
+
+			cnt.messageDialog.SetTitle("CONTAINER RECREATE WARNINGS")
+			cnt.messageDialog.SetText(dialogs.MessageContainerInfo, headerLabel, strings.Join(warnings, "\n"))
+			cnt.messageDialog.Display()
+		}
+
+		cnt.appFocusHandler()
+	}
+
+	go recreate()
+}
+
+func (cnt *Containers) importRun() {
+	runCmd, err := cntdialogs.ParseRunCommand(cnt.runImportDialog.GetCommand())
+	if err != nil {
+		cnt.displayError("CONTAINER RUN IMPORT ERROR", err)
+
+		return
+	}
+
+	cnt.runImportDialog.Hide()
+
+	target := cnt.createDialog
+	if runCmd.Run {
+		target = cnt.runDialog
+	}
+
+	cnt.presetName = ""
+
+	target.Display()
+	target.SetContainerCreateOptions(runCmd.Options)
+
+	unmapped := append(runCmd.Unmapped, target.UnresolvedOptions(runCmd.Options)...) //nolint:gocritic
+	if len(unmapped) > 0 {
+		cnt.displayError("CONTAINER RUN IMPORT WARNINGS",
+			fmt.Errorf("%w:\n%s", errRunImportUnmapped, strings.Join(unmapped, "\n")))
+	}
+}
+
+func (cnt *Containers) presets(target *cntdialogs.ContainerCreateDialog) {
+	presets, err := config.ContainerPresets()
+	if err != nil {
+		cnt.displayError("CONTAINER PRESETS ERROR", err)
+
+		return
+	}
+
+	if len(presets) == 0 {
+		cnt.displayError("", errNoContainerPresets)
+
+		return
+	}
+
+	names := make([]string, 0, len(presets))
+	for _, preset := range presets {
+		names = append(names, preset.Name)
+	}
+
+	cnt.presetsTarget = target
+	cnt.presetsDialog.SetTitle("podman container presets")
+	cnt.presetsDialog.SetPresets(names)
+	cnt.presetsDialog.Display()
+}
+
+func (cnt *Containers) loadPreset() {
+	name := cnt.presetsDialog.GetSelectedPreset()
+
+	cnt.presetsDialog.Hide()
+
+	presets, err := config.ContainerPresets()
+	if err != nil {
+		cnt.displayError("CONTAINER PRESET LOAD ERROR", err)
+
+		return
+	}
+
+	for _, preset := range presets {
+		if preset.Name == name {
+			cnt.presetsTarget.SetContainerCreateOptions(preset.Options)
+			cnt.presetName = name
+
+			return
+		}
+	}
+
+	cnt.displayError("CONTAINER PRESET LOAD ERROR", fmt.Errorf("%w: %q", config.ErrPresetNotFound, name))
+}
+
+func (cnt *Containers) deletePreset() {
+	name := cnt.presetsDialog.GetSelectedPreset()
+	if err := config.RemoveContainerPreset(name); err != nil {
+		cnt.presetsDialog.Hide()
+		cnt.displayError("CONTAINER PRESET DELETE ERROR", err)
+
+		return
+	}
+
+	if cnt.presetName == name {
+		cnt.presetName = ""
+	}
+
+	cnt.presetsDialog.Hide()
+	cnt.presets(cnt.presetsTarget)
+}
+
+func (cnt *Containers) savePreset(target *cntdialogs.ContainerCreateDialog) {
+	cnt.cmdInputDialog.SetTitle("podman container preset save")
+	cnt.cmdInputDialog.SetDescription("save the dialog values as a preset, existing preset with the same name will be overwritten.")
+	cnt.cmdInputDialog.SetSelectButtonLabel("save")
+	cnt.cmdInputDialog.SetLabel("preset name ")
+	cnt.cmdInputDialog.SetInputText(cnt.presetName)
+
+	cnt.cmdInputDialog.SetSelectedFunc(func() {
+		name := strings.TrimSpace(cnt.cmdInputDialog.GetInputText())
+		cnt.cmdInputDialog.Hide()
+
+		if err := config.SaveContainerPreset(name, target.ContainerPresetOptions()); err != nil {
+			cnt.displayError("CONTAINER PRESET SAVE ERROR", err)
+
+			return
+		}
+
+		cnt.presetName = name
+	})
+
+	cnt.cmdInputDialog.Display()
+}
+
 func (cnt *Containers) diff() {
 	if cnt.selectedID == "" {
 		cnt.displayError("", errNoContainerDiff)
@@ -622,9 +1354,9 @@ func (cnt *Containers) inspect() {
 
 	headerLabel := fmt.Sprintf("%s (%s)", cnt.selectedID, cnt.selectedName)
 
-	cnt.messageDialog.SetTitle("podman container inspect")
-	cnt.messageDialog.SetText(dialogs.MessageContainerInfo, headerLabel, data)
-	cnt.messageDialog.DisplayFullSize()
+	cnt.inspectDialog.SetTitle("podman container inspect")
+	cnt.inspectDialog.SetData(dialogs.MessageContainerInfo, headerLabel, data)
+	cnt.inspectDialog.Display()
 }
 
 func (cnt *Containers) kill() {
@@ -746,20 +1478,54 @@ func (cnt *Containers) port() {
 }
 
 func (cnt *Containers) cprune() {
-	cnt.confirmDialog.SetTitle("podman container prune")
+	cnt.pruneDialog.Display()
+	cnt.prunePreview()
+}
 
-	cnt.confirmData = "prune"
+func (cnt *Containers) prunePreview() {
+	opts, err := cnt.pruneDialog.GetPruneOptions()
+	if err != nil {
+		cnt.displayError("CONTAINER PRUNE PREVIEW ERROR", err)
 
-	cnt.confirmDialog.SetText("Are you sure you want to remove all unused containers ?")
-	cnt.confirmDialog.Display()
+		return
+	}
+
+	cnt.progressDialog.SetTitle("container prune preview in progress")
+	cnt.progressDialog.Display()
+
+	preview := func() {
+		reports, err := containers.PrunePreview(opts)
+
+		cnt.progressDialog.Hide()
+
+		if err != nil {
+			cnt.displayError("CONTAINER PRUNE PREVIEW ERROR", err)
+			cnt.appFocusHandler()
+
+			return
+		}
+
+		cnt.pruneDialog.SetPreview(reports)
+		cnt.appFocusHandler()
+	}
+
+	go preview()
 }
 
 func (cnt *Containers) prune() {
+	opts, err := cnt.pruneDialog.GetPruneOptions()
+	if err != nil {
+		cnt.displayError("CONTAINER PRUNE ERROR", err)
+
+		return
+	}
+
+	cnt.pruneDialog.Hide()
 	cnt.progressDialog.SetTitle("container prune in progress")
 	cnt.progressDialog.Display()
 
 	prune := func() {
-		errData, err := containers.Prune()
+		errData, err := containers.Prune(opts)
 
 		cnt.progressDialog.Hide()
 
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/containers/podman-tui/pdcs/containers"
 	"github.com/containers/podman-tui/ui/containers/cntdialogs"
 	"github.com/containers/podman-tui/ui/containers/cntdialogs/vterm"
 	"github.com/containers/podman-tui/ui/dialogs"
@@ -29,9 +30,15 @@ var (
 	errNoContainerAttach       = errors.New("there is no container to attach")
 	errNoContainerHealthCheck  = errors.New("there is no container to perform healthcheck")
 	errNoContainerCommit       = errors.New("there is no container to commit")
+	errNoContainerCompare      = errors.New("there is no container to compare")
+	errNoContainerCompareTo    = errors.New("there is no other container to compare with")
+	errNoContainerClone        = errors.New("there is no container to clone")
 	errNoContainerStat         = errors.New("there is no container to display stats")
 	errNoContainerCheckpoint   = errors.New("there is no container to perform checkpoint")
 	errNoContainerExec         = errors.New("there is no container to perform exec")
+	errNoContainerExecSessions = errors.New("there is no container to list exec sessions")
+	errNoContainerGoTo         = errors.New("there is no container to go to its resources")
+	errNoTerminalSessions      = errors.New("there is no active terminal session")
 	errNoContainerDiff         = errors.New("there is no container to display diff")
 	errNoContainerInspect      = errors.New("there is no container to inspect")
 	errNoContainerKill         = errors.New("there is no container to kill")
@@ -40,11 +47,19 @@ var (
 	errNoContainerUnpause      = errors.New("there is no container to unpause")
 	errNoContainerPorts        = errors.New("there is no container to display ports")
 	errNoContainerRename       = errors.New("there is no container to rename")
+	errNoContainerRecreate     = errors.New("there is no container to recreate")
+	errNoContainerRunCommand   = errors.New("there is no container to generate run command")
 	errNoContainerRemove       = errors.New("there is no container to remove")
 	errNoContainerStart        = errors.New("there is no container to start")
 	errNoContainerStop         = errors.New("there is no container to stop")
 	errNoContainerTop          = errors.New("there is no container to display top")
+	errNoContainerUpgrade      = errors.New("there is no container to upgrade")
+	errNoUpgradeRollback       = errors.New("there is no upgrade to rollback for the selected container")
+	errUpgradeWarnings         = errors.New("the container has been recreated with warnings")
 	errEmptyContainerImageName = errors.New("empty container image name")
+	errNoContainerPresets      = errors.New("there is no saved container preset")
+	errRunImportUnmapped       = errors.New("the following options could not be imported")
+	errRecreateUnmapped        = errors.New("the following settings will not be kept by the recreated container")
 )
 
 var UIViewHeaders = []string{"container id", "image", "pod", "created", "status", "names", "ports"}
@@ -56,28 +71,46 @@ type Containers struct {
 	title            string
 	headers          []string
 	table            *tview.Table
+	detailsPanel     *dialogs.DetailsPanel
 	errorDialog      *dialogs.ErrorDialog
 	cmdDialog        *dialogs.CommandDialog
 	cmdInputDialog   *dialogs.SimpleInputDialog
 	confirmDialog    *dialogs.ConfirmDialog
+	presetsDialog    *dialogs.PresetsDialog
 	messageDialog    *dialogs.MessageDialog
+	inspectDialog    *dialogs.InspectDialog
 	progressDialog   *dialogs.ProgressDialog
 	sortDialog       *dialogs.SortDialog
 	topDialog        *dialogs.TopDialog
 	createDialog     *cntdialogs.ContainerCreateDialog
 	runDialog        *cntdialogs.ContainerCreateDialog
+	recreateDialog   *cntdialogs.ContainerCreateDialog
+	runImportDialog  *cntdialogs.ContainerRunImportDialog
 	execDialog       *cntdialogs.ContainerExecDialog
 	statsDialog      *cntdialogs.ContainerStatsDialog
 	commitDialog     *cntdialogs.ContainerCommitDialog
+	cloneDialog      *cntdialogs.ContainerCloneDialog
+	runCommandDialog *cntdialogs.ContainerRunCommandDialog
+	upgradeDialog    *cntdialogs.ContainerUpgradeDialog
+	autoUpdateDialog *cntdialogs.ContainerAutoUpdateDialog
+	pruneDialog      *dialogs.PruneDialog
+	compareDialog    *dialogs.CompareDialog
+	navigateDialog   *dialogs.NavigateDialog
 	checkpointDialog *cntdialogs.ContainerCheckpointDialog
 	restoreDialog    *cntdialogs.ContainerRestoreDialog
 	terminalDialog   *vterm.VtermDialog
+	execSessions     *vterm.VtermSessionsDialog
 	containersList   containerListReport
 	selectedID       string
 	selectedName     string
+	recreateID       string
+	lastUpgrade      containers.UpgradeReport
 	confirmData      string
+	presetsTarget    *cntdialogs.ContainerCreateDialog
+	presetName       string
 	fastRefreshChan  chan bool
 	appFocusHandler  func()
+	navigateHandler  func(kind string, id string)
 }
 
 type containerListReport struct {
@@ -101,20 +134,33 @@ func NewContainers() *Containers {
 		title:            "containers",
 		headers:          UIViewHeaders,
 		errorDialog:      dialogs.NewErrorDialog(),
+		detailsPanel:     dialogs.NewDetailsPanel(),
 		cmdInputDialog:   dialogs.NewSimpleInputDialog(""),
 		messageDialog:    dialogs.NewMessageDialog(""),
+		inspectDialog:    dialogs.NewInspectDialog(),
 		progressDialog:   dialogs.NewProgressDialog(),
 		confirmDialog:    dialogs.NewConfirmDialog(),
+		presetsDialog:    dialogs.NewPresetsDialog(),
 		topDialog:        dialogs.NewTopDialog(),
 		sortDialog:       dialogs.NewSortDialog(sortHeaderItems, 3), //nolint:mnd
 		createDialog:     cntdialogs.NewContainerCreateDialog(cntdialogs.ContainerCreateOnlyDialogMode),
 		runDialog:        cntdialogs.NewContainerCreateDialog(cntdialogs.ContainerCreateAndRunDialogMode),
+		recreateDialog:   cntdialogs.NewContainerCreateDialog(cntdialogs.ContainerRecreateDialogMode),
+		runImportDialog:  cntdialogs.NewContainerRunImportDialog(),
 		execDialog:       cntdialogs.NewContainerExecDialog(),
 		statsDialog:      cntdialogs.NewContainerStatsDialog(),
 		commitDialog:     cntdialogs.NewContainerCommitDialog(),
+		cloneDialog:      cntdialogs.NewContainerCloneDialog(),
+		runCommandDialog: cntdialogs.NewContainerRunCommandDialog(),
+		upgradeDialog:    cntdialogs.NewContainerUpgradeDialog(),
+		autoUpdateDialog: cntdialogs.NewContainerAutoUpdateDialog(),
+		pruneDialog:      dialogs.NewPruneDialog(false, false),
+		compareDialog:    dialogs.NewCompareDialog(),
+		navigateDialog:   dialogs.NewNavigateDialog(),
 		checkpointDialog: cntdialogs.NewContainerCheckpointDialog(),
 		restoreDialog:    cntdialogs.NewContainerRestoreDialog(),
 		terminalDialog:   vterm.NewVtermDialog(),
+		execSessions:     vterm.NewVtermSessionsDialog(),
 		containersList:   containerListReport{sortBy: UIViewHeaders[viewContainersCreatedAtColIndex], ascending: true},
 	}
 
@@ -122,27 +168,38 @@ func NewContainers() *Containers {
 
 	containers.cmdDialog = dialogs.NewCommandDialog([][]string{
 		{"attach", "attach to a running container"},
+		{"auto-update", "review and apply auto-update of containers with autoupdate label"},
 		{"checkpoint", "checkpoints a running container"},
+		{"clone", "create a copy of the selected container"},
 		{"commit", "create an image from a container's changes"},
+		{"compare", "compare the selected container with another container"},
 		{"create", "create a new container but do not start"},
 		{"diff", "inspect changes to the selected container's file systems"},
 		{"exec", "execute the specified command inside a running container"},
+		{"exec sessions", "list exec sessions of the selected container"},
+		{"go to", "switch to the pod, image, volume, network or secret of the selected container"},
 		{"healthcheck", "run the health check of a container"},
+		{"import run", "create a container from a pasted podman or docker run command"},
 		{"inspect", "display the configuration of a container"},
 		{"kill", "kill the selected running container with a SIGKILL signal"},
 		{"logs", "fetch the logs of the selected container"},
 		{"pause", "pause all the processes in the selected container"},
 		{"port", "list port mappings for the selected container"},
 		{"prune", "remove all non running containers"},
+		{"recreate", "edit and recreate the selected container with the same name"},
 		{"rename", "rename the selected container"},
 		{"restore", "restores a container from a checkpoint"},
 		{"rm", "remove the selected container"},
 		{"run", "runs a command in a new container from the given image"},
+		{"run command", "display the podman run command to recreate the selected container"},
 		{"start", "start the selected containers"},
 		{"stats", "display container resource usage statistics"},
 		{"stop", "stop the selected containers"},
+		{"terminals", "switch to the active exec terminal sessions"},
 		{"top", "display the running processes of the selected container"},
 		{"unpause", "unpause the selected container that was paused before"},
+		{"upgrade", "pull a newer image and recreate the selected container on it"},
+		{"upgrade rollback", "recreate the last upgraded container on its old image"},
 	})
 
 	containers.table = tview.NewTable()
@@ -179,6 +236,9 @@ func NewContainers() *Containers {
 	// set message dialog functions
 	containers.messageDialog.SetCancelFunc(containers.messageDialog.Hide)
 
+	// set inspect dialog functions
+	containers.inspectDialog.SetCancelFunc(containers.inspectDialog.Hide)
+
 	// set container top dialog functions
 	containers.topDialog.SetCancelFunc(containers.topDialog.Hide)
 
@@ -186,10 +246,7 @@ func NewContainers() *Containers {
 	containers.confirmDialog.SetSelectedFunc(func() {
 		containers.confirmDialog.Hide()
 
-		switch containers.confirmData {
-		case utils.PruneCommandLabel:
-			containers.prune()
-		case "rm":
+		if containers.confirmData == "rm" {
 			containers.remove()
 		}
 	})
@@ -206,6 +263,14 @@ func NewContainers() *Containers {
 		containers.create()
 	})
 
+	containers.createDialog.SetPresetsFunc(func() {
+		containers.presets(containers.createDialog)
+	})
+
+	containers.createDialog.SetSavePresetFunc(func() {
+		containers.savePreset(containers.createDialog)
+	})
+
 	// set run dialog functions
 	containers.runDialog.SetCancelFunc(func() {
 		containers.runDialog.Hide()
@@ -216,6 +281,41 @@ func NewContainers() *Containers {
 		containers.run()
 	})
 
+	containers.runDialog.SetPresetsFunc(func() {
+		containers.presets(containers.runDialog)
+	})
+
+	containers.runDialog.SetSavePresetFunc(func() {
+		containers.savePreset(containers.runDialog)
+	})
+
+	// set recreate dialog functions
+	containers.recreateDialog.SetCancelFunc(func() {
+		containers.recreateDialog.Hide()
+	})
+
+	containers.recreateDialog.SetHandlerFunc(func() {
+		containers.recreateDialog.Hide()
+		containers.recreate()
+	})
+
+	containers.recreateDialog.SetPresetsFunc(func() {
+		containers.presets(containers.recreateDialog)
+	})
+
+	containers.recreateDialog.SetSavePresetFunc(func() {
+		containers.savePreset(containers.recreateDialog)
+	})
+
+	// set run import dialog functions
+	containers.runImportDialog.SetImportFunc(containers.importRun)
+	containers.runImportDialog.SetCancelFunc(containers.runImportDialog.Hide)
+
+	// set presets dialog functions
+	containers.presetsDialog.SetLoadFunc(containers.loadPreset)
+	containers.presetsDialog.SetDeleteFunc(containers.deletePreset)
+	containers.presetsDialog.SetCancelFunc(containers.presetsDialog.Hide)
+
 	// set exec dialog functions
 	containers.execDialog.SetCancelFunc(containers.execDialog.Hide)
 	containers.execDialog.SetExecFunc(containers.exec)
@@ -226,6 +326,11 @@ func NewContainers() *Containers {
 		containers.fastRefreshChan <- true
 	})
 
+	// exec terminal sessions
+	containers.execSessions.SetFastRefreshHandler(func() {
+		containers.fastRefreshChan <- true
+	})
+
 	// set stats dialogs functions
 	containers.statsDialog.SetDoneFunc(containers.statsDialog.Hide)
 
@@ -233,6 +338,40 @@ func NewContainers() *Containers {
 	containers.commitDialog.SetCommitFunc(containers.commit)
 	containers.commitDialog.SetCancelFunc(containers.commitDialog.Hide)
 
+	// set clone dialog functions
+	containers.cloneDialog.SetCloneFunc(containers.clone)
+	containers.cloneDialog.SetCancelFunc(containers.cloneDialog.Hide)
+
+	// set run command dialog functions
+	containers.runCommandDialog.SetCancelFunc(containers.runCommandDialog.Hide)
+
+	// set upgrade dialog functions
+	containers.upgradeDialog.SetPullFunc(containers.upgradePull)
+	containers.upgradeDialog.SetUpgradeFunc(containers.upgrade)
+	containers.upgradeDialog.SetRollbackFunc(containers.upgradeRollback)
+	containers.upgradeDialog.SetCancelFunc(containers.upgradeDialog.Hide)
+
+	// set auto-update dialog functions
+	containers.autoUpdateDialog.SetDryRunFunc(containers.autoUpdateDryRun)
+	containers.autoUpdateDialog.SetUpdateFunc(containers.autoUpdateApply)
+	containers.autoUpdateDialog.SetCancelFunc(containers.autoUpdateDialog.Hide)
+
+	// set prune dialog functions
+	containers.pruneDialog.SetTitle("podman container prune")
+	containers.pruneDialog.SetCancelFunc(containers.pruneDialog.Hide)
+	containers.pruneDialog.SetPreviewFunc(containers.prunePreview)
+	containers.pruneDialog.SetPruneFunc(containers.prune)
+
+	// set compare dialog functions
+	containers.compareDialog.SetTitle("podman container compare")
+	containers.compareDialog.SetCancelFunc(containers.compareDialog.Hide)
+	containers.compareDialog.SetCompareFunc(containers.compare)
+
+	// set navigate dialog functions
+	containers.navigateDialog.SetTitle("podman container go to")
+	containers.navigateDialog.SetCancelFunc(containers.navigateDialog.Hide)
+	containers.navigateDialog.SetGoToFunc(containers.goTo)
+
 	// set checkpoint dialog functions
 	containers.checkpointDialog.SetCheckpointFunc(containers.checkpoint)
 	containers.checkpointDialog.SetCancelFunc(containers.checkpointDialog.Hide)
@@ -245,6 +384,9 @@ func NewContainers() *Containers {
 	containers.sortDialog.SetSelectFunc(containers.SortView)
 	containers.sortDialog.SetCancelFunc(containers.sortDialog.Hide)
 
+	// set details panel functions
+	containers.initDetailsPanel()
+
 	return containers
 }
 
@@ -253,6 +395,11 @@ func (cnt *Containers) SetAppFocusHandler(handler func()) {
 	cnt.appFocusHandler = handler
 }
 
+// SetNavigateFunc sets the handler which switches to another resource screen.
+func (cnt *Containers) SetNavigateFunc(handler func(kind string, id string)) {
+	cnt.navigateHandler = handler
+}
+
 // GetTitle returns primitive title.
 func (cnt *Containers) GetTitle() string {
 	return cnt.title
@@ -272,6 +419,10 @@ func (cnt *Containers) HasFocus() bool { //nolint:cyclop
 		return true
 	}
 
+	if cnt.inspectDialog.HasFocus() {
+		return true
+	}
+
 	if cnt.confirmDialog.HasFocus() || cnt.cmdInputDialog.HasFocus() {
 		return true
 	}
@@ -292,6 +443,30 @@ func (cnt *Containers) HasFocus() bool { //nolint:cyclop
 		return true
 	}
 
+	if cnt.cloneDialog.HasFocus() || cnt.execSessions.HasFocus() {
+		return true
+	}
+
+	if cnt.runCommandDialog.HasFocus() || cnt.recreateDialog.HasFocus() {
+		return true
+	}
+
+	if cnt.upgradeDialog.HasFocus() || cnt.autoUpdateDialog.HasFocus() {
+		return true
+	}
+
+	if cnt.pruneDialog.HasFocus() || cnt.compareDialog.HasFocus() {
+		return true
+	}
+
+	if cnt.navigateDialog.HasFocus() {
+		return true
+	}
+
+	if cnt.presetsDialog.HasFocus() || cnt.runImportDialog.HasFocus() {
+		return true
+	}
+
 	if cnt.sortDialog.HasFocus() || cnt.Box.HasFocus() {
 		return true
 	}
@@ -313,6 +488,10 @@ func (cnt *Containers) SubDialogHasFocus() bool { //nolint:cyclop
 		return true
 	}
 
+	if cnt.inspectDialog.HasFocus() {
+		return true
+	}
+
 	if cnt.confirmDialog.HasFocus() || cnt.cmdInputDialog.HasFocus() {
 		return true
 	}
@@ -333,6 +512,30 @@ func (cnt *Containers) SubDialogHasFocus() bool { //nolint:cyclop
 		return true
 	}
 
+	if cnt.cloneDialog.HasFocus() || cnt.execSessions.HasFocus() {
+		return true
+	}
+
+	if cnt.runCommandDialog.HasFocus() || cnt.recreateDialog.HasFocus() {
+		return true
+	}
+
+	if cnt.upgradeDialog.HasFocus() || cnt.autoUpdateDialog.HasFocus() {
+		return true
+	}
+
+	if cnt.pruneDialog.HasFocus() || cnt.compareDialog.HasFocus() {
+		return true
+	}
+
+	if cnt.navigateDialog.HasFocus() {
+		return true
+	}
+
+	if cnt.presetsDialog.HasFocus() || cnt.runImportDialog.HasFocus() {
+		return true
+	}
+
 	return false
 }
 
@@ -359,6 +562,20 @@ func (cnt *Containers) Focus(delegate func(p tview.Primitive)) { //nolint:cyclop
 		return
 	}
 
+	// presets dialog
+	if cnt.presetsDialog.IsDisplay() {
+		delegate(cnt.presetsDialog)
+
+		return
+	}
+
+	// run import dialog
+	if cnt.runImportDialog.IsDisplay() {
+		delegate(cnt.runImportDialog)
+
+		return
+	}
+
 	// message dialog
 	if cnt.messageDialog.IsDisplay() {
 		delegate(cnt.messageDialog)
@@ -366,6 +583,13 @@ func (cnt *Containers) Focus(delegate func(p tview.Primitive)) { //nolint:cyclop
 		return
 	}
 
+	// inspect dialog
+	if cnt.inspectDialog.IsDisplay() {
+		delegate(cnt.inspectDialog)
+
+		return
+	}
+
 	// container top dialog
 	if cnt.topDialog.IsDisplay() {
 		delegate(cnt.topDialog)
@@ -394,6 +618,13 @@ func (cnt *Containers) Focus(delegate func(p tview.Primitive)) { //nolint:cyclop
 		return
 	}
 
+	// recreate dialog
+	if cnt.recreateDialog.IsDisplay() {
+		delegate(cnt.recreateDialog)
+
+		return
+	}
+
 	// exec dialog
 	if cnt.execDialog.IsDisplay() {
 		delegate(cnt.execDialog)
@@ -415,6 +646,55 @@ func (cnt *Containers) Focus(delegate func(p tview.Primitive)) { //nolint:cyclop
 		return
 	}
 
+	// clone dialog
+	if cnt.cloneDialog.IsDisplay() {
+		delegate(cnt.cloneDialog)
+
+		return
+	}
+
+	// run command dialog
+	if cnt.runCommandDialog.IsDisplay() {
+		delegate(cnt.runCommandDialog)
+
+		return
+	}
+
+	// upgrade dialog
+	if cnt.upgradeDialog.IsDisplay() {
+		delegate(cnt.upgradeDialog)
+
+		return
+	}
+
+	// auto-update dialog
+	if cnt.autoUpdateDialog.IsDisplay() {
+		delegate(cnt.autoUpdateDialog)
+
+		return
+	}
+
+	// prune dialog
+	if cnt.pruneDialog.IsDisplay() {
+		delegate(cnt.pruneDialog)
+
+		return
+	}
+
+	// compare dialog
+	if cnt.compareDialog.IsDisplay() {
+		delegate(cnt.compareDialog)
+
+		return
+	}
+
+	// navigate dialog
+	if cnt.navigateDialog.IsDisplay() {
+		delegate(cnt.navigateDialog)
+
+		return
+	}
+
 	// checkpoint dialog
 	if cnt.checkpointDialog.IsDisplay() {
 		delegate(cnt.checkpointDialog)
@@ -436,6 +716,13 @@ func (cnt *Containers) Focus(delegate func(p tview.Primitive)) { //nolint:cyclop
 		return
 	}
 
+	// exec terminal sessions dialog
+	if cnt.execSessions.IsDisplay() {
+		delegate(cnt.execSessions)
+
+		return
+	}
+
 	// sort dialog
 	if cnt.sortDialog.IsDisplay() {
 		delegate(cnt.sortDialog)
@@ -451,6 +738,11 @@ func (cnt *Containers) SetFastRefreshChannel(refresh chan bool) {
 	cnt.fastRefreshChan = refresh
 }
 
+// ExecSessions returns the container exec terminal sessions dialog.
+func (cnt *Containers) ExecSessions() *vterm.VtermSessionsDialog {
+	return cnt.execSessions
+}
+
 // HideAllDialogs hides all sub dialogs.
 func (cnt *Containers) HideAllDialogs() { //nolint:cyclop
 	if cnt.errorDialog.IsDisplay() {
@@ -473,10 +765,22 @@ func (cnt *Containers) HideAllDialogs() { //nolint:cyclop
 		cnt.cmdInputDialog.Hide()
 	}
 
+	if cnt.presetsDialog.IsDisplay() {
+		cnt.presetsDialog.Hide()
+	}
+
+	if cnt.runImportDialog.IsDisplay() {
+		cnt.runImportDialog.Hide()
+	}
+
 	if cnt.messageDialog.IsDisplay() {
 		cnt.messageDialog.Hide()
 	}
 
+	if cnt.inspectDialog.IsDisplay() {
+		cnt.inspectDialog.Hide()
+	}
+
 	if cnt.topDialog.IsDisplay() {
 		cnt.topDialog.Hide()
 	}
@@ -489,6 +793,10 @@ func (cnt *Containers) HideAllDialogs() { //nolint:cyclop
 		cnt.runDialog.Hide()
 	}
 
+	if cnt.recreateDialog.IsDisplay() {
+		cnt.recreateDialog.Hide()
+	}
+
 	if cnt.execDialog.IsDisplay() {
 		cnt.execDialog.Hide()
 	}
@@ -501,6 +809,34 @@ func (cnt *Containers) HideAllDialogs() { //nolint:cyclop
 		cnt.commitDialog.Hide()
 	}
 
+	if cnt.cloneDialog.IsDisplay() {
+		cnt.cloneDialog.Hide()
+	}
+
+	if cnt.runCommandDialog.IsDisplay() {
+		cnt.runCommandDialog.Hide()
+	}
+
+	if cnt.upgradeDialog.IsDisplay() {
+		cnt.upgradeDialog.Hide()
+	}
+
+	if cnt.autoUpdateDialog.IsDisplay() {
+		cnt.autoUpdateDialog.Hide()
+	}
+
+	if cnt.pruneDialog.IsDisplay() {
+		cnt.pruneDialog.Hide()
+	}
+
+	if cnt.compareDialog.IsDisplay() {
+		cnt.compareDialog.Hide()
+	}
+
+	if cnt.navigateDialog.IsDisplay() {
+		cnt.navigateDialog.Hide()
+	}
+
 	if cnt.checkpointDialog.IsDisplay() {
 		cnt.checkpointDialog.Hide()
 	}
@@ -513,6 +849,9 @@ func (cnt *Containers) HideAllDialogs() { //nolint:cyclop
 		cnt.terminalDialog.Hide()
 	}
 
+	// sessions are not usable after connection change
+	cnt.execSessions.CloseAll()
+
 	if cnt.sortDialog.IsDisplay() {
 		cnt.sortDialog.Hide()
 	}
@@ -534,3 +873,21 @@ func (cnt *Containers) getSelectedItem() (string, string) {
 
 	return cntID, cntName
 }
+
+// GetSelectedItemID returns the selected container ID.
+func (cnt *Containers) GetSelectedItemID() string {
+	cntID, _ := cnt.getSelectedItem()
+
+	return cntID
+}
+
+// SelectItem selects the container by its ID or name.
+func (cnt *Containers) SelectItem(id string) {
+	for i, container := range cnt.getData() {
+		if utils.MatchIDOrName(id, container.ID, container.Names...) {
+			cnt.table.Select(i+1, 0)
+
+			return
+		}
+	}
+}
@@ -29,6 +29,8 @@ func (cnt *Containers) SortView(option string, ascending bool) {
 
 // UpdateData retrieves containers list data.
 func (cnt *Containers) UpdateData() {
+	cnt.detailsPanel.Invalidate()
+
 	cntList, err := containers.List()
 	if err != nil {
 		log.Error().Msgf("view: containers update %v", err)
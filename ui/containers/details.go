@@ -0,0 +1,27 @@
+package containers
+
+import (
+	"github.com/containers/podman-tui/pdcs/containers"
+)
+
+// initDetailsPanel sets details panel title, its data loader and reloads
+// the details whenever the table selected item changes.
+func (cnt *Containers) initDetailsPanel() {
+	cnt.detailsPanel.SetTitle("container details")
+	cnt.detailsPanel.SetLoadFunc(containers.Details)
+	cnt.detailsPanel.SetSelectedItemFunc(cnt.selectedItemID)
+	cnt.detailsPanel.SetRefreshFunc(func() {
+		if cnt.fastRefreshChan != nil {
+			cnt.fastRefreshChan <- true
+		}
+	})
+	cnt.table.SetSelectionChangedFunc(func(_, _ int) {
+		cnt.detailsPanel.Update(cnt.selectedItemID())
+	})
+}
+
+func (cnt *Containers) selectedItemID() string {
+	id, _ := cnt.getSelectedItem()
+
+	return id
+}
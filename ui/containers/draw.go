@@ -10,13 +10,17 @@ func (cnt *Containers) Draw(screen tcell.Screen) { //nolint:cyclop
 	cnt.SetBorder(false)
 
 	cntViewX, cntViewY, cntViewW, cntViewH := cnt.GetInnerRect()
+	tableX, tableY, tableW, tableH := cnt.detailsPanel.Split(cntViewX, cntViewY, cntViewW, cntViewH)
 
-	cnt.refresh(cntViewW)
-	cnt.table.SetRect(cntViewX, cntViewY, cntViewW, cntViewH)
+	cnt.refresh(tableW)
+	cnt.table.SetRect(tableX, tableY, tableW, tableH)
 	cnt.table.SetBorder(true)
 	cnt.table.Draw(screen)
 
-	x, y, width, height := cnt.table.GetInnerRect()
+	cnt.detailsPanel.Draw(screen)
+
+	// dialogs are drawn over the table and details panel
+	x, y, width, height := cntViewX+1, cntViewY+1, cntViewW-2, cntViewH-2 //nolint:mnd
 
 	// error dialog
 	if cnt.errorDialog.IsDisplay() {
@@ -42,6 +46,22 @@ func (cnt *Containers) Draw(screen tcell.Screen) { //nolint:cyclop
 		return
 	}
 
+	// presets dialog
+	if cnt.presetsDialog.IsDisplay() {
+		cnt.presetsDialog.SetRect(x, y, width, height)
+		cnt.presetsDialog.Draw(screen)
+
+		return
+	}
+
+	// run import dialog
+	if cnt.runImportDialog.IsDisplay() {
+		cnt.runImportDialog.SetRect(x, y, width, height)
+		cnt.runImportDialog.Draw(screen)
+
+		return
+	}
+
 	// create dialog
 	if cnt.createDialog.IsDisplay() {
 		cnt.createDialog.SetRect(x, y, width, height)
@@ -58,6 +78,14 @@ func (cnt *Containers) Draw(screen tcell.Screen) { //nolint:cyclop
 		return
 	}
 
+	// recreate dialog
+	if cnt.recreateDialog.IsDisplay() {
+		cnt.recreateDialog.SetRect(x, y, width, height)
+		cnt.recreateDialog.Draw(screen)
+
+		return
+	}
+
 	// message dialog
 	if cnt.messageDialog.IsDisplay() {
 		if cnt.messageDialog.IsDisplayFullSize() {
@@ -71,6 +99,14 @@ func (cnt *Containers) Draw(screen tcell.Screen) { //nolint:cyclop
 		return
 	}
 
+	// inspect dialog
+	if cnt.inspectDialog.IsDisplay() {
+		cnt.inspectDialog.SetRect(cntViewX, cntViewY, cntViewW, cntViewH)
+		cnt.inspectDialog.Draw(screen)
+
+		return
+	}
+
 	// confirm dialog
 	if cnt.confirmDialog.IsDisplay() {
 		cnt.confirmDialog.SetRect(x, y, width, height)
@@ -79,6 +115,26 @@ func (cnt *Containers) Draw(screen tcell.Screen) { //nolint:cyclop
 		return
 	}
 
+	// prune dialog (progress dialog is drawn on top during preview)
+	if cnt.pruneDialog.IsDisplay() {
+		cnt.pruneDialog.SetRect(x, y, width, height)
+		cnt.pruneDialog.Draw(screen)
+	}
+
+	// compare dialog (progress dialog is drawn on top during compare)
+	if cnt.compareDialog.IsDisplay() {
+		cnt.compareDialog.SetRect(x, y, width, height)
+		cnt.compareDialog.Draw(screen)
+	}
+
+	// navigate dialog
+	if cnt.navigateDialog.IsDisplay() {
+		cnt.navigateDialog.SetRect(x, y, width, height)
+		cnt.navigateDialog.Draw(screen)
+
+		return
+	}
+
 	// progress dialog
 	if cnt.progressDialog.IsDisplay() {
 		cnt.progressDialog.SetRect(x, y, width, height)
@@ -117,6 +173,38 @@ func (cnt *Containers) Draw(screen tcell.Screen) { //nolint:cyclop
 		return
 	}
 
+	// clone dialog
+	if cnt.cloneDialog.IsDisplay() {
+		cnt.cloneDialog.SetRect(x, y, width, height)
+		cnt.cloneDialog.Draw(screen)
+
+		return
+	}
+
+	// run command dialog
+	if cnt.runCommandDialog.IsDisplay() {
+		cnt.runCommandDialog.SetRect(x, y, width, height)
+		cnt.runCommandDialog.Draw(screen)
+
+		return
+	}
+
+	// upgrade dialog
+	if cnt.upgradeDialog.IsDisplay() {
+		cnt.upgradeDialog.SetRect(x, y, width, height)
+		cnt.upgradeDialog.Draw(screen)
+
+		return
+	}
+
+	// auto-update dialog
+	if cnt.autoUpdateDialog.IsDisplay() {
+		cnt.autoUpdateDialog.SetRect(x, y, width, height)
+		cnt.autoUpdateDialog.Draw(screen)
+
+		return
+	}
+
 	// checkpoint dialog
 	if cnt.checkpointDialog.IsDisplay() {
 		cnt.checkpointDialog.SetRect(x, y, width, height)
@@ -141,6 +229,14 @@ func (cnt *Containers) Draw(screen tcell.Screen) { //nolint:cyclop
 		return
 	}
 
+	// exec terminal sessions dialog
+	if cnt.execSessions.IsDisplay() {
+		cnt.execSessions.SetRect(cntViewX, cntViewY, cntViewW, cntViewH)
+		cnt.execSessions.Draw(screen)
+
+		return
+	}
+
 	// sort dialog
 	if cnt.sortDialog.IsDisplay() {
 		cnt.sortDialog.SetRect(cntViewX, cntViewY, cntViewW, cntViewH)
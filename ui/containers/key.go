@@ -30,6 +30,20 @@ func (cnt *Containers) InputHandler() func(event *tcell.EventKey, setFocus func(
 			}
 		}
 
+		// presets dialog handler
+		if cnt.presetsDialog.HasFocus() {
+			if presetsDialogHandler := cnt.presetsDialog.InputHandler(); presetsDialogHandler != nil {
+				presetsDialogHandler(event, setFocus)
+			}
+		}
+
+		// run import dialog handler
+		if cnt.runImportDialog.HasFocus() {
+			if runImportDialogHandler := cnt.runImportDialog.InputHandler(); runImportDialogHandler != nil {
+				runImportDialogHandler(event, setFocus)
+			}
+		}
+
 		// message dialog handler
 		if cnt.messageDialog.HasFocus() {
 			if messageDialogHandler := cnt.messageDialog.InputHandler(); messageDialogHandler != nil {
@@ -37,6 +51,13 @@ func (cnt *Containers) InputHandler() func(event *tcell.EventKey, setFocus func(
 			}
 		}
 
+		// inspect dialog handler
+		if cnt.inspectDialog.HasFocus() {
+			if inspectDialogHandler := cnt.inspectDialog.InputHandler(); inspectDialogHandler != nil {
+				inspectDialogHandler(event, setFocus)
+			}
+		}
+
 		// create dialog handler
 		if cnt.createDialog.HasFocus() {
 			if createDialogHandler := cnt.createDialog.InputHandler(); createDialogHandler != nil {
@@ -51,6 +72,13 @@ func (cnt *Containers) InputHandler() func(event *tcell.EventKey, setFocus func(
 			}
 		}
 
+		// recreate dialog handler
+		if cnt.recreateDialog.HasFocus() {
+			if recreateDialogHandler := cnt.recreateDialog.InputHandler(); recreateDialogHandler != nil {
+				recreateDialogHandler(event, setFocus)
+			}
+		}
+
 		// exec dialog handler
 		if cnt.execDialog.HasFocus() {
 			if execDialogHandler := cnt.execDialog.InputHandler(); execDialogHandler != nil {
@@ -93,6 +121,55 @@ func (cnt *Containers) InputHandler() func(event *tcell.EventKey, setFocus func(
 			}
 		}
 
+		// container clone dialog handler
+		if cnt.cloneDialog.HasFocus() {
+			if cntCloneDialogHandler := cnt.cloneDialog.InputHandler(); cntCloneDialogHandler != nil {
+				cntCloneDialogHandler(event, setFocus)
+			}
+		}
+
+		// container run command dialog handler
+		if cnt.runCommandDialog.HasFocus() {
+			if cntRunCommandDialogHandler := cnt.runCommandDialog.InputHandler(); cntRunCommandDialogHandler != nil {
+				cntRunCommandDialogHandler(event, setFocus)
+			}
+		}
+
+		// container upgrade dialog handler
+		if cnt.upgradeDialog.HasFocus() {
+			if cntUpgradeDialogHandler := cnt.upgradeDialog.InputHandler(); cntUpgradeDialogHandler != nil {
+				cntUpgradeDialogHandler(event, setFocus)
+			}
+		}
+
+		// container auto-update dialog handler
+		if cnt.autoUpdateDialog.HasFocus() {
+			if cntAutoUpdateDialogHandler := cnt.autoUpdateDialog.InputHandler(); cntAutoUpdateDialogHandler != nil {
+				cntAutoUpdateDialogHandler(event, setFocus)
+			}
+		}
+
+		// container prune dialog handler
+		if cnt.pruneDialog.HasFocus() {
+			if cntPruneDialogHandler := cnt.pruneDialog.InputHandler(); cntPruneDialogHandler != nil {
+				cntPruneDialogHandler(event, setFocus)
+			}
+		}
+
+		// container compare dialog handler
+		if cnt.compareDialog.HasFocus() {
+			if cntCompareDialogHandler := cnt.compareDialog.InputHandler(); cntCompareDialogHandler != nil {
+				cntCompareDialogHandler(event, setFocus)
+			}
+		}
+
+		// container navigate dialog handler
+		if cnt.navigateDialog.HasFocus() {
+			if cntNavigateDialogHandler := cnt.navigateDialog.InputHandler(); cntNavigateDialogHandler != nil {
+				cntNavigateDialogHandler(event, setFocus)
+			}
+		}
+
 		// container checkpoint dialog handler
 		if cnt.checkpointDialog.HasFocus() {
 			if cntCheckpointDialogHandler := cnt.checkpointDialog.InputHandler(); cntCheckpointDialogHandler != nil {
@@ -121,6 +198,13 @@ func (cnt *Containers) InputHandler() func(event *tcell.EventKey, setFocus func(
 			}
 		}
 
+		// container exec terminal sessions dialog handler
+		if cnt.execSessions.HasFocus() {
+			if execSessionsHandler := cnt.execSessions.InputHandler(); execSessionsHandler != nil {
+				execSessionsHandler(event, setFocus)
+			}
+		}
+
 		// table handlers
 		if cnt.table.HasFocus() { //nolint:nestif
 			cnt.selectedID, cnt.selectedName = cnt.getSelectedItem()
@@ -144,6 +228,13 @@ func (cnt *Containers) InputHandler() func(event *tcell.EventKey, setFocus func(
 				return
 			}
 
+			// toggle details panel
+			if event.Rune() == utils.DetailsPanelKey.Rune() {
+				cnt.detailsPanel.Toggle()
+
+				return
+			}
+
 			if event.Key() == utils.DeleteKey.EventKey() {
 				cnt.rm()
 				setFocus(cnt)
@@ -0,0 +1,34 @@
+package containers
+
+import (
+	"strings"
+
+	putils "github.com/containers/podman-tui/pdcs/utils"
+)
+
+// GetCommands returns the containers screen commands and their descriptions.
+func (cnt *Containers) GetCommands() [][]string {
+	return cnt.cmdDialog.GetCommands()
+}
+
+// RunCommand runs the containers screen command on the selected item.
+func (cnt *Containers) RunCommand(cmd string) {
+	cnt.runCommand(cmd)
+}
+
+// GetResources returns the containers listed on the screen.
+func (cnt *Containers) GetResources() []putils.ResourceReference {
+	data := cnt.getData()
+	resources := make([]putils.ResourceReference, 0, len(data))
+
+	for _, item := range data {
+		resources = append(resources, putils.ResourceReference{
+			Kind: putils.ResourceKindContainer,
+			ID:   item.ID,
+			Name: strings.Join(item.Names, ","),
+			Info: item.State,
+		})
+	}
+
+	return resources
+}
@@ -0,0 +1,304 @@
+package dialogs
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	cmdlineHistoryMaxSize = 100
+	cmdlineHeight         = 2
+)
+
+// CommandLineDialog implements vim like command line primitive.
+// It is drawn at the bottom of its rect and keeps the executed commands history,
+// the last word of the command is completed by the complete function candidates.
+type CommandLineDialog struct {
+	*tview.Box
+
+	input           *tview.InputField
+	candidates      *tview.TextView
+	display         bool
+	history         []string
+	historyIndex    int
+	pending         string
+	completeHandler func(words []string) []string
+	executeHandler  func(cmd string)
+	cancelHandler   func()
+}
+
+// NewCommandLineDialog returns new command line dialog primitive.
+func NewCommandLineDialog() *CommandLineDialog {
+	dialog := &CommandLineDialog{
+		Box:        tview.NewBox(),
+		input:      tview.NewInputField(),
+		candidates: tview.NewTextView(),
+	}
+
+	bgColor := style.BgColor
+
+	// command input field
+	dialog.input.SetBackgroundColor(bgColor)
+	dialog.input.SetLabel(":")
+	dialog.input.SetPlaceholder("podman command and arguments, e.g. stop web (tab: complete, up/down: history)")
+	dialog.input.SetPlaceholderStyle(style.InputFieldStyle.Foreground(style.DialogSubBoxBorderColor))
+	dialog.input.SetFieldStyle(style.InputFieldStyle)
+	dialog.input.SetLabelStyle(style.InputLabelStyle)
+	dialog.input.SetChangedFunc(func(_ string) {
+		dialog.candidates.SetText("")
+	})
+
+	// completion candidates
+	dialog.candidates.SetBackgroundColor(style.DialogBgColor)
+	dialog.candidates.SetTextColor(style.DialogFgColor)
+	dialog.candidates.SetDynamicColors(false)
+	dialog.candidates.SetWrap(false)
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *CommandLineDialog) Display() {
+	d.display = true
+
+	d.input.SetText("")
+	d.candidates.SetText("")
+	d.historyIndex = len(d.history)
+	d.pending = ""
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *CommandLineDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *CommandLineDialog) Hide() {
+	d.display = false
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *CommandLineDialog) HasFocus() bool {
+	return d.input.HasFocus() || d.Box.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *CommandLineDialog) Focus(delegate func(p tview.Primitive)) {
+	delegate(d.input)
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *CommandLineDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("command line dialog: event %v received", event)
+
+		switch event.Key() { //nolint:exhaustive
+		case utils.CloseDialogKey.Key:
+			if d.cancelHandler != nil {
+				d.cancelHandler()
+			}
+
+			return
+		case tcell.KeyEnter:
+			d.execute()
+
+			return
+		case tcell.KeyUp:
+			d.historyPrevious()
+
+			return
+		case tcell.KeyDown:
+			d.historyNext()
+
+			return
+		case tcell.KeyTab:
+			d.complete()
+
+			return
+		}
+
+		if inputHandler := d.input.InputHandler(); inputHandler != nil {
+			inputHandler(event, setFocus)
+		}
+	})
+}
+
+// SetRect set rects for this primitive.
+func (d *CommandLineDialog) SetRect(x, y, width, height int) {
+	if height > cmdlineHeight {
+		y += height - cmdlineHeight
+		height = cmdlineHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *CommandLineDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	x, y, width, height := d.GetRect()
+
+	// the completion candidates are drawn above the command input
+	if d.candidates.GetText(false) != "" && height > 1 {
+		d.candidates.SetRect(x, y+height-2, width, 1) //nolint:mnd
+		d.candidates.Draw(screen)
+	}
+
+	d.input.SetRect(x, y+height-1, width, 1)
+	d.input.Draw(screen)
+}
+
+// SetExecuteFunc sets the function which is called with the entered command.
+func (d *CommandLineDialog) SetExecuteFunc(handler func(cmd string)) *CommandLineDialog {
+	d.executeHandler = handler
+
+	return d
+}
+
+// SetCompleteFunc sets the function which returns the completion candidates
+// of the last word of the command, the last word is empty after a white space.
+func (d *CommandLineDialog) SetCompleteFunc(handler func(words []string) []string) *CommandLineDialog {
+	d.completeHandler = handler
+
+	return d
+}
+
+// SetCancelFunc sets the command line cancel function.
+func (d *CommandLineDialog) SetCancelFunc(handler func()) *CommandLineDialog {
+	d.cancelHandler = handler
+
+	return d
+}
+
+// GetText returns the command line text.
+func (d *CommandLineDialog) GetText() string {
+	return d.input.GetText()
+}
+
+// GetHistory returns the executed commands, the most recent one is the last.
+func (d *CommandLineDialog) GetHistory() []string {
+	history := make([]string, len(d.history))
+	copy(history, d.history)
+
+	return history
+}
+
+func (d *CommandLineDialog) execute() {
+	cmd := strings.TrimSpace(d.input.GetText())
+
+	d.input.SetText("")
+
+	if cmd == "" {
+		if d.cancelHandler != nil {
+			d.cancelHandler()
+		}
+
+		return
+	}
+
+	if len(d.history) == 0 || d.history[len(d.history)-1] != cmd {
+		d.history = append(d.history, cmd)
+	}
+
+	if len(d.history) > cmdlineHistoryMaxSize {
+		d.history = d.history[1:]
+	}
+
+	d.historyIndex = len(d.history)
+	d.pending = ""
+
+	if d.executeHandler != nil {
+		d.executeHandler(cmd)
+	}
+}
+
+func (d *CommandLineDialog) historyPrevious() {
+	if d.historyIndex == 0 {
+		return
+	}
+
+	// keep the not executed command to restore it after the last history item
+	if d.historyIndex == len(d.history) {
+		d.pending = d.input.GetText()
+	}
+
+	d.historyIndex--
+	d.input.SetText(d.history[d.historyIndex])
+}
+
+func (d *CommandLineDialog) historyNext() {
+	if d.historyIndex >= len(d.history) {
+		return
+	}
+
+	d.historyIndex++
+
+	if d.historyIndex == len(d.history) {
+		d.input.SetText(d.pending)
+
+		return
+	}
+
+	d.input.SetText(d.history[d.historyIndex])
+}
+
+// complete replaces the last word with the common prefix of its completion candidates
+// and lists the candidates if there are more than one.
+func (d *CommandLineDialog) complete() {
+	if d.completeHandler == nil {
+		return
+	}
+
+	text := d.input.GetText()
+	words := strings.Fields(text)
+
+	if text == "" || unicode.IsSpace(rune(text[len(text)-1])) {
+		words = append(words, "")
+	}
+
+	prefix := words[len(words)-1]
+	candidates := make([]string, 0)
+
+	for _, candidate := range d.completeHandler(words) {
+		if strings.HasPrefix(candidate, prefix) {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return
+	}
+
+	text = strings.TrimSuffix(text, prefix) + commonPrefix(candidates)
+
+	if len(candidates) == 1 {
+		text += " "
+	}
+
+	d.input.SetText(text)
+
+	if len(candidates) > 1 {
+		d.candidates.SetText(strings.Join(candidates, "  "))
+	}
+}
+
+func commonPrefix(list []string) string {
+	prefix := list[0]
+
+	for _, item := range list[1:] {
+		for !strings.HasPrefix(item, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+
+	return prefix
+}
@@ -0,0 +1,121 @@
+package dialogs
+
+import (
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("command line dialog", Ordered, func() {
+	var cmdlineDialogApp *tview.Application
+	var cmdlineDialogScreen tcell.SimulationScreen
+	var cmdlineDialog *CommandLineDialog
+	var runApp func()
+
+	typeText := func(text string) {
+		for _, char := range text {
+			cmdlineDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyRune, char, tcell.ModNone))
+		}
+	}
+
+	BeforeAll(func() {
+		cmdlineDialogApp = tview.NewApplication()
+		cmdlineDialog = NewCommandLineDialog()
+		cmdlineDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := cmdlineDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := cmdlineDialogApp.SetScreen(cmdlineDialogScreen).SetRoot(cmdlineDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		cmdlineDialog.Display()
+		Expect(cmdlineDialog.IsDisplay()).To(Equal(true))
+		Expect(cmdlineDialog.GetText()).To(Equal(""))
+	})
+
+	It("set focus", func() {
+		cmdlineDialogApp.SetFocus(cmdlineDialog)
+		Expect(cmdlineDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("execute and history", func() {
+		executed := ""
+		cmdlineDialog.SetExecuteFunc(func(cmd string) {
+			executed = cmd
+		})
+
+		typeText("stop web")
+		cmdlineDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		cmdlineDialogApp.Draw()
+		Eventually(func() string { return executed }).Should(Equal("stop web"))
+
+		typeText("start db")
+		cmdlineDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		cmdlineDialogApp.Draw()
+		Eventually(func() string { return executed }).Should(Equal("start db"))
+		Expect(cmdlineDialog.GetHistory()).To(Equal([]string{"stop web", "start db"}))
+
+		typeText("pull")
+		cmdlineDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyUp, 0, tcell.ModNone))
+		cmdlineDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyUp, 0, tcell.ModNone))
+		cmdlineDialogApp.Draw()
+		Eventually(func() string { return cmdlineDialog.GetText() }).Should(Equal("stop web"))
+
+		cmdlineDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone))
+		cmdlineDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone))
+		cmdlineDialogApp.Draw()
+		Eventually(func() string { return cmdlineDialog.GetText() }).Should(Equal("pull"))
+	})
+
+	It("complete", func() {
+		cmdlineDialog.Display()
+		cmdlineDialog.SetCompleteFunc(func(words []string) []string {
+			if len(words) == 1 {
+				return []string{"start", "stop", "pull"}
+			}
+
+			return []string{"web-1", "web-2", "db"}
+		})
+
+		typeText("pu")
+		cmdlineDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		cmdlineDialogApp.Draw()
+		Eventually(func() string { return cmdlineDialog.GetText() }).Should(Equal("pull "))
+
+		cmdlineDialog.Display()
+		typeText("stop w")
+		cmdlineDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		cmdlineDialogApp.Draw()
+		Eventually(func() string { return cmdlineDialog.GetText() }).Should(Equal("stop web-"))
+		Eventually(func() string { return cmdlineDialog.candidates.GetText(true) }).Should(Equal("web-1  web-2"))
+	})
+
+	It("cancel", func() {
+		cancelled := false
+		cmdlineDialog.SetCancelFunc(func() {
+			cancelled = true
+		})
+		cmdlineDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
+		cmdlineDialogApp.Draw()
+		Eventually(func() bool { return cancelled }).Should(Equal(true))
+	})
+
+	It("hide", func() {
+		cmdlineDialog.Hide()
+		Expect(cmdlineDialog.IsDisplay()).To(Equal(false))
+	})
+
+	AfterAll(func() {
+		cmdlineDialogApp.Stop()
+	})
+})
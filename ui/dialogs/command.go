@@ -2,6 +2,7 @@ package dialogs
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/containers/podman-tui/ui/style"
 	"github.com/containers/podman-tui/ui/utils"
@@ -162,6 +163,11 @@ func (cmd *CommandDialog) GetSelectedItem() string {
 	return ""
 }
 
+// GetCommands returns list of commands and their descriptions.
+func (cmd *CommandDialog) GetCommands() [][]string {
+	return slices.Clone(cmd.options)
+}
+
 // GetCommandCount returns number of commands.
 func (cmd *CommandDialog) GetCommandCount() int {
 	return cmd.table.GetRowCount()
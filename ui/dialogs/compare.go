@@ -0,0 +1,447 @@
+package dialogs
+
+import (
+	"fmt"
+	"strings"
+
+	putils "github.com/containers/podman-tui/pdcs/utils"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	compareDialogMaxWidth      = 130
+	compareDialogMaxHeight     = 36
+	compareDialogLabelWidth    = 13
+	compareDialogValueMaxWidth = 50
+)
+
+const (
+	compareRightFocus = 0 + iota
+	compareDiffOnlyFocus
+	compareTableFocus
+	compareFormFocus
+)
+
+// CompareDialog implements side by side compare dialog of two resources (i.e. images or containers).
+type CompareDialog struct {
+	*tview.Box
+
+	layout         *tview.Flex
+	left           *tview.InputField
+	right          *tview.DropDown
+	diffOnly       *tview.Checkbox
+	table          *tview.Table
+	summary        *tview.TextView
+	form           *tview.Form
+	leftName       string
+	rightItems     [][]string
+	entries        []putils.CompareEntry
+	display        bool
+	focusElement   int
+	compareHandler func()
+	cancelHandler  func()
+}
+
+// NewCompareDialog returns new compare dialog primitive.
+func NewCompareDialog() *CompareDialog {
+	dialog := &CompareDialog{
+		Box:      tview.NewBox(),
+		layout:   tview.NewFlex().SetDirection(tview.FlexRow),
+		left:     tview.NewInputField(),
+		right:    tview.NewDropDown(),
+		diffOnly: tview.NewCheckbox(),
+		table:    tview.NewTable(),
+		summary:  tview.NewTextView(),
+		form:     tview.NewForm(),
+	}
+
+	bgColor := style.DialogBgColor
+	fgColor := style.DialogFgColor
+
+	// left item field
+	dialog.left.SetBackgroundColor(bgColor)
+	dialog.left.SetLabel("[::b]" + utils.StringToInputLabel("compare:", compareDialogLabelWidth))
+	dialog.left.SetFieldBackgroundColor(bgColor)
+	dialog.left.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(fgColor))
+
+	// right item dropdown
+	dialog.right.SetBackgroundColor(bgColor)
+	dialog.right.SetLabelColor(fgColor)
+	dialog.right.SetLabel("with:")
+	dialog.right.SetLabelWidth(compareDialogLabelWidth)
+	dialog.right.SetListStyles(style.DropDownUnselected, style.DropDownSelected)
+	dialog.right.SetFocusedStyle(style.DropDownFocused)
+	dialog.right.SetFieldStyle(style.InputFieldStyle)
+
+	// differences only checkbox
+	dialog.diffOnly.SetBackgroundColor(bgColor)
+	dialog.diffOnly.SetLabelColor(fgColor)
+	dialog.diffOnly.SetLabel("diff only:")
+	dialog.diffOnly.SetLabelWidth(compareDialogLabelWidth)
+	dialog.diffOnly.SetFieldBackgroundColor(style.FieldBackgroundColor)
+	dialog.diffOnly.SetChecked(true)
+	dialog.diffOnly.SetChangedFunc(func(_ bool) {
+		dialog.updateTable()
+	})
+
+	// results table
+	dialog.table.SetBackgroundColor(bgColor)
+	dialog.table.SetBorder(true)
+	dialog.table.SetBorderColor(style.DialogSubBoxBorderColor)
+	dialog.initTable()
+
+	// summary
+	dialog.summary.SetBackgroundColor(bgColor)
+	dialog.summary.SetTextColor(fgColor)
+	dialog.summary.SetDynamicColors(true)
+
+	// form
+	dialog.form.AddButton("Cancel", nil)
+	dialog.form.AddButton("Compare", nil)
+	dialog.form.SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	layout := tview.NewFlex().SetDirection(tview.FlexRow)
+	layout.SetBackgroundColor(bgColor)
+	layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	layout.AddItem(dialog.left, 1, 0, false)
+	layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	layout.AddItem(dialog.right, 1, 0, true)
+	layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	layout.AddItem(dialog.diffOnly, 1, 0, true)
+	layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	layout.AddItem(dialog.table, 0, 1, true)
+	layout.AddItem(dialog.summary, 1, 0, false)
+
+	mainLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	mainLayout.SetBackgroundColor(bgColor)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainLayout.AddItem(layout, 0, 1, true)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.AddItem(mainLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, DialogFormHeight, 0, true)
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *CompareDialog) Display() {
+	d.display = true
+	d.focusElement = compareRightFocus
+
+	d.diffOnly.SetChecked(true)
+	d.SetResults(nil)
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *CompareDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *CompareDialog) Hide() {
+	d.display = false
+	d.focusElement = compareRightFocus
+}
+
+// SetTitle sets dialog title.
+func (d *CompareDialog) SetTitle(title string) {
+	d.layout.SetTitle(strings.ToUpper(title))
+}
+
+// SetLeftItem sets the selected item which will be compared.
+func (d *CompareDialog) SetLeftItem(id string, name string) {
+	d.leftName = name
+	d.left.SetText(fmt.Sprintf("%12s (%s)", utils.GetIDWithLimit(id), name))
+}
+
+// SetRightItems sets the list of items (id and name) to compare with.
+func (d *CompareDialog) SetRightItems(items [][]string) {
+	d.rightItems = items
+	options := make([]string, 0, len(items))
+
+	for _, item := range items {
+		options = append(options, fmt.Sprintf("%12s (%s)", utils.GetIDWithLimit(item[0]), item[1]))
+	}
+
+	d.right.SetOptions(options, nil)
+
+	if len(options) > 0 {
+		d.right.SetCurrentOption(0)
+	}
+}
+
+// GetRightItem returns the selected item (id and name) to compare with.
+func (d *CompareDialog) GetRightItem() (string, string) {
+	index, _ := d.right.GetCurrentOption()
+	if index < 0 || index >= len(d.rightItems) {
+		return "", ""
+	}
+
+	return d.rightItems[index][0], d.rightItems[index][1]
+}
+
+// SetResults sets compare results.
+func (d *CompareDialog) SetResults(entries []putils.CompareEntry) {
+	d.entries = entries
+	d.updateTable()
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *CompareDialog) HasFocus() bool {
+	if d.right.HasFocus() || d.diffOnly.HasFocus() {
+		return true
+	}
+
+	if d.table.HasFocus() || d.form.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus() || d.layout.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *CompareDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case compareRightFocus:
+		delegate(d.right)
+	case compareDiffOnlyFocus:
+		delegate(d.diffOnly)
+	case compareTableFocus:
+		delegate(d.table)
+	case compareFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = compareRightFocus
+
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *CompareDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("compare dialog: event %v received", event)
+
+		if event.Key() == utils.CloseDialogKey.Key && !d.right.HasFocus() {
+			d.cancelHandler()
+
+			return
+		}
+
+		if event.Key() == utils.SwitchFocusKey.Key && !d.form.HasFocus() {
+			d.setFocusElement()
+			d.Focus(setFocus)
+
+			return
+		}
+
+		if d.right.HasFocus() {
+			if event.Key() == utils.CloseDialogKey.Key && !d.right.IsOpen() {
+				d.cancelHandler()
+
+				return
+			}
+
+			if handler := d.right.InputHandler(); handler != nil {
+				handler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.diffOnly.HasFocus() {
+			if handler := d.diffOnly.InputHandler(); handler != nil {
+				handler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.table.HasFocus() {
+			if handler := d.table.InputHandler(); handler != nil {
+				handler(utils.ParseKeyEventKey(event), setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if handler := d.form.InputHandler(); handler != nil {
+				handler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+// SetRect set rects for this primitive.
+func (d *CompareDialog) SetRect(x, y, width, height int) {
+	if width > compareDialogMaxWidth {
+		emptySpace := (width - compareDialogMaxWidth) / 2 //nolint:mnd
+		x += emptySpace
+		width = compareDialogMaxWidth
+	}
+
+	if height > compareDialogMaxHeight {
+		emptySpace := (height - compareDialogMaxHeight) / 2 //nolint:mnd
+		y += emptySpace
+		height = compareDialogMaxHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *CompareDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.DrawForSubclass(screen, d)
+
+	x, y, width, height := d.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetCompareFunc sets form compare button selected function.
+func (d *CompareDialog) SetCompareFunc(handler func()) *CompareDialog {
+	d.compareHandler = handler
+	compareButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+	compareButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *CompareDialog) SetCancelFunc(handler func()) *CompareDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 2) //nolint:mnd
+
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+func (d *CompareDialog) setFocusElement() {
+	switch d.focusElement {
+	case compareRightFocus:
+		d.focusElement = compareDiffOnlyFocus
+	case compareDiffOnlyFocus:
+		d.focusElement = compareTableFocus
+	case compareTableFocus:
+		d.focusElement = compareFormFocus
+	}
+}
+
+func (d *CompareDialog) initTable() {
+	bgColor := style.TableHeaderBgColor
+	fgColor := style.TableHeaderFgColor
+	_, rightName := d.GetRightItem()
+
+	d.table.Clear()
+	d.table.SetFixed(1, 0)
+	d.table.SetSelectable(true, false)
+
+	headers := []string{"FIELD", "LEFT", "RIGHT"}
+
+	if d.entries != nil {
+		headers = []string{"FIELD", strings.ToUpper(d.leftName), strings.ToUpper(rightName)}
+	}
+
+	for i, header := range headers {
+		d.table.SetCell(0, i,
+			tview.NewTableCell(fmt.Sprintf("[%s::b]%s", style.GetColorHex(fgColor), tview.Escape(header))).
+				SetExpansion(1).
+				SetMaxWidth(compareDialogValueMaxWidth).
+				SetBackgroundColor(bgColor).
+				SetTextColor(fgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
+	}
+}
+
+func (d *CompareDialog) updateTable() {
+	d.initTable()
+
+	row := 1
+	section := ""
+
+	for _, entry := range d.entries {
+		if d.diffOnly.IsChecked() && !entry.Differs() {
+			continue
+		}
+
+		if entry.Section != section {
+			section = entry.Section
+
+			d.table.SetCell(row, 0,
+				tview.NewTableCell(fmt.Sprintf("[::b]%s", strings.ToUpper(section))).
+					SetTextColor(style.DialogSubBoxBorderColor).
+					SetSelectable(false))
+
+			row++
+		}
+
+		color := style.DialogFgColor
+		if entry.Differs() {
+			color = style.PrgBarWarnColor
+		}
+
+		for col, value := range []string{"  " + entry.Field, entry.Left, entry.Right} {
+			if value == "" {
+				value = "-"
+			}
+
+			d.table.SetCell(row, col,
+				tview.NewTableCell(tview.Escape(value)).
+					SetExpansion(1).
+					SetMaxWidth(compareDialogValueMaxWidth).
+					SetAlign(tview.AlignLeft).
+					SetTextColor(color))
+		}
+
+		row++
+	}
+
+	// first row is section name
+	if row > 2 { //nolint:mnd
+		d.table.Select(2, 0) //nolint:mnd
+		d.table.ScrollToBeginning()
+	}
+
+	if d.entries == nil {
+		d.summary.SetText("")
+
+		return
+	}
+
+	d.summary.SetText(fmt.Sprintf("[::b]DIFFERENCES:[::-] %d of %d field(s)",
+		putils.CompareReportDiffers(d.entries), len(d.entries)))
+}
@@ -0,0 +1,128 @@
+package dialogs
+
+import (
+	"strings"
+
+	putils "github.com/containers/podman-tui/pdcs/utils"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("compare dialog", Ordered, func() {
+	var compareDialogApp *tview.Application
+	var compareDialogScreen tcell.SimulationScreen
+	var compareDialog *CompareDialog
+	var runApp func()
+
+	BeforeAll(func() {
+		compareDialogApp = tview.NewApplication()
+		compareDialog = NewCompareDialog()
+		compareDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := compareDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := compareDialogApp.SetScreen(compareDialogScreen).SetRoot(compareDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		compareDialog.Display()
+		Expect(compareDialog.IsDisplay()).To(Equal(true))
+	})
+
+	It("set focus", func() {
+		compareDialogApp.SetFocus(compareDialog)
+		Expect(compareDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("set title", func() {
+		title := "podman image compare"
+		compareDialog.SetTitle(title)
+		Expect(compareDialog.layout.GetTitle()).To(Equal(strings.ToUpper(title)))
+	})
+
+	It("set items", func() {
+		compareDialog.SetLeftItem("a1b2c3d4e5f6a7b8", "web01")
+		compareDialog.SetRightItems([][]string{
+			{"b1b2c3d4e5f6a7b8", "web02"},
+			{"c1b2c3d4e5f6a7b8", "web03"},
+		})
+
+		id, name := compareDialog.GetRightItem()
+		Expect(id).To(Equal("b1b2c3d4e5f6a7b8"))
+		Expect(name).To(Equal("web02"))
+	})
+
+	It("set results", func() {
+		entries := []putils.CompareEntry{
+			putils.CompareValue("config", "user", "root", "root"),
+			putils.CompareValue("config", "workdir", "/app", "/srv"),
+		}
+		entries = append(entries, putils.CompareMaps("env",
+			putils.KeyValueToMap([]string{"PATH=/bin", "MODE=dev"}),
+			putils.KeyValueToMap([]string{"PATH=/bin"}))...)
+
+		compareDialog.SetResults(entries)
+
+		// differences only: 2 section rows and 2 differing fields
+		Expect(compareDialog.table.GetRowCount()).To(Equal(5))
+		Expect(compareDialog.table.GetCell(0, 1).Text).To(ContainSubstring("WEB01"))
+		Expect(compareDialog.table.GetCell(2, 0).Text).To(Equal("  workdir"))
+		Expect(compareDialog.table.GetCell(4, 2).Text).To(Equal("-"))
+		Expect(compareDialog.summary.GetText(true)).To(ContainSubstring("2 of 4 field(s)"))
+	})
+
+	It("show all fields", func() {
+		compareDialog.focusElement = compareDiffOnlyFocus
+		compareDialogApp.SetFocus(compareDialog)
+		compareDialogApp.Draw()
+		compareDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		compareDialogApp.Draw()
+		Expect(compareDialog.diffOnly.IsChecked()).To(Equal(false))
+		Expect(compareDialog.table.GetRowCount()).To(Equal(7))
+	})
+
+	It("compare button selected", func() {
+		compared := false
+		compareDialog.SetCompareFunc(func() {
+			compared = true
+		})
+		compareDialog.focusElement = compareFormFocus
+		compareDialogApp.SetFocus(compareDialog)
+		compareDialogApp.Draw()
+		compareDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		compareDialogApp.Draw()
+		compareDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		compareDialogApp.Draw()
+		Expect(compared).To(Equal(true))
+	})
+
+	It("cancel button selected", func() {
+		cancelAction := "initial"
+		cancelWants := "cancel selected"
+		compareDialog.SetCancelFunc(func() {
+			cancelAction = cancelWants
+		})
+		compareDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
+		compareDialogApp.Draw()
+		Expect(cancelAction).To(Equal(cancelWants))
+	})
+
+	It("hide", func() {
+		compareDialog.Hide()
+		Expect(compareDialog.IsDisplay()).To(Equal(false))
+	})
+
+	AfterAll(func() {
+		compareDialogApp.Stop()
+	})
+})
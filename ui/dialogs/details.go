@@ -0,0 +1,291 @@
+package dialogs
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	putils "github.com/containers/podman-tui/pdcs/utils"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	detailsPanelMinWidth   = 40
+	detailsPanelMinHeight  = 8
+	detailsPanelFieldWidth = 12
+)
+
+type detailsPanelMode int
+
+const (
+	detailsPanelHidden detailsPanelMode = 0 + iota
+	detailsPanelRight
+	detailsPanelBottom
+)
+
+// DetailsPanel is a side (or bottom) panel which summarises the selected table row.
+// The details are loaded in background whenever the selected item changes, the panel
+// is shown or the data is invalidated and the refresh handler is called once loaded.
+type DetailsPanel struct {
+	*tview.Box
+
+	view           *tview.TextView
+	mode           detailsPanelMode
+	loadFunc       func(id string) ([]putils.DetailsEntry, error)
+	selectedFunc   func() string
+	refreshHandler func()
+	mu             sync.Mutex
+	selectedID     string
+	loadedID       string
+	loading        bool
+	outdated       bool
+}
+
+// NewDetailsPanel returns new details panel primitive.
+func NewDetailsPanel() *DetailsPanel {
+	panel := &DetailsPanel{
+		Box:  tview.NewBox(),
+		view: tview.NewTextView(),
+		mode: detailsPanelHidden,
+	}
+
+	panel.view.SetDynamicColors(true)
+	panel.view.SetWrap(true)
+	panel.view.SetBorder(true)
+	panel.view.SetBorderColor(style.BorderColor)
+	panel.view.SetTitleColor(style.FgColor)
+	panel.view.SetTextColor(style.FgColor)
+	panel.view.SetBackgroundColor(style.BgColor)
+	panel.view.SetTitle("DETAILS")
+
+	return panel
+}
+
+// SetTitle sets details panel title.
+func (d *DetailsPanel) SetTitle(title string) {
+	d.view.SetTitle(strings.ToUpper(title))
+}
+
+// SetLoadFunc sets the function which returns details report of an item.
+func (d *DetailsPanel) SetLoadFunc(handler func(id string) ([]putils.DetailsEntry, error)) {
+	d.loadFunc = handler
+}
+
+// SetSelectedItemFunc sets the function which returns the selected item ID.
+// It is used to load the details when the panel is shown or invalidated.
+func (d *DetailsPanel) SetSelectedItemFunc(handler func() string) {
+	d.selectedFunc = handler
+}
+
+// SetRefreshFunc sets the function which is called when the details have been loaded.
+func (d *DetailsPanel) SetRefreshFunc(handler func()) {
+	d.refreshHandler = handler
+}
+
+// Toggle switches the panel between right, bottom and hidden layouts.
+func (d *DetailsPanel) Toggle() {
+	d.mu.Lock()
+
+	switch d.mode {
+	case detailsPanelHidden:
+		d.mode = detailsPanelRight
+		d.outdated = true
+	case detailsPanelRight:
+		d.mode = detailsPanelBottom
+	default:
+		d.mode = detailsPanelHidden
+	}
+
+	d.mu.Unlock()
+
+	if d.IsDisplay() {
+		d.Update(d.selectedItem())
+	}
+}
+
+// IsDisplay returns true if the panel is shown.
+func (d *DetailsPanel) IsDisplay() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.mode != detailsPanelHidden
+}
+
+// Invalidate marks current details as outdated and reloads the selected item details.
+func (d *DetailsPanel) Invalidate() {
+	d.mu.Lock()
+	d.outdated = true
+	d.mu.Unlock()
+
+	d.Update(d.selectedItem())
+}
+
+// selectedItem returns the selected item ID from the selected item function
+// or the last updated one.
+func (d *DetailsPanel) selectedItem() string {
+	if d.selectedFunc != nil {
+		return d.selectedFunc()
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.selectedID
+}
+
+// Split sets the panel position inside of the specified area and returns
+// the remaining area for the table.
+func (d *DetailsPanel) Split(x int, y int, width int, height int) (int, int, int, int) {
+	d.mu.Lock()
+	mode := d.mode
+	d.mu.Unlock()
+
+	switch mode {
+	case detailsPanelRight:
+		panelWidth := max(width*2/5, detailsPanelMinWidth) //nolint:mnd
+		if panelWidth >= width {
+			return x, y, width, height
+		}
+
+		d.SetRect(x+width-panelWidth, y, panelWidth, height)
+
+		return x, y, width - panelWidth, height
+	case detailsPanelBottom:
+		panelHeight := max(height*2/5, detailsPanelMinHeight) //nolint:mnd
+		if panelHeight >= height {
+			return x, y, width, height
+		}
+
+		d.SetRect(x, y+height-panelHeight, width, panelHeight)
+
+		return x, y, width, height - panelHeight
+	default:
+		return x, y, width, height
+	}
+}
+
+// Update sets the selected item and loads its details in background if they are
+// not already loaded or the loaded details are outdated.
+func (d *DetailsPanel) Update(id string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.selectedID = id
+
+	if d.mode == detailsPanelHidden || d.loadFunc == nil {
+		return
+	}
+
+	if id == "" {
+		d.loadedID = ""
+		d.view.Clear()
+
+		return
+	}
+
+	if d.loading || (id == d.loadedID && !d.outdated) {
+		return
+	}
+
+	d.loading = true
+	d.outdated = false
+
+	go d.load(id)
+}
+
+func (d *DetailsPanel) load(id string) {
+	log.Debug().Msgf("view: details panel loading %s", id)
+
+	report, err := d.loadFunc(id)
+
+	d.mu.Lock()
+
+	d.loading = false
+
+	// selection has been changed (or invalidated) during loading, load it again
+	if id != d.selectedID || d.outdated {
+		selectedID := d.selectedID
+
+		d.mu.Unlock()
+		d.Update(selectedID)
+
+		return
+	}
+
+	d.loadedID = id
+
+	if err != nil {
+		d.view.SetText(fmt.Sprintf("[%s::]%s[-::]", style.GetColorHex(style.PrgBarCritColor), tview.Escape(err.Error())))
+	} else {
+		d.setDetails(report)
+	}
+
+	d.mu.Unlock()
+
+	if d.refreshHandler != nil {
+		d.refreshHandler()
+	}
+}
+
+// SetDetails sets details panel report.
+func (d *DetailsPanel) SetDetails(report []putils.DetailsEntry) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.setDetails(report)
+}
+
+func (d *DetailsPanel) setDetails(report []putils.DetailsEntry) {
+	var text strings.Builder
+
+	sectionColor := style.GetColorHex(style.HelpHeaderFgColor)
+	section := ""
+
+	for _, entry := range report {
+		if entry.Section != section {
+			if section != "" {
+				text.WriteString("\n")
+			}
+
+			section = entry.Section
+			text.WriteString(fmt.Sprintf("[%s::b]%s[-::-]\n", sectionColor, strings.ToUpper(section)))
+		}
+
+		value := entry.Value
+		if value == "" {
+			value = "-"
+		}
+
+		if entry.Field == "" {
+			text.WriteString(fmt.Sprintf(" %s\n", tview.Escape(value)))
+
+			continue
+		}
+
+		text.WriteString(fmt.Sprintf(" [::b]%-*s[::-] %s\n",
+			detailsPanelFieldWidth, tview.Escape(entry.Field)+":", tview.Escape(value)))
+	}
+
+	d.view.SetText(text.String())
+	d.view.ScrollToBeginning()
+}
+
+// GetText returns the panel text without color tags.
+func (d *DetailsPanel) GetText() string {
+	return d.view.GetText(true)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *DetailsPanel) Draw(screen tcell.Screen) {
+	if !d.IsDisplay() {
+		return
+	}
+
+	x, y, width, height := d.GetRect()
+
+	d.view.SetRect(x, y, width, height)
+	d.view.Draw(screen)
+}
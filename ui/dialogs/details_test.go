@@ -0,0 +1,170 @@
+package dialogs
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	putils "github.com/containers/podman-tui/pdcs/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("details panel", Ordered, func() {
+	var detailsPanel *DetailsPanel
+
+	var loadMu sync.Mutex
+
+	loadCalls := make(map[string]int)
+	loadCount := func(id string) int {
+		loadMu.Lock()
+		defer loadMu.Unlock()
+
+		return loadCalls[id]
+	}
+
+	BeforeAll(func() {
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+
+		detailsPanel = NewDetailsPanel()
+		detailsPanel.SetLoadFunc(func(id string) ([]putils.DetailsEntry, error) {
+			loadMu.Lock()
+			loadCalls[id]++
+			loadMu.Unlock()
+
+			if id == "broken" {
+				return nil, errors.New("no such container")
+			}
+
+			report := []putils.DetailsEntry{
+				putils.DetailsValue("container", "name", id),
+				putils.DetailsValue("container", "health", ""),
+			}
+			report = append(report, putils.DetailsMap("ports", map[string]string{"80/tcp": "0.0.0.0:8080"})...)
+			report = append(report, putils.DetailsList("logs", []string{"listening on :80"})...)
+
+			return report, nil
+		})
+	})
+
+	It("hidden by default", func() {
+		Expect(detailsPanel.IsDisplay()).To(Equal(false))
+
+		x, y, w, h := detailsPanel.Split(0, 0, 120, 40)
+		Expect([]int{x, y, w, h}).To(Equal([]int{0, 0, 120, 40}))
+
+		detailsPanel.Update("web01")
+		Expect(loadCount("web01")).To(Equal(0))
+	})
+
+	It("toggle right and bottom layout", func() {
+		detailsPanel.Toggle()
+		Expect(detailsPanel.IsDisplay()).To(Equal(true))
+
+		_, _, w, h := detailsPanel.Split(0, 0, 120, 40)
+		Expect([]int{w, h}).To(Equal([]int{72, 40}))
+
+		detailsPanel.Toggle()
+		_, _, w, h = detailsPanel.Split(0, 0, 120, 40)
+		Expect([]int{w, h}).To(Equal([]int{120, 24}))
+
+		// too small screen for the details panel
+		_, _, w, h = detailsPanel.Split(0, 0, 120, 8)
+		Expect([]int{w, h}).To(Equal([]int{120, 8}))
+	})
+
+	It("load details of selected item", func() {
+		detailsPanel.Update("web01")
+		Eventually(detailsPanel.GetText, time.Second).Should(ContainSubstring("name:        web01"))
+		Expect(detailsPanel.GetText()).To(ContainSubstring("health:      -"))
+		Expect(detailsPanel.GetText()).To(ContainSubstring("PORTS\n 80/tcp:      0.0.0.0:8080"))
+		Expect(detailsPanel.GetText()).To(ContainSubstring("LOGS\n listening on :80"))
+
+		// already loaded details are not reloaded
+		detailsPanel.Update("web01")
+		Consistently(func() int { return loadCount("web01") }, 100*time.Millisecond).Should(Equal(1))
+	})
+
+	It("reload outdated details", func() {
+		detailsPanel.Invalidate()
+		detailsPanel.Update("web01")
+		Eventually(func() int { return loadCount("web01") }, time.Second).Should(Equal(2))
+	})
+
+	It("display load error", func() {
+		Eventually(func() bool {
+			detailsPanel.Update("broken")
+
+			return loadCount("broken") > 0
+		}, time.Second).Should(Equal(true))
+		Eventually(detailsPanel.GetText, time.Second).Should(ContainSubstring("no such container"))
+	})
+
+	It("clear details if nothing is selected", func() {
+		detailsPanel.Update("")
+		Expect(detailsPanel.GetText()).To(Equal(""))
+	})
+
+	It("hide", func() {
+		detailsPanel.Toggle()
+		Expect(detailsPanel.IsDisplay()).To(Equal(false))
+	})
+})
+
+var _ = Describe("details panel events", func() {
+	It("load selected item when shown and notify refresh", func() {
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+
+		var mu sync.Mutex
+
+		selectedItem := "web01"
+		refreshCount := 0
+		release := make(chan struct{})
+
+		detailsPanel := NewDetailsPanel()
+		detailsPanel.SetSelectedItemFunc(func() string {
+			mu.Lock()
+			defer mu.Unlock()
+
+			return selectedItem
+		})
+		detailsPanel.SetRefreshFunc(func() {
+			mu.Lock()
+			defer mu.Unlock()
+
+			refreshCount++
+		})
+		detailsPanel.SetLoadFunc(func(id string) ([]putils.DetailsEntry, error) {
+			if id == "web01" {
+				<-release
+			}
+
+			return []putils.DetailsEntry{putils.DetailsValue("container", "name", id)}, nil
+		})
+
+		refreshes := func() int {
+			mu.Lock()
+			defer mu.Unlock()
+
+			return refreshCount
+		}
+
+		// nothing is loaded while the panel is hidden
+		detailsPanel.Update("db01")
+		Expect(detailsPanel.GetText()).To(Equal(""))
+
+		detailsPanel.Toggle()
+
+		// selection changes during loading, the new selection is loaded once done
+		mu.Lock()
+		selectedItem = "db01"
+		mu.Unlock()
+
+		detailsPanel.Update("db01")
+		close(release)
+
+		Eventually(detailsPanel.GetText, time.Second).Should(ContainSubstring("name:        db01"))
+		Eventually(refreshes, time.Second).Should(Equal(1))
+	})
+})
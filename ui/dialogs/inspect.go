@@ -0,0 +1,774 @@
+package dialogs
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	inspectDialogLabelWidth = 9
+	inspectFilePerm         = 0o600
+)
+
+const (
+	inspectTreeFocus = 0 + iota
+	inspectSearchFocus
+	inspectSavePathFocus
+	inspectFormFocus
+)
+
+const (
+	inspectValueNode = 0 + iota
+	inspectObjectNode
+	inspectArrayNode
+)
+
+const (
+	inspectFormatJSON = "json"
+	inspectFormatYAML = "yaml"
+)
+
+var (
+	errInspectEmptySavePath = errors.New("empty save path")
+	errInspectNoDocument    = errors.New("there is no inspect document to save")
+	inspectKeyIdentifier    = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// InspectDialog implements inspect dialog primitive.
+// It shows the inspect JSON document as a collapsible tree with search,
+// copy of node path or value and save of the document as JSON or YAML.
+type InspectDialog struct {
+	*tview.Box
+
+	layout        *tview.Flex
+	infoType      *tview.InputField
+	search        *tview.InputField
+	tree          *tview.TreeView
+	hint          *tview.TextView
+	status        *tview.TextView
+	savePath      *tview.InputField
+	form          *tview.Form
+	document      *inspectNode
+	matches       []*inspectNode
+	matchIndex    int
+	clipboard     []byte
+	display       bool
+	focusElement  int
+	cancelHandler func()
+}
+
+// inspectNode implements an inspect JSON document node with preserved keys order.
+type inspectNode struct {
+	key      string
+	path     string
+	kind     int
+	value    string
+	children []*inspectNode
+	parent   *inspectNode
+	treeNode *tview.TreeNode
+}
+
+// NewInspectDialog returns new inspect dialog primitive.
+func NewInspectDialog() *InspectDialog {
+	dialog := &InspectDialog{
+		Box:      tview.NewBox(),
+		layout:   tview.NewFlex().SetDirection(tview.FlexRow),
+		infoType: tview.NewInputField(),
+		search:   tview.NewInputField(),
+		tree:     tview.NewTreeView(),
+		hint:     tview.NewTextView(),
+		status:   tview.NewTextView(),
+		savePath: tview.NewInputField(),
+		form:     tview.NewForm(),
+	}
+
+	bgColor := style.DialogBgColor
+
+	// info type field
+	dialog.infoType.SetBackgroundColor(bgColor)
+	dialog.infoType.SetFieldBackgroundColor(bgColor)
+	dialog.infoType.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(style.DialogFgColor))
+
+	// search field
+	dialog.search.SetBackgroundColor(bgColor)
+	dialog.search.SetLabel(utils.StringToInputLabel("search:", inspectDialogLabelWidth))
+	dialog.search.SetPlaceholder("key or value")
+	dialog.search.SetPlaceholderStyle(style.InputFieldStyle.Foreground(style.DialogSubBoxBorderColor))
+	dialog.search.SetFieldStyle(style.InputFieldStyle)
+	dialog.search.SetLabelStyle(style.InputLabelStyle)
+	dialog.search.SetChangedFunc(func(_ string) {
+		dialog.matches = nil
+	})
+
+	// inspect tree
+	dialog.tree.SetBackgroundColor(bgColor)
+	dialog.tree.SetBorder(true)
+	dialog.tree.SetBorderColor(style.DialogSubBoxBorderColor)
+	dialog.tree.SetGraphicsColor(style.DialogSubBoxBorderColor)
+	dialog.tree.SetSelectedFunc(func(node *tview.TreeNode) {
+		node.SetExpanded(!node.IsExpanded())
+	})
+
+	// hint and status
+	dialog.hint.SetBackgroundColor(bgColor)
+	dialog.hint.SetTextColor(style.DialogFgColor)
+	dialog.hint.SetDynamicColors(true)
+	dialog.hint.SetText("[::b]/:[::-] search  [::b]n/N:[::-] next/previous  [::b]p:[::-] copy path  " +
+		"[::b]y:[::-] copy value  [::b]e/c:[::-] expand/collapse all")
+
+	dialog.status.SetBackgroundColor(bgColor)
+	dialog.status.SetTextColor(style.DialogFgColor)
+	dialog.status.SetDynamicColors(true)
+
+	// save path field
+	dialog.savePath.SetBackgroundColor(bgColor)
+	dialog.savePath.SetLabel(utils.StringToInputLabel("save to:", inspectDialogLabelWidth))
+	dialog.savePath.SetFieldStyle(style.InputFieldStyle)
+	dialog.savePath.SetLabelStyle(style.InputLabelStyle)
+
+	// form
+	dialog.form.AddButton("Save JSON", func() {
+		dialog.saveDocument(inspectFormatJSON)
+	})
+	dialog.form.AddButton("Save YAML", func() {
+		dialog.saveDocument(inspectFormatYAML)
+	})
+	dialog.form.AddButton("Cancel", nil)
+	dialog.form.SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	layout := tview.NewFlex().SetDirection(tview.FlexRow)
+	layout.SetBackgroundColor(bgColor)
+	layout.AddItem(dialog.infoType, 1, 0, false)
+	layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	layout.AddItem(dialog.search, 1, 0, false)
+	layout.AddItem(dialog.tree, 0, 1, true)
+	layout.AddItem(dialog.hint, 1, 0, false)
+	layout.AddItem(dialog.status, 1, 0, false)
+	layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	layout.AddItem(dialog.savePath, 1, 0, false)
+
+	mainLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	mainLayout.SetBackgroundColor(bgColor)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainLayout.AddItem(layout, 0, 1, true)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.AddItem(mainLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, DialogFormHeight, 0, true)
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *InspectDialog) Display() {
+	d.display = true
+	d.focusElement = inspectTreeFocus
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *InspectDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *InspectDialog) Hide() {
+	d.display = false
+	d.focusElement = inspectTreeFocus
+	d.document = nil
+	d.matches = nil
+
+	d.search.SetText("")
+	d.status.SetText("")
+	d.tree.SetRoot(nil)
+}
+
+// SetTitle sets dialog title.
+func (d *InspectDialog) SetTitle(title string) {
+	d.layout.SetTitle(strings.ToUpper(title))
+}
+
+// SetData sets inspect dialog header and JSON document.
+func (d *InspectDialog) SetData(headerType messageInfo, headerMessage string, data string) {
+	d.infoType.SetLabel("[::b]" + messageInfoLabel(headerType))
+	d.infoType.SetText(" " + headerMessage)
+
+	d.matches = nil
+	d.search.SetText("")
+	d.status.SetText("")
+
+	// default save path from the header resource ID or name
+	saveName := "inspect"
+	if fields := strings.Fields(headerMessage); len(fields) > 0 {
+		saveName = fields[0] + "-inspect"
+	}
+
+	d.savePath.SetText(filepath.Join("~", saveName+"."+inspectFormatJSON))
+
+	document, err := parseInspectDocument(data)
+	if err != nil {
+		log.Error().Msgf("inspect dialog: failed to parse document: %v", err)
+
+		d.document = nil
+		d.status.SetText(fmt.Sprintf("[red::]%s", tview.Escape(err.Error())))
+		d.tree.SetRoot(tview.NewTreeNode(tview.Escape(strings.TrimSpace(data))))
+
+		return
+	}
+
+	d.document = document
+
+	root := tview.NewTreeNode(".").
+		SetReference(document).
+		SetColor(style.DialogFgColor).
+		SetExpanded(true)
+
+	for _, child := range document.children {
+		root.AddChild(child.newTreeNode())
+	}
+
+	document.treeNode = root
+
+	d.tree.SetRoot(root)
+	d.tree.SetCurrentNode(root)
+
+	if len(root.GetChildren()) > 0 {
+		d.tree.SetCurrentNode(root.GetChildren()[0])
+	}
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *InspectDialog) HasFocus() bool {
+	if d.tree.HasFocus() || d.search.HasFocus() || d.savePath.HasFocus() || d.form.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus() || d.layout.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *InspectDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case inspectTreeFocus:
+		delegate(d.tree)
+	case inspectSearchFocus:
+		delegate(d.search)
+	case inspectSavePathFocus:
+		delegate(d.savePath)
+	case inspectFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = inspectTreeFocus
+
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *InspectDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) { //nolint:cyclop
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("inspect dialog: event %v received", event)
+
+		if event.Key() == utils.CloseDialogKey.Key {
+			d.cancelHandler()
+
+			return
+		}
+
+		if event.Key() == utils.SwitchFocusKey.Key && !d.form.HasFocus() {
+			d.setFocusElement()
+			d.Focus(setFocus)
+
+			return
+		}
+
+		if d.tree.HasFocus() {
+			if d.treeKeyHandler(event, setFocus) {
+				return
+			}
+
+			if treeHandler := d.tree.InputHandler(); treeHandler != nil {
+				treeHandler(utils.ParseKeyEventKey(event), setFocus)
+
+				return
+			}
+		}
+
+		if d.search.HasFocus() {
+			if event.Key() == tcell.KeyEnter {
+				d.nextMatch(1)
+
+				return
+			}
+
+			if searchHandler := d.search.InputHandler(); searchHandler != nil {
+				searchHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.savePath.HasFocus() {
+			if savePathHandler := d.savePath.InputHandler(); savePathHandler != nil {
+				savePathHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+// SetRect set rects for this primitive.
+func (d *InspectDialog) SetRect(x, y, width, height int) {
+	dX := x + 1
+	dY := y + 1
+	dWidth := width - 2   //nolint:mnd
+	dHeight := height - 2 //nolint:mnd
+
+	d.Box.SetRect(dX, dY, dWidth, dHeight)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *InspectDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.DrawForSubclass(screen, d)
+
+	x, y, width, height := d.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+
+	if len(d.clipboard) > 0 {
+		screen.SetClipboard(d.clipboard)
+
+		d.clipboard = nil
+	}
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *InspectDialog) SetCancelFunc(handler func()) *InspectDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+func (d *InspectDialog) setFocusElement() {
+	switch d.focusElement {
+	case inspectTreeFocus:
+		d.focusElement = inspectSearchFocus
+	case inspectSearchFocus:
+		d.focusElement = inspectSavePathFocus
+	case inspectSavePathFocus:
+		d.focusElement = inspectFormFocus
+	}
+}
+
+// treeKeyHandler handles the tree shortcut keys and returns true if the event is handled.
+func (d *InspectDialog) treeKeyHandler(event *tcell.EventKey, setFocus func(p tview.Primitive)) bool {
+	if event.Key() != tcell.KeyRune {
+		return false
+	}
+
+	switch event.Rune() {
+	case '/':
+		d.focusElement = inspectSearchFocus
+		d.Focus(setFocus)
+	case 'n':
+		d.nextMatch(1)
+	case 'N':
+		d.nextMatch(-1)
+	case 'p':
+		if node := d.selectedNode(); node != nil {
+			d.copyToClipboard("path", node.jsonPath())
+		}
+	case 'y':
+		if node := d.selectedNode(); node != nil {
+			d.copyToClipboard("value", node.copyValue())
+		}
+	case 'e':
+		d.expandAll(true)
+	case 'c':
+		d.expandAll(false)
+	default:
+		return false
+	}
+
+	return true
+}
+
+func (d *InspectDialog) selectedNode() *inspectNode {
+	current := d.tree.GetCurrentNode()
+	if current == nil {
+		return nil
+	}
+
+	node, ok := current.GetReference().(*inspectNode)
+	if !ok {
+		return nil
+	}
+
+	return node
+}
+
+// copyToClipboard copies the text to the terminal clipboard (OSC 52) on next draw.
+func (d *InspectDialog) copyToClipboard(name string, text string) {
+	d.clipboard = []byte(text)
+
+	d.status.SetText(fmt.Sprintf("%s copied to clipboard", name))
+}
+
+func (d *InspectDialog) expandAll(expand bool) {
+	if d.document == nil {
+		return
+	}
+
+	for _, child := range d.document.children {
+		child.walk(func(node *inspectNode) {
+			node.treeNode.SetExpanded(expand)
+		})
+	}
+
+	if !expand {
+		// selected node can be hidden by collapse
+		if node := d.selectedNode(); node != nil {
+			for node.parent != nil && node.parent != d.document {
+				node = node.parent
+			}
+
+			d.tree.SetCurrentNode(node.treeNode)
+		}
+	}
+}
+
+// nextMatch selects the next (or previous) node which key or value contains the search text.
+func (d *InspectDialog) nextMatch(direction int) {
+	text := strings.ToLower(strings.TrimSpace(d.search.GetText()))
+	if d.document == nil || text == "" {
+		return
+	}
+
+	if d.matches == nil {
+		d.matches = make([]*inspectNode, 0)
+		d.matchIndex = -1
+
+		for _, child := range d.document.children {
+			child.walk(func(node *inspectNode) {
+				if strings.Contains(strings.ToLower(node.key), text) ||
+					strings.Contains(strings.ToLower(node.value), text) {
+					d.matches = append(d.matches, node)
+				}
+			})
+		}
+	}
+
+	if len(d.matches) == 0 {
+		d.status.SetText(fmt.Sprintf("no match for %q", tview.Escape(text)))
+
+		return
+	}
+
+	d.matchIndex = (d.matchIndex + direction + len(d.matches)) % len(d.matches)
+	match := d.matches[d.matchIndex]
+
+	// expands the parents of the matched node
+	for parent := match.parent; parent != nil && parent.treeNode != nil; parent = parent.parent {
+		parent.treeNode.SetExpanded(true)
+	}
+
+	d.tree.SetCurrentNode(match.treeNode)
+	d.status.SetText(fmt.Sprintf("match %d/%d: %s", d.matchIndex+1, len(d.matches), tview.Escape(match.jsonPath())))
+}
+
+func (d *InspectDialog) saveDocument(format string) {
+	path, err := d.saveDocumentPath(format)
+	if err == nil {
+		var data []byte
+
+		data, err = d.documentData(format)
+		if err == nil {
+			err = os.WriteFile(path, data, inspectFilePerm)
+		}
+	}
+
+	if err != nil {
+		log.Error().Msgf("inspect dialog: failed to save document: %v", err)
+		d.status.SetText(fmt.Sprintf("[red::]%s", tview.Escape(err.Error())))
+
+		return
+	}
+
+	d.status.SetText("saved to " + tview.Escape(path))
+}
+
+func (d *InspectDialog) documentData(format string) ([]byte, error) {
+	if d.document == nil {
+		return nil, errInspectNoDocument
+	}
+
+	data := []byte(d.document.indentedJSON() + "\n")
+
+	if format == inspectFormatYAML {
+		return yaml.JSONToYAML(data)
+	}
+
+	return data, nil
+}
+
+// saveDocumentPath returns the save path with home directory (~) expanded
+// and json or yaml extension set for the format.
+func (d *InspectDialog) saveDocumentPath(format string) (string, error) {
+	path := strings.TrimSpace(d.savePath.GetText())
+	if path == "" {
+		return "", errInspectEmptySavePath
+	}
+
+	switch filepath.Ext(path) {
+	case ".json", ".yaml", ".yml":
+		path = strings.TrimSuffix(path, filepath.Ext(path)) + "." + format
+		d.savePath.SetText(path)
+	}
+
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		home, err := utils.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+
+		path = filepath.Join(home, strings.TrimPrefix(path, "~"))
+	}
+
+	return path, nil
+}
+
+// parseInspectDocument parses JSON document and preserves the objects keys order.
+func parseInspectDocument(data string) (*inspectNode, error) {
+	decoder := json.NewDecoder(strings.NewReader(data))
+	decoder.UseNumber()
+
+	document := &inspectNode{}
+	if err := document.decode(decoder); err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
+
+func (n *inspectNode) decode(decoder *json.Decoder) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	switch value := token.(type) {
+	case json.Delim:
+		n.kind = inspectObjectNode
+		if value == '[' {
+			n.kind = inspectArrayNode
+		}
+
+		for index := 0; decoder.More(); index++ {
+			child := &inspectNode{
+				key:    strconv.Itoa(index),
+				path:   fmt.Sprintf("%s[%d]", n.path, index),
+				parent: n,
+			}
+
+			if n.kind == inspectObjectNode {
+				keyToken, err := decoder.Token()
+				if err != nil {
+					return err
+				}
+
+				child.key = fmt.Sprintf("%v", keyToken)
+				child.path = n.path + inspectKeyPath(child.key)
+			}
+
+			if err := child.decode(decoder); err != nil {
+				return err
+			}
+
+			n.children = append(n.children, child)
+		}
+
+		// object or array closing delimiter
+		_, err := decoder.Token()
+
+		return err
+	case string:
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+
+		n.value = string(raw)
+	case json.Number:
+		n.value = value.String()
+	case bool:
+		n.value = strconv.FormatBool(value)
+	case nil:
+		n.value = "null"
+	}
+
+	return nil
+}
+
+// inspectKeyPath returns jq style path of an object key.
+func inspectKeyPath(key string) string {
+	if inspectKeyIdentifier.MatchString(key) {
+		return "." + key
+	}
+
+	raw, _ := json.Marshal(key)
+
+	return "[" + string(raw) + "]"
+}
+
+func (n *inspectNode) newTreeNode() *tview.TreeNode {
+	text := tview.Escape(n.key)
+
+	switch n.kind {
+	case inspectObjectNode:
+		text = fmt.Sprintf("%s {%d}", text, len(n.children))
+	case inspectArrayNode:
+		text = fmt.Sprintf("%s [%d]", text, len(n.children))
+	default:
+		text = fmt.Sprintf("%s: %s", text, tview.Escape(n.value))
+	}
+
+	color := style.DialogFgColor
+	if n.kind != inspectValueNode {
+		color = style.HelpHeaderFgColor
+	}
+
+	n.treeNode = tview.NewTreeNode(text).
+		SetReference(n).
+		SetColor(color).
+		SetSelectable(true).
+		SetExpanded(false)
+
+	for _, child := range n.children {
+		n.treeNode.AddChild(child.newTreeNode())
+	}
+
+	return n.treeNode
+}
+
+func (n *inspectNode) walk(visit func(node *inspectNode)) {
+	visit(n)
+
+	for _, child := range n.children {
+		child.walk(visit)
+	}
+}
+
+func (n *inspectNode) jsonPath() string {
+	if n.path == "" {
+		return "."
+	}
+
+	return n.path
+}
+
+// copyValue returns the node value, unquoted for strings and indented JSON for objects and arrays.
+func (n *inspectNode) copyValue() string {
+	if n.kind != inspectValueNode {
+		return n.indentedJSON()
+	}
+
+	var text string
+	if err := json.Unmarshal([]byte(n.value), &text); err == nil {
+		return text
+	}
+
+	return n.value
+}
+
+func (n *inspectNode) indentedJSON() string {
+	var (
+		builder strings.Builder
+		output  bytes.Buffer
+	)
+
+	n.writeJSON(&builder)
+
+	if err := json.Indent(&output, []byte(builder.String()), "", "  "); err != nil {
+		return builder.String()
+	}
+
+	return output.String()
+}
+
+func (n *inspectNode) writeJSON(builder *strings.Builder) {
+	switch n.kind {
+	case inspectObjectNode, inspectArrayNode:
+		open, closing := "{", "}"
+		if n.kind == inspectArrayNode {
+			open, closing = "[", "]"
+		}
+
+		builder.WriteString(open)
+
+		for i, child := range n.children {
+			if i > 0 {
+				builder.WriteString(",")
+			}
+
+			if n.kind == inspectObjectNode {
+				key, _ := json.Marshal(child.key)
+				builder.Write(key)
+				builder.WriteString(":")
+			}
+
+			child.writeJSON(builder)
+		}
+
+		builder.WriteString(closing)
+	default:
+		builder.WriteString(n.value)
+	}
+}
@@ -0,0 +1,133 @@
+package dialogs
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("inspect dialog", Ordered, func() {
+	var inspectDialogApp *tview.Application
+	var inspectDialogScreen tcell.SimulationScreen
+	var inspectDialog *InspectDialog
+	var runApp func()
+
+	inspectData := `{
+  "Id": "a1b2c3d4e5f6",
+  "Name": "web01",
+  "Config": {
+    "Env": ["PATH=/usr/bin", "MODE=dev"],
+    "Labels": {"io.podman.app": "web"}
+  },
+  "Restarts": 2,
+  "Running": true
+}`
+
+	BeforeAll(func() {
+		inspectDialogApp = tview.NewApplication()
+		inspectDialog = NewInspectDialog()
+		inspectDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := inspectDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := inspectDialogApp.SetScreen(inspectDialogScreen).SetRoot(inspectDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		inspectDialog.SetData(MessageContainerInfo, "a1b2c3d4e5f6 (web01)", inspectData)
+		inspectDialog.Display()
+		inspectDialogApp.Draw()
+		Expect(inspectDialog.IsDisplay()).To(Equal(true))
+		Expect(inspectDialog.savePath.GetText()).To(Equal(filepath.Join("~", "a1b2c3d4e5f6-inspect.json")))
+	})
+
+	It("set focus", func() {
+		inspectDialogApp.SetFocus(inspectDialog)
+		inspectDialogApp.Draw()
+		Expect(inspectDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("preserves keys order", func() {
+		children := inspectDialog.tree.GetRoot().GetChildren()
+		Expect(children).To(HaveLen(5))
+		Expect(children[0].GetText()).To(Equal(`Id: "a1b2c3d4e5f6"`))
+		Expect(children[2].GetText()).To(Equal("Config {2}"))
+		Expect(children[2].IsExpanded()).To(Equal(false))
+		Expect(children[3].GetText()).To(Equal("Restarts: 2"))
+	})
+
+	It("search key and value", func() {
+		inspectDialog.search.SetText("mode")
+		inspectDialog.nextMatch(1)
+		node := inspectDialog.selectedNode()
+		Expect(node).NotTo(BeNil())
+		Expect(node.jsonPath()).To(Equal(".Config.Env[1]"))
+		Expect(node.copyValue()).To(Equal("MODE=dev"))
+		Expect(node.parent.treeNode.IsExpanded()).To(Equal(true))
+
+		inspectDialog.search.SetText("io.podman")
+		inspectDialog.nextMatch(1)
+		node = inspectDialog.selectedNode()
+		Expect(node.jsonPath()).To(Equal(`.Config.Labels["io.podman.app"]`))
+	})
+
+	It("copy object value", func() {
+		inspectDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyUp, 0, tcell.ModNone))
+		inspectDialogApp.Draw()
+		Expect(inspectDialog.selectedNode().jsonPath()).To(Equal(".Config.Labels"))
+
+		inspectDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyRune, 'y', tcell.ModNone))
+		inspectDialogApp.Draw()
+		Expect(inspectDialog.status.GetText(true)).To(Equal("value copied to clipboard"))
+		Expect(inspectDialog.selectedNode().copyValue()).To(Equal("{\n  \"io.podman.app\": \"web\"\n}"))
+	})
+
+	It("save as json and yaml", func() {
+		saveDir := GinkgoT().TempDir()
+		inspectDialog.savePath.SetText(filepath.Join(saveDir, "web01.json"))
+
+		inspectDialog.saveDocument(inspectFormatJSON)
+		data, err := os.ReadFile(filepath.Join(saveDir, "web01.json"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(strings.Index(string(data), `"Id"`)).To(BeNumerically("<", strings.Index(string(data), `"Name"`)))
+
+		inspectDialog.saveDocument(inspectFormatYAML)
+		Expect(inspectDialog.savePath.GetText()).To(Equal(filepath.Join(saveDir, "web01.yaml")))
+		data, err = os.ReadFile(filepath.Join(saveDir, "web01.yaml"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(ContainSubstring("Restarts: 2"))
+	})
+
+	It("cancel button selected", func() {
+		cancelAction := "initial"
+		cancelWants := "cancel selected"
+		inspectDialog.SetCancelFunc(func() {
+			cancelAction = cancelWants
+		})
+		inspectDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
+		inspectDialogApp.Draw()
+		Expect(cancelAction).To(Equal(cancelWants))
+	})
+
+	It("hide", func() {
+		inspectDialog.Hide()
+		Expect(inspectDialog.IsDisplay()).To(Equal(false))
+	})
+
+	AfterAll(func() {
+		inspectDialogApp.Stop()
+	})
+})
@@ -124,26 +124,9 @@ func (d *MessageDialog) SetTitle(title string) {
 
 // SetText sets message dialog text messages.
 func (d *MessageDialog) SetText(headerType messageInfo, headerMessage string, message string) {
-	msgTypeLabel := ""
+	msgTypeLabel := messageInfoLabel(headerType)
 	msgHeader := " " + headerMessage
 
-	switch headerType {
-	case MessageSystemInfo:
-		msgTypeLabel = "SERVICE NAME:"
-	case MessagePodInfo:
-		msgTypeLabel = "POD ID:"
-	case MessageContainerInfo:
-		msgTypeLabel = utils.ContainerIDLabel
-	case MessageVolumeInfo:
-		msgTypeLabel = "VOLUME NAME:"
-	case MessageImageInfo:
-		msgTypeLabel = "IMAGE ID:"
-	case MessageNetworkInfo:
-		msgTypeLabel = "NETWORK ID:"
-	case MessageSecretInfo:
-		msgTypeLabel = "SECRET ID:"
-	}
-
 	if msgTypeLabel != "" {
 		d.infoType.SetLabel("[::b]" + msgTypeLabel)
 		d.infoType.SetText(msgHeader)
@@ -279,3 +262,25 @@ func (d *MessageDialog) SetCancelFunc(handler func()) *MessageDialog {
 
 	return d
 }
+
+// messageInfoLabel returns header label of the message info type.
+func messageInfoLabel(headerType messageInfo) string {
+	switch headerType {
+	case MessageSystemInfo:
+		return "SERVICE NAME:"
+	case MessagePodInfo:
+		return "POD ID:"
+	case MessageContainerInfo:
+		return utils.ContainerIDLabel
+	case MessageVolumeInfo:
+		return "VOLUME NAME:"
+	case MessageImageInfo:
+		return "IMAGE ID:"
+	case MessageNetworkInfo:
+		return "NETWORK ID:"
+	case MessageSecretInfo:
+		return "SECRET ID:"
+	}
+
+	return ""
+}
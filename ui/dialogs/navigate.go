@@ -0,0 +1,337 @@
+package dialogs
+
+import (
+	"fmt"
+	"strings"
+
+	putils "github.com/containers/podman-tui/pdcs/utils"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	navigateDialogMaxWidth  = 100
+	navigateDialogMaxHeight = 20
+)
+
+const (
+	navigateTableFocus = 0 + iota
+	navigateFormFocus
+)
+
+const (
+	navigateKindColIndex = 0 + iota
+	navigateIDColIndex
+	navigateNameColIndex
+	navigateInfoColIndex
+)
+
+// NavigateDialog implements resource references dialog.
+// It lists the related resources and switches to the selected one.
+type NavigateDialog struct {
+	*tview.Box
+
+	layout        *tview.Flex
+	form          *tview.Form
+	info          *tview.InputField
+	table         *tview.Table
+	display       bool
+	focusElement  int
+	tableHeaders  []string
+	references    []putils.ResourceReference
+	goToHandler   func()
+	cancelHandler func()
+}
+
+// NewNavigateDialog returns new resource references dialog primitive.
+func NewNavigateDialog() *NavigateDialog {
+	dialog := &NavigateDialog{
+		Box:          tview.NewBox(),
+		layout:       tview.NewFlex().SetDirection(tview.FlexRow),
+		form:         tview.NewForm(),
+		info:         tview.NewInputField(),
+		table:        tview.NewTable(),
+		tableHeaders: []string{"type", "id", "name", "info"},
+		focusElement: navigateTableFocus,
+	}
+
+	bgColor := style.DialogBgColor
+
+	// resource info field
+	dialog.info.SetBackgroundColor(bgColor)
+	dialog.info.SetFieldBackgroundColor(bgColor)
+	dialog.info.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(style.DialogFgColor))
+
+	// references table
+	dialog.table.SetBackgroundColor(bgColor)
+	dialog.table.SetBorder(true)
+	dialog.table.SetBorderColor(style.DialogSubBoxBorderColor)
+	dialog.table.SetSelectedFunc(func(_, _ int) {
+		if dialog.goToHandler != nil {
+			dialog.goToHandler()
+		}
+	})
+	dialog.initTable()
+
+	// form
+	dialog.form.AddButton("Cancel", nil)
+	dialog.form.AddButton("Go To", nil)
+	dialog.form.SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	optionsLayout := tview.NewFlex().SetDirection(tview.FlexRow)
+
+	optionsLayout.SetBackgroundColor(bgColor)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(dialog.info, 1, 0, false)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(dialog.table, 0, 1, true)
+
+	mainOptsLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+
+	mainOptsLayout.SetBackgroundColor(bgColor)
+	mainOptsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainOptsLayout.AddItem(optionsLayout, 0, 1, true)
+	mainOptsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.AddItem(mainOptsLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, DialogFormHeight, 0, true)
+
+	return dialog
+}
+
+// SetTitle sets title for the dialog.
+func (d *NavigateDialog) SetTitle(title string) {
+	d.layout.SetTitle(strings.ToUpper(title))
+}
+
+// Display displays this primitive.
+func (d *NavigateDialog) Display() {
+	d.display = true
+	d.focusElement = navigateTableFocus
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *NavigateDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *NavigateDialog) Hide() {
+	d.display = false
+	d.focusElement = navigateTableFocus
+
+	d.info.SetText("")
+	d.SetReferences(nil)
+}
+
+// SetRect set rects for this primitive.
+func (d *NavigateDialog) SetRect(x, y, width, height int) {
+	if width > navigateDialogMaxWidth {
+		emptySpace := (width - navigateDialogMaxWidth) / 2 //nolint:mnd
+		x += emptySpace
+		width = navigateDialogMaxWidth
+	}
+
+	if height > navigateDialogMaxHeight {
+		emptySpace := (height - navigateDialogMaxHeight) / 2 //nolint:mnd
+		y += emptySpace
+		height = navigateDialogMaxHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *NavigateDialog) HasFocus() bool {
+	if d.table.HasFocus() || d.form.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus() || d.layout.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *NavigateDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case navigateTableFocus:
+		delegate(d.table)
+	case navigateFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = navigateTableFocus
+
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// Draw draws this primitive into the screen.
+func (d *NavigateDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.DrawForSubclass(screen, d)
+
+	x, y, width, height := d.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *NavigateDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("navigate dialog: event %v received", event)
+
+		if event.Key() == utils.CloseDialogKey.Key {
+			d.cancelHandler()
+
+			return
+		}
+
+		if event.Key() == utils.SwitchFocusKey.Key && d.table.HasFocus() {
+			d.focusElement = navigateFormFocus
+
+			d.Focus(setFocus)
+
+			return
+		}
+
+		if d.table.HasFocus() {
+			if tableHandler := d.table.InputHandler(); tableHandler != nil {
+				tableHandler(utils.ParseKeyEventKey(event), setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+// SetGoToFunc sets form go to button and table selected function.
+func (d *NavigateDialog) SetGoToFunc(handler func()) *NavigateDialog {
+	d.goToHandler = handler
+	goToButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+	goToButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *NavigateDialog) SetCancelFunc(handler func()) *NavigateDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 2) //nolint:mnd
+
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetResourceInfo sets the resource information which references are listed.
+func (d *NavigateDialog) SetResourceInfo(label string, id string, name string) {
+	infoLabel := strings.ToUpper(label) + ":"
+
+	d.info.SetLabel("[::b]" + infoLabel)
+	d.info.SetLabelWidth(len(infoLabel) + 1)
+	if name == "" {
+		d.info.SetText(id)
+
+		return
+	}
+
+	d.info.SetText(fmt.Sprintf("%s (%s)", id, name))
+}
+
+// SetReferences sets list of referenced resources.
+func (d *NavigateDialog) SetReferences(references []putils.ResourceReference) {
+	d.references = references
+
+	d.initTable()
+
+	for i, ref := range references {
+		rowIndex := i + 1
+
+		d.table.SetCell(rowIndex, navigateKindColIndex,
+			tview.NewTableCell(ref.Kind).
+				SetExpansion(0).
+				SetAlign(tview.AlignLeft))
+
+		d.table.SetCell(rowIndex, navigateIDColIndex,
+			tview.NewTableCell(utils.GetIDWithLimit(ref.ID)).
+				SetExpansion(1).
+				SetAlign(tview.AlignLeft))
+
+		d.table.SetCell(rowIndex, navigateNameColIndex,
+			tview.NewTableCell(ref.Name).
+				SetExpansion(1).
+				SetAlign(tview.AlignLeft))
+
+		d.table.SetCell(rowIndex, navigateInfoColIndex,
+			tview.NewTableCell(ref.Info).
+				SetExpansion(1).
+				SetAlign(tview.AlignLeft))
+	}
+
+	if len(references) > 0 {
+		d.table.Select(1, 0)
+		d.table.ScrollToBeginning()
+	}
+}
+
+// GetSelectedReference returns the selected resource reference.
+func (d *NavigateDialog) GetSelectedReference() (putils.ResourceReference, bool) {
+	row, _ := d.table.GetSelection()
+	if row < 1 || row > len(d.references) {
+		return putils.ResourceReference{}, false
+	}
+
+	return d.references[row-1], true
+}
+
+func (d *NavigateDialog) initTable() {
+	bgColor := style.TableHeaderBgColor
+	fgColor := style.TableHeaderFgColor
+
+	d.table.Clear()
+	d.table.SetFixed(1, 1)
+	d.table.SetSelectable(true, false)
+
+	for i := range d.tableHeaders {
+		d.table.SetCell(0, i,
+			tview.NewTableCell(fmt.Sprintf("[%s::b]%s", style.GetColorHex(fgColor), strings.ToUpper(d.tableHeaders[i]))).
+				SetExpansion(1).
+				SetBackgroundColor(bgColor).
+				SetTextColor(fgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
+	}
+}
@@ -0,0 +1,107 @@
+package dialogs
+
+import (
+	"strings"
+
+	putils "github.com/containers/podman-tui/pdcs/utils"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("navigate dialog", Ordered, func() {
+	var navigateDialogApp *tview.Application
+	var navigateDialogScreen tcell.SimulationScreen
+	var navigateDialog *NavigateDialog
+	var runApp func()
+
+	references := []putils.ResourceReference{
+		{Kind: putils.ResourceKindPod, ID: "8d3a5e1b0c3f", Name: "pod01"},
+		{Kind: putils.ResourceKindVolume, Name: "vol01", Info: "/data"},
+	}
+
+	BeforeAll(func() {
+		navigateDialogApp = tview.NewApplication()
+		navigateDialog = NewNavigateDialog()
+		navigateDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := navigateDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := navigateDialogApp.SetScreen(navigateDialogScreen).SetRoot(navigateDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		navigateDialog.Display()
+		Expect(navigateDialog.IsDisplay()).To(Equal(true))
+	})
+
+	It("set focus", func() {
+		navigateDialogApp.SetFocus(navigateDialog)
+		Expect(navigateDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("set title", func() {
+		title := "podman container go to"
+		navigateDialog.SetTitle(title)
+		Expect(navigateDialog.layout.GetTitle()).To(Equal(strings.ToUpper(title)))
+	})
+
+	It("set resource info and references", func() {
+		navigateDialog.SetResourceInfo("container id", "1a2b3c4d5e6f", "web01")
+		Expect(navigateDialog.info.GetText()).To(Equal("1a2b3c4d5e6f (web01)"))
+
+		navigateDialog.SetReferences(references)
+		Expect(navigateDialog.table.GetRowCount()).To(Equal(3))
+
+		ref, ok := navigateDialog.GetSelectedReference()
+		Expect(ok).To(Equal(true))
+		Expect(ref).To(Equal(references[0]))
+		Expect(ref.Target()).To(Equal("8d3a5e1b0c3f"))
+	})
+
+	It("go to selected reference", func() {
+		var target string
+
+		navigateDialog.SetGoToFunc(func() {
+			ref, _ := navigateDialog.GetSelectedReference()
+			target = ref.Target()
+		})
+		navigateDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone))
+		navigateDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		navigateDialogApp.Draw()
+		Eventually(func() string { return target }).Should(Equal("vol01"))
+	})
+
+	It("cancel button selected", func() {
+		cancelButton := "initial"
+		cancelButtonWants := "cancel selected"
+		navigateDialog.SetCancelFunc(func() {
+			cancelButton = cancelButtonWants
+		})
+		navigateDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
+		navigateDialogApp.Draw()
+		Eventually(func() string { return cancelButton }).Should(Equal(cancelButtonWants))
+	})
+
+	It("hide", func() {
+		navigateDialog.Hide()
+		Expect(navigateDialog.IsDisplay()).To(Equal(false))
+		Expect(navigateDialog.info.GetText()).To(Equal(""))
+
+		_, ok := navigateDialog.GetSelectedReference()
+		Expect(ok).To(Equal(false))
+	})
+
+	AfterAll(func() {
+		navigateDialogApp.Stop()
+	})
+})
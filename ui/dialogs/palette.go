@@ -0,0 +1,364 @@
+package dialogs
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	putils "github.com/containers/podman-tui/pdcs/utils"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	paletteDialogMaxWidth  = 120
+	paletteDialogMaxHeight = 25
+	paletteMaxMatches      = 200
+	paletteSelectCommand   = "select"
+)
+
+const (
+	paletteCommandColIndex = 0 + iota
+	paletteTargetColIndex
+	paletteScreenColIndex
+	paletteDescColIndex
+)
+
+// PaletteItem implements a command palette entry.
+// It is a screen command which is optionally run on one of the screen resources,
+// an empty command only switches to the screen and selects the resource.
+type PaletteItem struct {
+	Screen      string
+	Command     string
+	Description string
+	Target      putils.ResourceReference
+}
+
+func (item PaletteItem) command() string {
+	if item.Command == "" {
+		return paletteSelectCommand
+	}
+
+	return item.Command
+}
+
+func (item PaletteItem) text() string {
+	return strings.Join([]string{item.command(), item.Target.Name, item.Screen}, " ")
+}
+
+type paletteMatch struct {
+	item  PaletteItem
+	score int
+}
+
+// CommandPaletteDialog implements command palette dialog.
+// It fuzzy searches the commands and resources of all screens.
+type CommandPaletteDialog struct {
+	*tview.Box
+
+	layout        *tview.Flex
+	input         *tview.InputField
+	table         *tview.Table
+	hint          *tview.TextView
+	display       bool
+	tableHeaders  []string
+	items         []PaletteItem
+	matches       []PaletteItem
+	selectHandler func()
+	cancelHandler func()
+}
+
+// NewCommandPaletteDialog returns new command palette dialog primitive.
+func NewCommandPaletteDialog() *CommandPaletteDialog {
+	dialog := &CommandPaletteDialog{
+		Box:          tview.NewBox(),
+		layout:       tview.NewFlex().SetDirection(tview.FlexRow),
+		input:        tview.NewInputField(),
+		table:        tview.NewTable(),
+		hint:         tview.NewTextView(),
+		tableHeaders: []string{"command", "target", "screen", "description"},
+	}
+
+	bgColor := style.DialogBgColor
+
+	// search field
+	dialog.input.SetBackgroundColor(bgColor)
+	dialog.input.SetLabel("> ")
+	dialog.input.SetPlaceholder("command and resource name, e.g. logs web")
+	dialog.input.SetPlaceholderStyle(style.InputFieldStyle.Foreground(style.DialogSubBoxBorderColor))
+	dialog.input.SetFieldStyle(style.InputFieldStyle)
+	dialog.input.SetLabelStyle(style.InputLabelStyle)
+	dialog.input.SetChangedFunc(func(query string) {
+		dialog.filter(query)
+	})
+
+	// matches table
+	dialog.table.SetBackgroundColor(bgColor)
+	dialog.table.SetBorder(true)
+	dialog.table.SetBorderColor(style.DialogSubBoxBorderColor)
+	dialog.initTable()
+
+	// hint
+	dialog.hint.SetBackgroundColor(bgColor)
+	dialog.hint.SetTextColor(style.DialogFgColor)
+	dialog.hint.SetDynamicColors(true)
+	dialog.hint.SetText("[::b]enter:[::-] run  [::b]up/down:[::-] select  [::b]esc:[::-] close")
+
+	// layout
+	mainLayout := tview.NewFlex().SetDirection(tview.FlexRow)
+
+	mainLayout.SetBackgroundColor(bgColor)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainLayout.AddItem(dialog.input, 1, 0, true)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainLayout.AddItem(dialog.table, 0, 1, false)
+	mainLayout.AddItem(dialog.hint, 1, 0, false)
+
+	paddingLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+
+	paddingLayout.SetBackgroundColor(bgColor)
+	paddingLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	paddingLayout.AddItem(mainLayout, 0, 1, true)
+	paddingLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetTitle("COMMAND PALETTE")
+	dialog.layout.AddItem(paddingLayout, 0, 1, true)
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *CommandPaletteDialog) Display() {
+	d.display = true
+
+	d.input.SetText("")
+	d.filter("")
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *CommandPaletteDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *CommandPaletteDialog) Hide() {
+	d.display = false
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *CommandPaletteDialog) HasFocus() bool {
+	return d.input.HasFocus() || d.Box.HasFocus() || d.layout.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *CommandPaletteDialog) Focus(delegate func(p tview.Primitive)) {
+	delegate(d.input)
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *CommandPaletteDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("command palette dialog: event %v received", event)
+
+		switch event.Key() { //nolint:exhaustive
+		case utils.CloseDialogKey.Key:
+			if d.cancelHandler != nil {
+				d.cancelHandler()
+			}
+
+			return
+		case tcell.KeyEnter:
+			if _, ok := d.GetSelectedItem(); ok && d.selectHandler != nil {
+				d.selectHandler()
+			}
+
+			return
+		case tcell.KeyUp, tcell.KeyDown, tcell.KeyPgUp, tcell.KeyPgDn:
+			if tableHandler := d.table.InputHandler(); tableHandler != nil {
+				tableHandler(event, setFocus)
+			}
+
+			return
+		}
+
+		if inputHandler := d.input.InputHandler(); inputHandler != nil {
+			inputHandler(event, setFocus)
+		}
+	})
+}
+
+// SetRect set rects for this primitive.
+func (d *CommandPaletteDialog) SetRect(x, y, width, height int) {
+	if width > paletteDialogMaxWidth {
+		emptySpace := (width - paletteDialogMaxWidth) / 2 //nolint:mnd
+		x += emptySpace
+		width = paletteDialogMaxWidth
+	}
+
+	if height > paletteDialogMaxHeight {
+		emptySpace := (height - paletteDialogMaxHeight) / 2 //nolint:mnd
+		y += emptySpace
+		height = paletteDialogMaxHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// Draw draws this primitive into the screen.
+func (d *CommandPaletteDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.DrawForSubclass(screen, d)
+
+	x, y, width, height := d.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetSelectedFunc sets the function which is called when a palette item is chosen.
+func (d *CommandPaletteDialog) SetSelectedFunc(handler func()) *CommandPaletteDialog {
+	d.selectHandler = handler
+
+	return d
+}
+
+// SetCancelFunc sets the dialog cancel function.
+func (d *CommandPaletteDialog) SetCancelFunc(handler func()) *CommandPaletteDialog {
+	d.cancelHandler = handler
+
+	return d
+}
+
+// SetItems sets command palette items and filters them by the current query.
+func (d *CommandPaletteDialog) SetItems(items []PaletteItem) {
+	d.items = items
+
+	d.filter(d.input.GetText())
+}
+
+// GetSelectedItem returns the selected palette item.
+func (d *CommandPaletteDialog) GetSelectedItem() (PaletteItem, bool) {
+	row, _ := d.table.GetSelection()
+	if row < 1 || row > len(d.matches) {
+		return PaletteItem{}, false
+	}
+
+	return d.matches[row-1], true
+}
+
+// filter fuzzy matches every word of the query against the items and lists the best matches.
+func (d *CommandPaletteDialog) filter(query string) {
+	words := strings.Fields(query)
+	matches := make([]paletteMatch, 0, len(d.items))
+
+	for _, item := range d.items {
+		score, ok := paletteItemScore(words, item.text())
+		if !ok {
+			continue
+		}
+
+		matches = append(matches, paletteMatch{item: item, score: score})
+	}
+
+	slices.SortStableFunc(matches, func(a, b paletteMatch) int {
+		if a.score != b.score {
+			return cmp.Compare(b.score, a.score)
+		}
+
+		return cmp.Compare(len(a.item.text()), len(b.item.text()))
+	})
+
+	if len(matches) > paletteMaxMatches {
+		matches = matches[:paletteMaxMatches]
+	}
+
+	d.matches = make([]PaletteItem, 0, len(matches))
+	for _, match := range matches {
+		d.matches = append(d.matches, match.item)
+	}
+
+	d.setTable()
+}
+
+func paletteItemScore(words []string, text string) (int, bool) {
+	total := 0
+
+	for _, word := range words {
+		score, ok := utils.FuzzyMatch(word, text)
+		if !ok {
+			return 0, false
+		}
+
+		total += score
+	}
+
+	return total, true
+}
+
+func (d *CommandPaletteDialog) setTable() {
+	d.initTable()
+
+	for i, item := range d.matches {
+		rowIndex := i + 1
+
+		target := item.Target.Name
+		if target == "" {
+			target = utils.GetIDWithLimit(item.Target.ID)
+		}
+
+		d.table.SetCell(rowIndex, paletteCommandColIndex,
+			tview.NewTableCell(tview.Escape(item.command())).
+				SetExpansion(1).
+				SetAlign(tview.AlignLeft))
+
+		d.table.SetCell(rowIndex, paletteTargetColIndex,
+			tview.NewTableCell(tview.Escape(target)).
+				SetExpansion(1).
+				SetAlign(tview.AlignLeft))
+
+		d.table.SetCell(rowIndex, paletteScreenColIndex,
+			tview.NewTableCell(item.Screen).
+				SetExpansion(0).
+				SetAlign(tview.AlignLeft))
+
+		d.table.SetCell(rowIndex, paletteDescColIndex,
+			tview.NewTableCell(tview.Escape(item.Description)).
+				SetExpansion(2). //nolint:mnd
+				SetAlign(tview.AlignLeft))
+	}
+
+	if len(d.matches) > 0 {
+		d.table.Select(1, 0)
+	}
+
+	d.table.ScrollToBeginning()
+}
+
+func (d *CommandPaletteDialog) initTable() {
+	bgColor := style.TableHeaderBgColor
+	fgColor := style.TableHeaderFgColor
+
+	d.table.Clear()
+	d.table.SetFixed(1, 0)
+	d.table.SetSelectable(true, false)
+
+	for i := range d.tableHeaders {
+		d.table.SetCell(0, i,
+			tview.NewTableCell(fmt.Sprintf("[%s::b]%s", style.GetColorHex(fgColor), strings.ToUpper(d.tableHeaders[i]))).
+				SetExpansion(1).
+				SetBackgroundColor(bgColor).
+				SetTextColor(fgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
+	}
+}
@@ -0,0 +1,109 @@
+package dialogs
+
+import (
+	putils "github.com/containers/podman-tui/pdcs/utils"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("command palette dialog", Ordered, func() {
+	var paletteDialogApp *tview.Application
+	var paletteDialogScreen tcell.SimulationScreen
+	var paletteDialog *CommandPaletteDialog
+	var runApp func()
+
+	web01 := putils.ResourceReference{Kind: putils.ResourceKindContainer, ID: "1a2b3c4d5e6f", Name: "web-1"}
+	db01 := putils.ResourceReference{Kind: putils.ResourceKindContainer, ID: "6f5e4d3c2b1a", Name: "db-1"}
+	items := []PaletteItem{
+		{Screen: "containers", Command: "logs", Description: "fetch the logs of a container"},
+		{Screen: "containers", Command: "logs", Description: "fetch the logs of a container", Target: web01},
+		{Screen: "containers", Command: "logs", Description: "fetch the logs of a container", Target: db01},
+		{Screen: "containers", Description: "switch to containers screen", Target: web01},
+		{Screen: "images", Command: "prune", Description: "remove all unused images"},
+		{Screen: "system", Command: "connect", Description: "connect to selected destination"},
+	}
+
+	BeforeAll(func() {
+		paletteDialogApp = tview.NewApplication()
+		paletteDialog = NewCommandPaletteDialog()
+		paletteDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := paletteDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := paletteDialogApp.SetScreen(paletteDialogScreen).SetRoot(paletteDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		paletteDialog.SetItems(items)
+		paletteDialog.Display()
+		Expect(paletteDialog.IsDisplay()).To(Equal(true))
+		Expect(paletteDialog.table.GetRowCount()).To(Equal(len(items) + 1))
+	})
+
+	It("set focus", func() {
+		paletteDialogApp.SetFocus(paletteDialog)
+		Expect(paletteDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("fuzzy search command and resource", func() {
+		paletteDialog.filter("logs web-1")
+		item, ok := paletteDialog.GetSelectedItem()
+		Expect(ok).To(Equal(true))
+		Expect(item.Command).To(Equal("logs"))
+		Expect(item.Target).To(Equal(web01))
+
+		paletteDialog.filter("prune images")
+		item, _ = paletteDialog.GetSelectedItem()
+		Expect(item.Screen).To(Equal("images"))
+
+		paletteDialog.filter("unknown command")
+		_, ok = paletteDialog.GetSelectedItem()
+		Expect(ok).To(Equal(false))
+	})
+
+	It("type query and select", func() {
+		var selected PaletteItem
+
+		paletteDialog.SetSelectedFunc(func() {
+			selected, _ = paletteDialog.GetSelectedItem()
+		})
+		paletteDialog.Display()
+
+		for _, char := range "cnnct" {
+			paletteDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyRune, char, tcell.ModNone))
+		}
+
+		paletteDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		paletteDialogApp.Draw()
+		Eventually(func() string { return selected.Command }).Should(Equal("connect"))
+	})
+
+	It("cancel", func() {
+		cancelled := false
+		paletteDialog.SetCancelFunc(func() {
+			cancelled = true
+		})
+		paletteDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
+		paletteDialogApp.Draw()
+		Eventually(func() bool { return cancelled }).Should(Equal(true))
+	})
+
+	It("hide", func() {
+		paletteDialog.Hide()
+		Expect(paletteDialog.IsDisplay()).To(Equal(false))
+	})
+
+	AfterAll(func() {
+		paletteDialogApp.Stop()
+	})
+})
@@ -0,0 +1,294 @@
+package dialogs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	presetsDialogMaxWidth  = 60
+	presetsDialogMaxHeight = 16
+)
+
+const (
+	presetsTableFocus = 0 + iota
+	presetsFormFocus
+)
+
+// PresetsDialog implements create options presets dialog.
+// It lists the saved presets and allows to load or delete them.
+type PresetsDialog struct {
+	*tview.Box
+
+	layout        *tview.Flex
+	table         *tview.Table
+	form          *tview.Form
+	display       bool
+	focusElement  int
+	presets       []string
+	loadHandler   func()
+	deleteHandler func()
+	cancelHandler func()
+}
+
+// NewPresetsDialog returns new presets dialog primitive.
+func NewPresetsDialog() *PresetsDialog {
+	dialog := &PresetsDialog{
+		Box:          tview.NewBox(),
+		layout:       tview.NewFlex().SetDirection(tview.FlexRow),
+		table:        tview.NewTable(),
+		form:         tview.NewForm(),
+		focusElement: presetsTableFocus,
+	}
+
+	bgColor := style.DialogBgColor
+
+	// presets table
+	dialog.table.SetBackgroundColor(bgColor)
+	dialog.table.SetBorder(true)
+	dialog.table.SetBorderColor(style.DialogSubBoxBorderColor)
+	dialog.initTable()
+
+	// form
+	dialog.form.AddButton("Delete", nil)
+	dialog.form.AddButton("Cancel", nil)
+	dialog.form.AddButton("Load", nil)
+	dialog.form.SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	tableLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+
+	tableLayout.SetBackgroundColor(bgColor)
+	tableLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	tableLayout.AddItem(dialog.table, 0, 1, true)
+	tableLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	dialog.layout.AddItem(tableLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, DialogFormHeight, 0, true)
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *PresetsDialog) Display() {
+	d.display = true
+	d.focusElement = presetsTableFocus
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *PresetsDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *PresetsDialog) Hide() {
+	d.display = false
+	d.focusElement = presetsTableFocus
+}
+
+// SetTitle sets dialog title.
+func (d *PresetsDialog) SetTitle(title string) {
+	d.layout.SetTitle(strings.ToUpper(title))
+}
+
+// SetPresets sets presets name list.
+func (d *PresetsDialog) SetPresets(presets []string) {
+	d.presets = presets
+
+	d.initTable()
+
+	for i, name := range presets {
+		d.table.SetCell(i+1, 0,
+			tview.NewTableCell(name).
+				SetExpansion(1).
+				SetAlign(tview.AlignLeft).
+				SetTextColor(style.DialogFgColor))
+	}
+
+	if len(presets) > 0 {
+		d.table.Select(1, 0)
+		d.table.ScrollToBeginning()
+	}
+}
+
+// GetSelectedPreset returns selected preset name.
+func (d *PresetsDialog) GetSelectedPreset() string {
+	row, _ := d.table.GetSelection()
+	if row < 1 || row > len(d.presets) {
+		return ""
+	}
+
+	return d.presets[row-1]
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *PresetsDialog) HasFocus() bool {
+	if d.table.HasFocus() || d.form.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus() || d.layout.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *PresetsDialog) Focus(delegate func(p tview.Primitive)) {
+	if d.focusElement == presetsTableFocus {
+		delegate(d.table)
+
+		return
+	}
+
+	button := d.form.GetButton(d.form.GetButtonCount() - 1)
+	button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == utils.SwitchFocusKey.Key {
+			d.focusElement = presetsTableFocus
+
+			d.Focus(delegate)
+			d.form.SetFocus(0)
+
+			return nil
+		}
+
+		return event
+	})
+
+	delegate(d.form)
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *PresetsDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("presets dialog: event %v received", event)
+
+		if event.Key() == utils.CloseDialogKey.Key {
+			d.cancelHandler()
+
+			return
+		}
+
+		if d.table.HasFocus() {
+			switch event.Key() { //nolint:exhaustive
+			case utils.SwitchFocusKey.Key:
+				d.focusElement = presetsFormFocus
+				d.Focus(setFocus)
+
+				return
+			case tcell.KeyEnter:
+				if d.loadHandler != nil && d.GetSelectedPreset() != "" {
+					d.loadHandler()
+				}
+
+				return
+			case tcell.KeyDelete:
+				if d.deleteHandler != nil && d.GetSelectedPreset() != "" {
+					d.deleteHandler()
+				}
+
+				return
+			}
+
+			if tableHandler := d.table.InputHandler(); tableHandler != nil {
+				tableHandler(utils.ParseKeyEventKey(event), setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+// SetRect set rects for this primitive.
+func (d *PresetsDialog) SetRect(x, y, width, height int) {
+	if width > presetsDialogMaxWidth {
+		emptySpace := (width - presetsDialogMaxWidth) / 2 //nolint:mnd
+		x += emptySpace
+		width = presetsDialogMaxWidth
+	}
+
+	if height > presetsDialogMaxHeight {
+		emptySpace := (height - presetsDialogMaxHeight) / 2 //nolint:mnd
+		y += emptySpace
+		height = presetsDialogMaxHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *PresetsDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.DrawForSubclass(screen, d)
+
+	x, y, width, height := d.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetLoadFunc sets form load button selected function.
+func (d *PresetsDialog) SetLoadFunc(handler func()) *PresetsDialog {
+	d.loadHandler = handler
+	loadButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+	loadButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *PresetsDialog) SetCancelFunc(handler func()) *PresetsDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 2) //nolint:mnd
+
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetDeleteFunc sets form delete button selected function.
+func (d *PresetsDialog) SetDeleteFunc(handler func()) *PresetsDialog {
+	d.deleteHandler = handler
+	deleteButton := d.form.GetButton(d.form.GetButtonCount() - 3) //nolint:mnd
+
+	deleteButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+func (d *PresetsDialog) initTable() {
+	bgColor := style.TableHeaderBgColor
+	fgColor := style.TableHeaderFgColor
+
+	d.table.Clear()
+	d.table.SetFixed(1, 0)
+	d.table.SetSelectable(true, false)
+	d.table.SetCell(0, 0,
+		tview.NewTableCell(fmt.Sprintf("[%s::b]PRESET NAME", style.GetColorHex(fgColor))).
+			SetExpansion(1).
+			SetBackgroundColor(bgColor).
+			SetTextColor(fgColor).
+			SetAlign(tview.AlignLeft).
+			SetSelectable(false))
+}
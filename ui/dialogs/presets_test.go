@@ -0,0 +1,101 @@
+package dialogs
+
+import (
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("presets dialog", Ordered, func() {
+	var presetsDialogApp *tview.Application
+	var presetsDialogScreen tcell.SimulationScreen
+	var presetsDialog *PresetsDialog
+	var runApp func()
+
+	BeforeAll(func() {
+		presetsDialogApp = tview.NewApplication()
+		presetsDialog = NewPresetsDialog()
+		presetsDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := presetsDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := presetsDialogApp.SetScreen(presetsDialogScreen).SetRoot(presetsDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		presetsDialog.Display()
+		Expect(presetsDialog.IsDisplay()).To(Equal(true))
+	})
+
+	It("set focus", func() {
+		presetsDialogApp.SetFocus(presetsDialog)
+		Expect(presetsDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("set title", func() {
+		title := "podman container presets"
+		presetsDialog.SetTitle(title)
+		Expect(presetsDialog.layout.GetTitle()).To(Equal(strings.ToUpper(title)))
+	})
+
+	It("set presets", func() {
+		presetsDialog.SetPresets([]string{"dev01", "dev02"})
+		Expect(presetsDialog.table.GetRowCount()).To(Equal(3))
+		Expect(presetsDialog.GetSelectedPreset()).To(Equal("dev01"))
+	})
+
+	It("load preset", func() {
+		loaded := ""
+		presetsDialog.SetLoadFunc(func() {
+			loaded = presetsDialog.GetSelectedPreset()
+		})
+		presetsDialogApp.SetFocus(presetsDialog)
+		presetsDialogApp.Draw()
+		presetsDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone))
+		presetsDialogApp.Draw()
+		presetsDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		presetsDialogApp.Draw()
+		Expect(loaded).To(Equal("dev02"))
+	})
+
+	It("delete preset", func() {
+		deleted := ""
+		presetsDialog.SetDeleteFunc(func() {
+			deleted = presetsDialog.GetSelectedPreset()
+		})
+		presetsDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyDelete, 0, tcell.ModNone))
+		presetsDialogApp.Draw()
+		Expect(deleted).To(Equal("dev02"))
+	})
+
+	It("cancel button selected", func() {
+		cancelAction := "initial"
+		cancelWants := "cancel selected"
+		presetsDialog.SetCancelFunc(func() {
+			cancelAction = cancelWants
+		})
+		presetsDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
+		presetsDialogApp.Draw()
+		Expect(cancelAction).To(Equal(cancelWants))
+	})
+
+	It("hide", func() {
+		presetsDialog.Hide()
+		Expect(presetsDialog.IsDisplay()).To(Equal(false))
+	})
+
+	AfterAll(func() {
+		presetsDialogApp.Stop()
+	})
+})
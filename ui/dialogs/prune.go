@@ -0,0 +1,519 @@
+package dialogs
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	putils "github.com/containers/podman-tui/pdcs/utils"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/docker/go-units"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	pruneDialogMaxWidth   = 90
+	pruneDialogMaxHeight  = 24
+	pruneDialogLabelWidth = 9
+)
+
+const (
+	pruneFiltersFocus = 0 + iota
+	pruneAllFocus
+	pruneVolumesFocus
+	pruneTableFocus
+	pruneFormFocus
+)
+
+var errPruneInvalidFilter = errors.New("invalid prune filter, expected until=, label= or label!=")
+
+// PruneDialog implements prune dialog.
+// It accepts until and label filters and shows the resources which will be removed
+// and the reclaimable space before pruning.
+type PruneDialog struct {
+	*tview.Box
+
+	layout         *tview.Flex
+	filters        *tview.InputField
+	all            *tview.Checkbox
+	volumes        *tview.Checkbox
+	table          *tview.Table
+	summary        *tview.TextView
+	form           *tview.Form
+	display        bool
+	focusElement   int
+	allOption      bool
+	volumesOption  bool
+	previewed      bool
+	previewHandler func()
+	pruneHandler   func()
+	cancelHandler  func()
+}
+
+// NewPruneDialog returns new prune dialog primitive.
+// allOption and volumesOption show the "all images" and "volumes" checkboxes.
+func NewPruneDialog(allOption bool, volumesOption bool) *PruneDialog {
+	dialog := &PruneDialog{
+		Box:           tview.NewBox(),
+		layout:        tview.NewFlex().SetDirection(tview.FlexRow),
+		filters:       tview.NewInputField(),
+		all:           tview.NewCheckbox(),
+		volumes:       tview.NewCheckbox(),
+		table:         tview.NewTable(),
+		summary:       tview.NewTextView(),
+		form:          tview.NewForm(),
+		focusElement:  pruneFiltersFocus,
+		allOption:     allOption,
+		volumesOption: volumesOption,
+	}
+
+	bgColor := style.DialogBgColor
+	fgColor := style.DialogFgColor
+
+	// filters input field
+	dialog.filters.SetBackgroundColor(bgColor)
+	dialog.filters.SetLabel(utils.StringToInputLabel("filters:", pruneDialogLabelWidth))
+	dialog.filters.SetPlaceholder("until=24h label=key=value label!=key")
+	dialog.filters.SetPlaceholderStyle(style.InputFieldStyle.Foreground(style.DialogSubBoxBorderColor))
+	dialog.filters.SetFieldStyle(style.InputFieldStyle)
+	dialog.filters.SetLabelStyle(style.InputLabelStyle)
+	dialog.filters.SetChangedFunc(func(_ string) {
+		dialog.previewed = false
+	})
+
+	// all images checkbox
+	allLabel := "all images:"
+
+	dialog.all.SetBackgroundColor(bgColor)
+	dialog.all.SetLabelColor(fgColor)
+	dialog.all.SetLabel(allLabel)
+	dialog.all.SetLabelWidth(len(allLabel) + 1)
+	dialog.all.SetFieldBackgroundColor(style.FieldBackgroundColor)
+	dialog.all.SetChangedFunc(func(_ bool) {
+		dialog.previewed = false
+	})
+
+	// volumes checkbox
+	volumesLabel := "volumes:"
+
+	dialog.volumes.SetBackgroundColor(bgColor)
+	dialog.volumes.SetLabelColor(fgColor)
+	dialog.volumes.SetLabel(volumesLabel)
+	dialog.volumes.SetLabelWidth(len(volumesLabel) + 1)
+	dialog.volumes.SetFieldBackgroundColor(style.FieldBackgroundColor)
+	dialog.volumes.SetChangedFunc(func(_ bool) {
+		dialog.previewed = false
+	})
+
+	// preview table
+	dialog.table.SetBackgroundColor(bgColor)
+	dialog.table.SetBorder(true)
+	dialog.table.SetBorderColor(style.DialogSubBoxBorderColor)
+	dialog.initTable()
+
+	// summary
+	dialog.summary.SetBackgroundColor(bgColor)
+	dialog.summary.SetTextColor(fgColor)
+	dialog.summary.SetDynamicColors(true)
+
+	// form
+	dialog.form.AddButton("Cancel", nil)
+	dialog.form.AddButton("Preview", nil)
+	dialog.form.AddButton("Prune", nil)
+	dialog.form.SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	optionsLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	optionsLayout.SetBackgroundColor(bgColor)
+
+	if allOption {
+		optionsLayout.AddItem(dialog.all, len(allLabel)+4, 0, true) //nolint:mnd
+	}
+
+	if volumesOption {
+		optionsLayout.AddItem(dialog.volumes, len(volumesLabel)+4, 0, true) //nolint:mnd
+	}
+
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 0, 1, false)
+
+	layout := tview.NewFlex().SetDirection(tview.FlexRow)
+	layout.SetBackgroundColor(bgColor)
+	layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	layout.AddItem(dialog.filters, 1, 0, true)
+
+	if allOption || volumesOption {
+		layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+		layout.AddItem(optionsLayout, 1, 0, true)
+	}
+
+	layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	layout.AddItem(dialog.table, 0, 1, true)
+	layout.AddItem(dialog.summary, 1, 0, false)
+
+	mainLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	mainLayout.SetBackgroundColor(bgColor)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainLayout.AddItem(layout, 0, 1, true)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.AddItem(mainLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, DialogFormHeight, 0, true)
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *PruneDialog) Display() {
+	d.display = true
+	d.focusElement = pruneFiltersFocus
+
+	d.filters.SetText("")
+	d.all.SetChecked(d.allOption)
+	d.volumes.SetChecked(d.volumesOption)
+	d.SetPreview(nil)
+
+	d.previewed = false
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *PruneDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *PruneDialog) Hide() {
+	d.display = false
+	d.focusElement = pruneFiltersFocus
+	d.previewed = false
+}
+
+// SetTitle sets dialog title.
+func (d *PruneDialog) SetTitle(title string) {
+	d.layout.SetTitle(strings.ToUpper(title))
+}
+
+// GetPruneOptions returns prune filters and options.
+func (d *PruneDialog) GetPruneOptions() (putils.PruneOptions, error) {
+	opts, err := ParsePruneFilters(d.filters.GetText())
+	if err != nil {
+		return opts, err
+	}
+
+	opts.All = d.allOption && d.all.IsChecked()
+	opts.Volumes = d.volumesOption && d.volumes.IsChecked()
+
+	return opts, nil
+}
+
+// SetPreview sets the resources which will be removed by prune.
+func (d *PruneDialog) SetPreview(reports []putils.PruneReport) {
+	d.initTable()
+
+	for i, report := range reports {
+		name := report.Name
+		if name == "" {
+			name = utils.GetIDWithLimit(report.ID)
+		}
+
+		size := "-"
+		if report.Size > 0 {
+			size = units.HumanSize(float64(report.Size))
+		}
+
+		d.table.SetCell(i+1, 0,
+			tview.NewTableCell(report.Type).
+				SetAlign(tview.AlignLeft).
+				SetTextColor(style.DialogFgColor))
+
+		d.table.SetCell(i+1, 1,
+			tview.NewTableCell(name).
+				SetExpansion(1).
+				SetAlign(tview.AlignLeft).
+				SetTextColor(style.DialogFgColor))
+
+		d.table.SetCell(i+1, 2, //nolint:mnd
+			tview.NewTableCell(size).
+				SetAlign(tview.AlignRight).
+				SetTextColor(style.DialogFgColor))
+	}
+
+	if len(reports) > 0 {
+		d.table.Select(1, 0)
+		d.table.ScrollToBeginning()
+	}
+
+	if reports == nil {
+		d.summary.SetText("")
+
+		return
+	}
+
+	d.summary.SetText(fmt.Sprintf("[::b]RECLAIMABLE:[::-] %d resource(s), %s",
+		len(reports), units.HumanSize(float64(putils.PruneReportsSize(reports)))))
+
+	d.previewed = true
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *PruneDialog) HasFocus() bool {
+	if d.filters.HasFocus() || d.all.HasFocus() || d.volumes.HasFocus() {
+		return true
+	}
+
+	if d.table.HasFocus() || d.form.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus() || d.layout.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *PruneDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case pruneFiltersFocus:
+		delegate(d.filters)
+	case pruneAllFocus:
+		delegate(d.all)
+	case pruneVolumesFocus:
+		delegate(d.volumes)
+	case pruneTableFocus:
+		delegate(d.table)
+	case pruneFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = pruneFiltersFocus
+
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *PruneDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("prune dialog: event %v received", event)
+
+		if event.Key() == utils.CloseDialogKey.Key {
+			d.cancelHandler()
+
+			return
+		}
+
+		if event.Key() == utils.SwitchFocusKey.Key && !d.form.HasFocus() {
+			d.setFocusElement()
+			d.Focus(setFocus)
+
+			return
+		}
+
+		if d.filters.HasFocus() {
+			if event.Key() == tcell.KeyEnter {
+				if d.previewHandler != nil {
+					d.previewHandler()
+				}
+
+				return
+			}
+
+			if handler := d.filters.InputHandler(); handler != nil {
+				handler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.all.HasFocus() {
+			if handler := d.all.InputHandler(); handler != nil {
+				handler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.volumes.HasFocus() {
+			if handler := d.volumes.InputHandler(); handler != nil {
+				handler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.table.HasFocus() {
+			if handler := d.table.InputHandler(); handler != nil {
+				handler(utils.ParseKeyEventKey(event), setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if handler := d.form.InputHandler(); handler != nil {
+				handler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+// SetRect set rects for this primitive.
+func (d *PruneDialog) SetRect(x, y, width, height int) {
+	if width > pruneDialogMaxWidth {
+		emptySpace := (width - pruneDialogMaxWidth) / 2 //nolint:mnd
+		x += emptySpace
+		width = pruneDialogMaxWidth
+	}
+
+	if height > pruneDialogMaxHeight {
+		emptySpace := (height - pruneDialogMaxHeight) / 2 //nolint:mnd
+		y += emptySpace
+		height = pruneDialogMaxHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *PruneDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.DrawForSubclass(screen, d)
+
+	x, y, width, height := d.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetPruneFunc sets form prune button selected function.
+// The handler is called only if the preview is up to date with the current filters,
+// otherwise the preview handler is called.
+func (d *PruneDialog) SetPruneFunc(handler func()) *PruneDialog {
+	d.pruneHandler = handler
+	pruneButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+	pruneButton.SetSelectedFunc(func() {
+		if !d.previewed {
+			if d.previewHandler != nil {
+				d.previewHandler()
+			}
+
+			return
+		}
+
+		d.pruneHandler()
+	})
+
+	return d
+}
+
+// SetPreviewFunc sets form preview button selected function.
+func (d *PruneDialog) SetPreviewFunc(handler func()) *PruneDialog {
+	d.previewHandler = handler
+	previewButton := d.form.GetButton(d.form.GetButtonCount() - 2) //nolint:mnd
+
+	previewButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *PruneDialog) SetCancelFunc(handler func()) *PruneDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 3) //nolint:mnd
+
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+func (d *PruneDialog) setFocusElement() {
+	switch d.focusElement {
+	case pruneFiltersFocus:
+		d.focusElement = pruneTableFocus
+
+		if d.allOption {
+			d.focusElement = pruneAllFocus
+		} else if d.volumesOption {
+			d.focusElement = pruneVolumesFocus
+		}
+	case pruneAllFocus:
+		d.focusElement = pruneTableFocus
+
+		if d.volumesOption {
+			d.focusElement = pruneVolumesFocus
+		}
+	case pruneVolumesFocus:
+		d.focusElement = pruneTableFocus
+	case pruneTableFocus:
+		d.focusElement = pruneFormFocus
+	}
+}
+
+func (d *PruneDialog) initTable() {
+	bgColor := style.TableHeaderBgColor
+	fgColor := style.TableHeaderFgColor
+
+	d.table.Clear()
+	d.table.SetFixed(1, 0)
+	d.table.SetSelectable(true, false)
+
+	for i, header := range []string{"TYPE", "NAME", "SIZE"} {
+		expansion := 0
+		if header == "NAME" {
+			expansion = 1
+		}
+
+		d.table.SetCell(0, i,
+			tview.NewTableCell(fmt.Sprintf("[%s::b]%s", style.GetColorHex(fgColor), header)).
+				SetExpansion(expansion).
+				SetBackgroundColor(bgColor).
+				SetTextColor(fgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
+	}
+}
+
+// ParsePruneFilters parses space separated until=, label= and label!= prune filters.
+func ParsePruneFilters(filters string) (putils.PruneOptions, error) {
+	var opts putils.PruneOptions
+
+	for token := range strings.FieldsSeq(filters) {
+		key, value, found := strings.Cut(token, "=")
+		if !found || value == "" {
+			return opts, fmt.Errorf("%w: %q", errPruneInvalidFilter, token)
+		}
+
+		switch key {
+		case putils.PruneFilterUntil:
+			opts.Until = value
+		case putils.PruneFilterLabel:
+			opts.Labels = append(opts.Labels, value)
+		case putils.PruneFilterNotLabel:
+			opts.NotLabels = append(opts.NotLabels, value)
+		default:
+			return opts, fmt.Errorf("%w: %q", errPruneInvalidFilter, token)
+		}
+	}
+
+	return opts, nil
+}
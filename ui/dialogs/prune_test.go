@@ -0,0 +1,134 @@
+package dialogs
+
+import (
+	"strings"
+
+	putils "github.com/containers/podman-tui/pdcs/utils"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("prune dialog", Ordered, func() {
+	var pruneDialogApp *tview.Application
+	var pruneDialogScreen tcell.SimulationScreen
+	var pruneDialog *PruneDialog
+	var runApp func()
+
+	BeforeAll(func() {
+		pruneDialogApp = tview.NewApplication()
+		pruneDialog = NewPruneDialog(true, true)
+		pruneDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := pruneDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := pruneDialogApp.SetScreen(pruneDialogScreen).SetRoot(pruneDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		pruneDialog.Display()
+		Expect(pruneDialog.IsDisplay()).To(Equal(true))
+
+		opts, err := pruneDialog.GetPruneOptions()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(opts.All).To(Equal(true))
+		Expect(opts.Volumes).To(Equal(true))
+		Expect(opts.HasFilters()).To(Equal(false))
+	})
+
+	It("set focus", func() {
+		pruneDialogApp.SetFocus(pruneDialog)
+		Expect(pruneDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("set title", func() {
+		title := "podman system prune"
+		pruneDialog.SetTitle(title)
+		Expect(pruneDialog.layout.GetTitle()).To(Equal(strings.ToUpper(title)))
+	})
+
+	It("parse filters", func() {
+		opts, err := ParsePruneFilters("until=24h label=env=dev label!=keep")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(opts.Until).To(Equal("24h"))
+		Expect(opts.Labels).To(Equal([]string{"env=dev"}))
+		Expect(opts.NotLabels).To(Equal([]string{"keep"}))
+
+		_, err = ParsePruneFilters("since=24h")
+		Expect(err).To(HaveOccurred())
+
+		_, err = ParsePruneFilters("until=")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("prune without preview", func() {
+		previewed := false
+		pruned := false
+		pruneDialog.SetPreviewFunc(func() {
+			previewed = true
+		})
+		pruneDialog.SetPruneFunc(func() {
+			pruned = true
+		})
+		pruneDialog.focusElement = pruneFormFocus
+		pruneDialogApp.SetFocus(pruneDialog)
+		pruneDialogApp.Draw()
+		pruneDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		pruneDialogApp.Draw()
+		pruneDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		pruneDialogApp.Draw()
+		pruneDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		pruneDialogApp.Draw()
+		Expect(previewed).To(Equal(true))
+		Expect(pruned).To(Equal(false))
+	})
+
+	It("set preview", func() {
+		pruneDialog.SetPreview([]putils.PruneReport{
+			{Type: "container", ID: "a1b2c3d4e5f6a7b8", Size: 1024},
+			{Type: "volume", ID: "vol01", Name: "vol01", Size: 2048},
+		})
+		Expect(pruneDialog.table.GetRowCount()).To(Equal(3))
+		Expect(pruneDialog.table.GetCell(1, 1).Text).To(Equal("a1b2c3d4e5f6"))
+		Expect(pruneDialog.summary.GetText(true)).To(ContainSubstring("2 resource(s), 3.072kB"))
+	})
+
+	It("prune after preview", func() {
+		pruned := false
+		pruneDialog.SetPruneFunc(func() {
+			pruned = true
+		})
+		pruneDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		pruneDialogApp.Draw()
+		Expect(pruned).To(Equal(true))
+	})
+
+	It("cancel button selected", func() {
+		cancelAction := "initial"
+		cancelWants := "cancel selected"
+		pruneDialog.SetCancelFunc(func() {
+			cancelAction = cancelWants
+		})
+		pruneDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
+		pruneDialogApp.Draw()
+		Expect(cancelAction).To(Equal(cancelWants))
+	})
+
+	It("hide", func() {
+		pruneDialog.Hide()
+		Expect(pruneDialog.IsDisplay()).To(Equal(false))
+	})
+
+	AfterAll(func() {
+		pruneDialogApp.Stop()
+	})
+})
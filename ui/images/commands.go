@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/containers/podman-tui/pdcs/containers"
 	"github.com/containers/podman-tui/pdcs/images"
 	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/containers/podman-tui/ui/style"
@@ -15,6 +16,8 @@ func (img *Images) runCommand(cmd string) { //nolint:cyclop
 	switch cmd {
 	case "build":
 		img.buildDialog.Display()
+	case "compare":
+		img.ccompare()
 	case "diff":
 		img.diff()
 	case "history":
@@ -23,6 +26,8 @@ func (img *Images) runCommand(cmd string) { //nolint:cyclop
 		img.importDialog.Display()
 	case "inspect":
 		img.inspect()
+	case "layers":
+		img.layers()
 	case utils.PruneCommandLabel:
 		img.cprune()
 	case "push":
@@ -37,6 +42,8 @@ func (img *Images) runCommand(cmd string) { //nolint:cyclop
 		img.ctag()
 	case "tree":
 		img.tree()
+	case "used by":
+		img.usedBy()
 	case "untag":
 		img.cuntag()
 	}
@@ -92,6 +99,67 @@ func (img *Images) build() {
 	go buildFunc()
 }
 
+func (img *Images) ccompare() {
+	imageID, imageName := img.getSelectedItem()
+	if imageID == "" {
+		img.displayError("", errNoImageToCompare)
+
+		return
+	}
+
+	items := make([][]string, 0)
+
+	for _, image := range img.getData() {
+		if strings.HasPrefix(image.ID, imageID) {
+			continue
+		}
+
+		items = append(items, []string{image.ID, image.Repository + ":" + image.Tag})
+	}
+
+	if len(items) == 0 {
+		img.displayError("", errNoImageToCompareTo)
+
+		return
+	}
+
+	img.compareDialog.SetLeftItem(imageID, imageName)
+	img.compareDialog.SetRightItems(items)
+	img.compareDialog.Display()
+}
+
+func (img *Images) compare() {
+	leftID, _ := img.getSelectedItem()
+	rightID, _ := img.compareDialog.GetRightItem()
+
+	if leftID == "" || rightID == "" {
+		return
+	}
+
+	img.progressDialog.SetTitle("image compare in progress")
+	img.progressDialog.Display()
+
+	compareFunc := func() {
+		report, err := images.Compare(leftID, rightID)
+
+		img.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("IMAGE (%s) COMPARE ERROR", leftID)
+
+			img.displayError(title, err)
+			img.appFocusHandler()
+
+			return
+		}
+
+		img.compareDialog.SetResults(report)
+		img.appFocusHandler()
+	}
+
+	go compareFunc()
+}
+
 func (img *Images) diff() {
 	imageID, imageName := img.getSelectedItem()
 
@@ -206,24 +274,172 @@ func (img *Images) inspect() {
 
 	headerLabel := fmt.Sprintf("%12s (%s)", imageID, imageName)
 
-	img.messageDialog.SetTitle("podman image inspect")
-	img.messageDialog.SetText(dialogs.MessageImageInfo, headerLabel, data)
-	img.messageDialog.DisplayFullSize()
+	img.inspectDialog.SetTitle("podman image inspect")
+	img.inspectDialog.SetData(dialogs.MessageImageInfo, headerLabel, data)
+	img.inspectDialog.Display()
+}
+
+func (img *Images) layers() {
+	imageID, imageName := img.getSelectedItem()
+	if imageID == "" {
+		img.displayError("", errNoImageToLayers)
+
+		return
+	}
+
+	layersFunc := func() {
+		layers, err := images.Layers(imageID)
+
+		img.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("IMAGE (%s) LAYERS ERROR", imageID)
+
+			img.displayError(title, err)
+			img.appFocusHandler()
+
+			return
+		}
+
+		img.layersDialog.SetImageInfo(imageID, imageName)
+		img.layersDialog.SetLayers(layers)
+		img.layersDialog.Display()
+		img.appFocusHandler()
+	}
+
+	img.progressDialog.SetTitle("image layers in progress")
+	img.progressDialog.Display()
+
+	go layersFunc()
+}
+
+func (img *Images) layersFiles() {
+	imageID, _ := img.getSelectedItem()
+	if imageID == "" {
+		return
+	}
+
+	img.progressDialog.SetTitle("image layers files in progress")
+	img.progressDialog.Display()
+
+	layersFilesFunc := func() {
+		files, err := images.LayersFiles(imageID)
+
+		img.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("IMAGE (%s) LAYERS FILES ERROR", imageID)
+
+			img.displayError(title, err)
+			img.appFocusHandler()
+
+			return
+		}
+
+		img.layersDialog.SetLayersFiles(files)
+		img.appFocusHandler()
+	}
+
+	go layersFilesFunc()
+}
+
+func (img *Images) usedBy() {
+	imageID, imageName := img.getSelectedItem()
+	if imageID == "" {
+		img.displayError("", errNoImageToUsedBy)
+
+		return
+	}
+
+	usedByFunc := func() {
+		refs, err := containers.UsedByImage(imageID)
+
+		img.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("IMAGE (%s) USED BY ERROR", imageID)
+
+			img.displayError(title, err)
+			img.appFocusHandler()
+
+			return
+		}
+
+		img.navigateDialog.SetResourceInfo("image id", imageID, imageName)
+		img.navigateDialog.SetReferences(refs)
+		img.navigateDialog.Display()
+		img.appFocusHandler()
+	}
+
+	img.progressDialog.SetTitle("image used by in progress")
+	img.progressDialog.Display()
+
+	go usedByFunc()
+}
+
+func (img *Images) goTo() {
+	ref, ok := img.navigateDialog.GetSelectedReference()
+
+	img.navigateDialog.Hide()
+
+	if !ok || img.navigateHandler == nil {
+		return
+	}
+
+	img.navigateHandler(ref.Kind, ref.Target())
 }
 
 func (img *Images) cprune() {
-	img.confirmDialog.SetTitle("podman image prune")
-	img.confirmData = "prune"
-	img.confirmDialog.SetText("Are you sure you want to remove all unused images ?")
-	img.confirmDialog.Display()
+	img.pruneDialog.Display()
+	img.prunePreview()
+}
+
+func (img *Images) prunePreview() {
+	errorTitle := "IMAGE PRUNE PREVIEW ERROR"
+
+	opts, err := img.pruneDialog.GetPruneOptions()
+	if err != nil {
+		img.displayError(errorTitle, err)
+
+		return
+	}
+
+	img.progressDialog.SetTitle("image prune preview in progress")
+	img.progressDialog.Display()
+
+	preview := func() {
+		reports, err := images.PrunePreview(opts)
+
+		img.progressDialog.Hide()
+
+		if err != nil {
+			img.displayError(errorTitle, err)
+			img.appFocusHandler()
+
+			return
+		}
+
+		img.pruneDialog.SetPreview(reports)
+		img.appFocusHandler()
+	}
+
+	go preview()
 }
 
 func (img *Images) prune() {
+	opts, err := img.pruneDialog.GetPruneOptions()
+	if err != nil {
+		img.displayError("IMAGE PRUNE ERROR", err)
+
+		return
+	}
+
+	img.pruneDialog.Hide()
 	img.progressDialog.SetTitle("image prune in progress")
 	img.progressDialog.Display()
 
 	prune := func() {
-		err := images.Prune()
+		err := images.Prune(opts)
 
 		img.progressDialog.Hide()
 
@@ -27,6 +27,8 @@ func (img *Images) SortView(option string, ascending bool) {
 
 // UpdateData retrieves images list data.
 func (img *Images) UpdateData() {
+	img.detailsPanel.Invalidate()
+
 	images, err := images.List()
 	if err != nil {
 		log.Error().Msgf("view: images update %v", err)
@@ -0,0 +1,27 @@
+package images
+
+import (
+	"github.com/containers/podman-tui/pdcs/images"
+)
+
+// initDetailsPanel sets details panel title, its data loader and reloads
+// the details whenever the table selected item changes.
+func (img *Images) initDetailsPanel() {
+	img.detailsPanel.SetTitle("image details")
+	img.detailsPanel.SetLoadFunc(images.Details)
+	img.detailsPanel.SetSelectedItemFunc(img.selectedItemID)
+	img.detailsPanel.SetRefreshFunc(func() {
+		if img.fastRefreshChan != nil {
+			img.fastRefreshChan <- true
+		}
+	})
+	img.table.SetSelectionChangedFunc(func(_, _ int) {
+		img.detailsPanel.Update(img.selectedItemID())
+	})
+}
+
+func (img *Images) selectedItemID() string {
+	id, _ := img.getSelectedItem()
+
+	return id
+}
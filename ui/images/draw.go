@@ -11,12 +11,16 @@ func (img *Images) Draw(screen tcell.Screen) {
 
 	x, y, w, h := img.GetInnerRect()
 
-	img.refresh(w)
-	img.table.SetRect(x, y, w, h)
+	tableX, tableY, tableW, tableH := img.detailsPanel.Split(x, y, w, h)
+
+	img.refresh(tableW)
+	img.table.SetRect(tableX, tableY, tableW, tableH)
 	img.table.SetBorder(true)
 
 	img.table.Draw(screen)
 
+	img.detailsPanel.Draw(screen)
+
 	for _, dialog := range img.getInnerDialogs() {
 		if dialog.IsDisplay() {
 			dialog.SetRect(x, y, w, h)
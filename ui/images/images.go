@@ -32,6 +32,10 @@ var (
 	errNoImageToDiff       = errors.New("here is no image to display diff")
 	errNoImageToRemove     = errors.New("there is no image to remove")
 	errNoImageToInspect    = errors.New("there is no image to display inspect")
+	errNoImageToLayers     = errors.New("there is no image to explore layers")
+	errNoImageToCompare    = errors.New("there is no image to compare")
+	errNoImageToCompareTo  = errors.New("there is no other image to compare with")
+	errNoImageToUsedBy     = errors.New("there is no image to list its containers")
 	errNoBuildDirOrCntFile = errors.New("both context directory path and container files fields are empty")
 )
 
@@ -44,26 +48,33 @@ type Images struct {
 	title           string
 	headers         []string
 	table           *tview.Table
+	detailsPanel    *dialogs.DetailsPanel
 	errorDialog     *dialogs.ErrorDialog
 	progressDialog  *dialogs.ProgressDialog
 	cmdDialog       *dialogs.CommandDialog
 	cmdInputDialog  *dialogs.SimpleInputDialog
 	messageDialog   *dialogs.MessageDialog
+	inspectDialog   *dialogs.InspectDialog
 	confirmDialog   *dialogs.ConfirmDialog
 	sortDialog      *dialogs.SortDialog
 	searchDialog    *imgdialogs.ImageSearchDialog
 	historyDialog   *imgdialogs.ImageHistoryDialog
+	layersDialog    *imgdialogs.ImageLayersDialog
 	importDialog    *imgdialogs.ImageImportDialog
 	buildDialog     *imgdialogs.ImageBuildDialog
 	buildPrgDialog  *imgdialogs.ImageBuildProgressDialog
 	saveDialog      *imgdialogs.ImageSaveDialog
 	pushDialog      *imgdialogs.ImagePushDialog
+	pruneDialog     *dialogs.PruneDialog
+	compareDialog   *dialogs.CompareDialog
+	navigateDialog  *dialogs.NavigateDialog
 	imagesList      imageListReport
 	selectedID      string
 	selectedName    string
 	confirmData     string
 	fastRefreshChan chan bool
 	appFocusHandler func()
+	navigateHandler func(kind string, id string)
 }
 
 type imageListReport struct {
@@ -86,27 +97,35 @@ func NewImages() *Images {
 		title:          "images",
 		headers:        UIViewHeaders,
 		errorDialog:    dialogs.NewErrorDialog(),
+		detailsPanel:   dialogs.NewDetailsPanel(),
 		progressDialog: dialogs.NewProgressDialog(),
 		cmdInputDialog: dialogs.NewSimpleInputDialog(""),
 		messageDialog:  dialogs.NewMessageDialog(""),
+		inspectDialog:  dialogs.NewInspectDialog(),
 		confirmDialog:  dialogs.NewConfirmDialog(),
 		sortDialog:     dialogs.NewSortDialog(sortHeaderItems, 1),
 		searchDialog:   imgdialogs.NewImageSearchDialog(),
 		historyDialog:  imgdialogs.NewImageHistoryDialog(),
+		layersDialog:   imgdialogs.NewImageLayersDialog(),
 		importDialog:   imgdialogs.NewImageImportDialog(),
 		buildDialog:    imgdialogs.NewImageBuildDialog(),
 		buildPrgDialog: imgdialogs.NewImageBuildProgressDialog(),
 		saveDialog:     imgdialogs.NewImageSaveDialog(),
 		pushDialog:     imgdialogs.NewImagePushDialog(),
+		pruneDialog:    dialogs.NewPruneDialog(true, false),
+		compareDialog:  dialogs.NewCompareDialog(),
+		navigateDialog: dialogs.NewNavigateDialog(),
 		imagesList:     imageListReport{sortBy: UIViewHeaders[viewImageCreatedAtColIndex], ascending: true},
 	}
 
 	images.cmdDialog = dialogs.NewCommandDialog([][]string{
 		{"build", "build an image from Containerfile"},
+		{"compare", "compare the selected image with another image"},
 		{"diff", "inspect changes to the image's file systems"},
 		{"history", "show history of the selected image"},
 		{"import", "create a container image from a tarball"},
 		{"inspect", "display the configuration of the selected image"},
+		{"layers", "explore the selected image layers and files"},
 		{"prune", "remove all unused images"},
 		{"push", "push a source image to a specified destination"},
 		{"rm", "removes the selected  image from local storage"},
@@ -114,6 +133,7 @@ func NewImages() *Images {
 		{"search/pull", "search and pull image from registry"},
 		{"tag", "add an additional name to the selected  image"},
 		{"tree", "display layer hierarchy of an image"},
+		{"used by", "list containers which use the selected image and switch to one of them"},
 		{"untag", "remove a name from the selected image"},
 	})
 
@@ -143,6 +163,11 @@ func NewImages() *Images {
 		images.messageDialog.Hide()
 	})
 
+	// set inspect dialog functions
+	images.inspectDialog.SetCancelFunc(func() {
+		images.inspectDialog.Hide()
+	})
+
 	// set input cmd dialog functions
 	images.cmdInputDialog.SetCancelFunc(func() {
 		images.cmdInputDialog.Hide()
@@ -165,10 +190,7 @@ func NewImages() *Images {
 	images.confirmDialog.SetSelectedFunc(func() {
 		images.confirmDialog.Hide()
 
-		switch images.confirmData {
-		case utils.PruneCommandLabel:
-			images.prune()
-		case "rm":
+		if images.confirmData == "rm" {
 			images.remove()
 		}
 	})
@@ -182,6 +204,21 @@ func NewImages() *Images {
 		images.historyDialog.Hide()
 	})
 
+	// set compare dialog functions
+	images.compareDialog.SetTitle("podman image compare")
+	images.compareDialog.SetCancelFunc(func() {
+		images.compareDialog.Hide()
+	})
+
+	images.compareDialog.SetCompareFunc(images.compare)
+
+	// set layers dialog functions
+	images.layersDialog.SetCancelFunc(func() {
+		images.layersDialog.Hide()
+	})
+
+	images.layersDialog.SetLoadFilesFunc(images.layersFiles)
+
 	// set search dialogs functions
 	images.searchDialog.SetCancelFunc(func() {
 		images.searchDialog.Hide()
@@ -220,10 +257,24 @@ func NewImages() *Images {
 	images.pushDialog.SetPushFunc(images.push)
 	images.pushDialog.SetCancelFunc(images.pushDialog.Hide)
 
+	// set prune dialog functions
+	images.pruneDialog.SetTitle("podman image prune")
+	images.pruneDialog.SetCancelFunc(images.pruneDialog.Hide)
+	images.pruneDialog.SetPreviewFunc(images.prunePreview)
+	images.pruneDialog.SetPruneFunc(images.prune)
+
 	// set sort dialog functions
 	images.sortDialog.SetSelectFunc(images.SortView)
 	images.sortDialog.SetCancelFunc(images.sortDialog.Hide)
 
+	// set navigate dialog functions
+	images.navigateDialog.SetTitle("podman image used by")
+	images.navigateDialog.SetCancelFunc(images.navigateDialog.Hide)
+	images.navigateDialog.SetGoToFunc(images.goTo)
+
+	// set details panel functions
+	images.initDetailsPanel()
+
 	return images
 }
 
@@ -232,6 +283,11 @@ func (img *Images) SetAppFocusHandler(handler func()) {
 	img.appFocusHandler = handler
 }
 
+// SetNavigateFunc sets the handler which switches to another resource screen.
+func (img *Images) SetNavigateFunc(handler func(kind string, id string)) {
+	img.navigateHandler = handler
+}
+
 // GetTitle returns primitive title.
 func (img *Images) GetTitle() string {
 	return img.title
@@ -335,18 +391,41 @@ func (img *Images) getSelectedItem() (string, string) {
 	return imageID, imageName
 }
 
+// GetSelectedItemID returns the selected image ID.
+func (img *Images) GetSelectedItemID() string {
+	imageID, _ := img.getSelectedItem()
+
+	return imageID
+}
+
+// SelectItem selects the image by its ID or name.
+func (img *Images) SelectItem(id string) {
+	for i, image := range img.getData() {
+		if utils.MatchIDOrName(id, image.ID, image.Repository+":"+image.Tag) {
+			img.table.Select(i+1, 0)
+
+			return
+		}
+	}
+}
+
 func (img *Images) getInnerDialogs() []utils.UIDialog {
 	dialogs := []utils.UIDialog{
 		img.cmdDialog,
 		img.cmdInputDialog,
 		img.messageDialog,
+		img.inspectDialog,
 		img.searchDialog,
 		img.historyDialog,
+		img.layersDialog,
 		img.importDialog,
 		img.buildDialog,
 		img.buildPrgDialog,
 		img.saveDialog,
 		img.pushDialog,
+		img.pruneDialog,
+		img.compareDialog,
+		img.navigateDialog,
 		img.sortDialog,
 	}
 
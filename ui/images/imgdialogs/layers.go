@@ -0,0 +1,528 @@
+package imgdialogs
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/images"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/docker/go-units"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	imgLayersTableFocus = 0 + iota
+	imgLayersTreeFocus
+	imgLayersFormFocus
+)
+
+const (
+	imgLayersIndexColIndex = 0 + iota
+	imgLayersSizeColIndex
+	imgLayersCreatedByColIndex
+)
+
+const (
+	imgLayersSummaryHeight  = 3
+	imgLayersTopWastedFiles = 3
+	imgLayersExpandDepth    = 1
+)
+
+// ImageLayersDialog implements image layers explorer dialog primitive.
+type ImageLayersDialog struct {
+	*tview.Box
+
+	layout        *tview.Flex
+	imageInfo     *tview.InputField
+	table         *tview.Table
+	tree          *tview.TreeView
+	summary       *tview.TextView
+	form          *tview.Form
+	layers        []images.ImageLayer
+	wasted        []images.ImageWastedFile
+	layerIndex    int
+	filesLoaded   bool
+	changedOnly   bool
+	display       bool
+	focusElement  int
+	cancelHandler func()
+	filesHandler  func()
+}
+
+// NewImageLayersDialog returns new image layers explorer dialog primitive.
+func NewImageLayersDialog() *ImageLayersDialog {
+	dialog := &ImageLayersDialog{
+		Box:       tview.NewBox(),
+		layout:    tview.NewFlex(),
+		imageInfo: tview.NewInputField(),
+		table:     tview.NewTable(),
+		tree:      tview.NewTreeView(),
+		summary:   tview.NewTextView(),
+		form:      tview.NewForm(),
+	}
+
+	bgColor := style.DialogBgColor
+
+	// image info field
+	imageInfoLabel := "IMAGE ID:"
+
+	dialog.imageInfo.SetBackgroundColor(bgColor)
+	dialog.imageInfo.SetLabel("[::b]" + imageInfoLabel)
+	dialog.imageInfo.SetLabelWidth(len(imageInfoLabel) + 1)
+	dialog.imageInfo.SetFieldBackgroundColor(bgColor)
+	dialog.imageInfo.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(style.DialogFgColor))
+
+	// layers table
+	dialog.table.SetBackgroundColor(bgColor)
+	dialog.table.SetBorder(true)
+	dialog.table.SetBorderColor(style.DialogSubBoxBorderColor)
+	dialog.table.SetTitle("LAYERS")
+	dialog.table.SetSelectionChangedFunc(func(row, _ int) {
+		if row > 0 && row-1 != dialog.layerIndex {
+			dialog.showLayer(row - 1)
+		}
+	})
+
+	// files tree
+	dialog.tree.SetBackgroundColor(bgColor)
+	dialog.tree.SetBorder(true)
+	dialog.tree.SetBorderColor(style.DialogSubBoxBorderColor)
+	dialog.tree.SetGraphicsColor(style.DialogSubBoxBorderColor)
+	dialog.tree.SetSelectedFunc(func(node *tview.TreeNode) {
+		node.SetExpanded(!node.IsExpanded())
+	})
+
+	// summary
+	dialog.summary.SetBackgroundColor(bgColor)
+	dialog.summary.SetTextColor(style.DialogFgColor)
+	dialog.summary.SetDynamicColors(true)
+
+	// form
+	dialog.form.AddButton("Cancel", nil)
+	dialog.form.SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	explorerLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	explorerLayout.SetBackgroundColor(bgColor)
+	explorerLayout.AddItem(dialog.table, 0, 1, true)
+	explorerLayout.AddItem(dialog.tree, 0, 1, false)
+
+	mainLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	mainLayout.SetBackgroundColor(bgColor)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainLayout.AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(dialog.imageInfo, 1, 0, false).
+		AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false).
+		AddItem(explorerLayout, 0, 1, true).
+		AddItem(dialog.summary, imgLayersSummaryHeight, 0, false),
+		0, 1, true)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout.SetDirection(tview.FlexRow)
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetTitle("PODMAN IMAGE LAYERS")
+	dialog.layout.AddItem(mainLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
+
+	dialog.SetLayers(nil)
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *ImageLayersDialog) Display() {
+	d.display = true
+	d.focusElement = imgLayersTableFocus
+}
+
+// IsDisplay returns true if this primitive is shown.
+func (d *ImageLayersDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *ImageLayersDialog) Hide() {
+	d.display = false
+	d.focusElement = imgLayersTableFocus
+	d.changedOnly = false
+	d.SetLayers(nil)
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *ImageLayersDialog) HasFocus() bool {
+	if d.table.HasFocus() || d.tree.HasFocus() || d.form.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus() || d.layout.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *ImageLayersDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case imgLayersTableFocus:
+		delegate(d.table)
+	case imgLayersTreeFocus:
+		delegate(d.tree)
+	case imgLayersFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = imgLayersTableFocus
+
+				d.Focus(delegate)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *ImageLayersDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("image layers dialog: event %v received", event)
+
+		if event.Key() == utils.CloseDialogKey.Key {
+			d.cancelHandler()
+
+			return
+		}
+
+		if event.Key() == utils.SwitchFocusKey.Key && (d.table.HasFocus() || d.tree.HasFocus()) {
+			d.focusElement = imgLayersTreeFocus
+
+			if d.tree.HasFocus() {
+				d.focusElement = imgLayersFormFocus
+			}
+
+			d.Focus(setFocus)
+
+			return
+		}
+
+		if event.Rune() == 'f' && !d.filesLoaded && (d.table.HasFocus() || d.tree.HasFocus()) {
+			if d.filesHandler != nil {
+				d.filesHandler()
+			}
+
+			return
+		}
+
+		if event.Rune() == 'c' && (d.table.HasFocus() || d.tree.HasFocus()) {
+			d.changedOnly = !d.changedOnly
+			d.showLayer(d.layerIndex)
+
+			return
+		}
+
+		if d.table.HasFocus() {
+			if tableHandler := d.table.InputHandler(); tableHandler != nil {
+				tableHandler(utils.ParseKeyEventKey(event), setFocus)
+
+				return
+			}
+		}
+
+		if d.tree.HasFocus() {
+			if treeHandler := d.tree.InputHandler(); treeHandler != nil {
+				treeHandler(utils.ParseKeyEventKey(event), setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+// SetRect set rects for this primitive.
+func (d *ImageLayersDialog) SetRect(x, y, width, height int) {
+	dX := x + 1
+	dY := y + 1
+	dWidth := width - 2   //nolint:mnd
+	dHeight := height - 2 //nolint:mnd
+
+	d.Box.SetRect(dX, dY, dWidth, dHeight)
+}
+
+// Draw draws this primitive into the screen.
+func (d *ImageLayersDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.DrawForSubclass(screen, d)
+
+	x, y, width, height := d.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *ImageLayersDialog) SetCancelFunc(handler func()) *ImageLayersDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetLoadFilesFunc sets the layers files load function, it is called when
+// the user requests the layers contents.
+func (d *ImageLayersDialog) SetLoadFilesFunc(handler func()) *ImageLayersDialog {
+	d.filesHandler = handler
+
+	return d
+}
+
+// SetImageInfo sets dialog image ID and name.
+func (d *ImageLayersDialog) SetImageInfo(id string, name string) {
+	imageInfo := fmt.Sprintf("%12s (%s)", id, name)
+	d.imageInfo.SetText(imageInfo)
+}
+
+// SetLayers sets image layers and shows the first layer.
+// The layers files tree is shown once the layers files are set.
+func (d *ImageLayersDialog) SetLayers(layers []images.ImageLayer) {
+	d.layers = layers
+	d.wasted = nil
+	d.filesLoaded = false
+	d.layerIndex = 0
+
+	d.initTable()
+
+	for i, layer := range layers {
+		row := i + 1
+		createdBy := strings.TrimSpace(strings.TrimPrefix(layer.CreatedBy, "/bin/sh -c "))
+
+		d.table.SetCell(row, imgLayersIndexColIndex,
+			tview.NewTableCell(fmt.Sprintf("%d", row)).
+				SetAlign(tview.AlignLeft))
+
+		d.table.SetCell(row, imgLayersSizeColIndex,
+			tview.NewTableCell(units.HumanSize(float64(layer.Size))).
+				SetAlign(tview.AlignRight))
+
+		d.table.SetCell(row, imgLayersCreatedByColIndex,
+			tview.NewTableCell(tview.Escape(createdBy)).
+				SetExpansion(1).
+				SetAlign(tview.AlignLeft))
+	}
+
+	if len(layers) > 0 {
+		d.table.Select(1, 0)
+		d.table.ScrollToBeginning()
+	}
+
+	d.showLayer(0)
+}
+
+// SetLayersFiles sets the files of each image layer and shows the selected layer files tree.
+func (d *ImageLayersDialog) SetLayersFiles(files [][]images.ImageLayerFile) {
+	for i := range d.layers {
+		if i < len(files) {
+			d.layers[i].Files = files[i]
+		}
+	}
+
+	d.wasted = images.LayersWastedFiles(d.layers)
+	d.filesLoaded = true
+
+	d.showLayer(d.layerIndex)
+}
+
+func (d *ImageLayersDialog) initTable() {
+	bgColor := style.TableHeaderBgColor
+	fgColor := style.TableHeaderFgColor
+	headers := []string{"#", "size", "created by"}
+
+	d.table.Clear()
+	d.table.SetFixed(1, 1)
+	d.table.SetSelectable(true, false)
+
+	for i := range headers {
+		d.table.SetCell(0, i,
+			tview.NewTableCell(fmt.Sprintf("[%s::b]%s", style.GetColorHex(fgColor), strings.ToUpper(headers[i]))).
+				SetBackgroundColor(bgColor).
+				SetTextColor(fgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
+	}
+}
+
+// showLayer updates files tree and summary for the specified layer index.
+func (d *ImageLayersDialog) showLayer(index int) {
+	d.layerIndex = index
+
+	root := tview.NewTreeNode("/").
+		SetColor(style.DialogFgColor).
+		SetSelectable(true).
+		SetExpanded(true)
+
+	treeTitle := "FILES"
+	if d.changedOnly {
+		treeTitle = "FILES (CHANGED)"
+	}
+
+	d.tree.SetTitle(treeTitle)
+	d.tree.SetRoot(root)
+	d.tree.SetCurrentNode(root)
+
+	if index >= len(d.layers) {
+		d.summary.SetText("")
+
+		return
+	}
+
+	layer := d.layers[index]
+	summary := fmt.Sprintf("[::b]LAYER %d/%d:[::-] %s\n",
+		index+1, len(d.layers), tview.Escape(strings.TrimSpace(layer.CreatedBy)))
+
+	if !d.filesLoaded {
+		summary += fmt.Sprintf("[::b]SIZE:[::-] %s  [::b]f:[::-] load layers files (exports the image)\n",
+			units.HumanSize(float64(layer.Size)))
+
+		d.summary.SetText(summary)
+
+		return
+	}
+
+	var (
+		added, modified, deleted int
+		nodes                    = map[string]*tview.TreeNode{"/": root}
+	)
+
+	for _, entry := range images.LayerTree(d.layers, index) {
+		switch entry.Status {
+		case images.LayerFileAdded:
+			added++
+		case images.LayerFileModified:
+			modified++
+		case images.LayerFileDeleted:
+			deleted++
+		}
+
+		if d.changedOnly && entry.Status == "" {
+			continue
+		}
+
+		node := layerTreeNode(nodes, entry.Path, d.changedOnly)
+		node.SetText(layerTreeEntryLabel(entry))
+		node.SetColor(layerTreeEntryColor(entry.Status))
+	}
+
+	summary += fmt.Sprintf("[::b]CHANGES:[::-] %d added, %d modified, %d deleted (%s)  [::b]c:[::-] toggle changed files only\n", //nolint:lll
+		added, modified, deleted, units.HumanSize(float64(layer.Size)))
+	summary += d.wastedSummary()
+
+	d.summary.SetText(summary)
+}
+
+func (d *ImageLayersDialog) wastedSummary() string {
+	var wastedSize int64
+
+	for _, file := range d.wasted {
+		wastedSize += file.Size
+	}
+
+	summary := "[::b]WASTED SPACE:[::-] " + units.HumanSize(float64(wastedSize))
+
+	topFiles := make([]string, 0, imgLayersTopWastedFiles)
+
+	for i := 0; i < len(d.wasted) && i < imgLayersTopWastedFiles; i++ {
+		topFiles = append(topFiles, fmt.Sprintf("%s (%s)",
+			tview.Escape(d.wasted[i].Path),
+			units.HumanSize(float64(d.wasted[i].Size))))
+	}
+
+	if len(topFiles) > 0 {
+		summary = fmt.Sprintf("%s - %s", summary, strings.Join(topFiles, ", "))
+	}
+
+	return summary
+}
+
+// layerTreeNode returns the tree node of the path and creates its missing parent nodes.
+func layerTreeNode(nodes map[string]*tview.TreeNode, filePath string, expand bool) *tview.TreeNode {
+	if node, ok := nodes[filePath]; ok {
+		return node
+	}
+
+	parentNode := layerTreeNode(nodes, path.Dir(filePath), expand)
+	depth := strings.Count(filePath, "/")
+
+	node := tview.NewTreeNode(tview.Escape(path.Base(filePath))).
+		SetColor(style.DialogFgColor).
+		SetSelectable(true).
+		SetExpanded(expand || depth < imgLayersExpandDepth)
+
+	parentNode.AddChild(node)
+	nodes[filePath] = node
+
+	return node
+}
+
+func layerTreeEntryLabel(entry images.ImageLayerTreeEntry) string {
+	var prefix string
+
+	switch entry.Status {
+	case images.LayerFileAdded:
+		prefix = "+ "
+	case images.LayerFileModified:
+		prefix = "~ "
+	case images.LayerFileDeleted:
+		prefix = "- "
+	}
+
+	label := prefix + tview.Escape(path.Base(entry.Path))
+
+	if entry.IsDir {
+		return label + "/"
+	}
+
+	label = fmt.Sprintf("%s (%s)", label, units.HumanSize(float64(entry.Size)))
+
+	if entry.Wasted > 0 {
+		label += " (wasted)"
+	}
+
+	return label
+}
+
+func layerTreeEntryColor(status string) tcell.Color {
+	switch status {
+	case images.LayerFileAdded:
+		return style.PrgBarOKColor
+	case images.LayerFileModified:
+		return style.PrgBarWarnColor
+	case images.LayerFileDeleted:
+		return style.PrgBarCritColor
+	}
+
+	return style.DialogFgColor
+}
@@ -0,0 +1,175 @@
+package imgdialogs
+
+import (
+	"github.com/containers/podman-tui/pdcs/images"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("image layers", Ordered, func() {
+	var layersDialogApp *tview.Application
+	var layersDialogScreen tcell.SimulationScreen
+	var layersDialog *ImageLayersDialog
+	var runApp func()
+
+	layers := []images.ImageLayer{
+		{
+			ID:        "sha256:layer1",
+			CreatedBy: "ADD rootfs.tar /",
+			Size:      300,
+		},
+		{
+			ID:        "sha256:layer2",
+			CreatedBy: "RUN update",
+			Size:      150,
+		},
+	}
+
+	layersFiles := [][]images.ImageLayerFile{
+		{
+			{Path: "/etc", IsDir: true},
+			{Path: "/etc/hosts", Size: 100},
+			{Path: "/tmp", IsDir: true},
+			{Path: "/tmp/cache", Size: 200},
+		},
+		{
+			{Path: "/etc", IsDir: true},
+			{Path: "/etc/hosts", Size: 120},
+			{Path: "/tmp/cache", Deleted: true},
+			{Path: "/usr", IsDir: true},
+			{Path: "/usr/app", Size: 30},
+		},
+	}
+
+	findNode := func(label string) *tview.TreeNode {
+		var found *tview.TreeNode
+
+		layersDialog.tree.GetRoot().Walk(func(node, _ *tview.TreeNode) bool {
+			if node.GetText() == label {
+				found = node
+			}
+
+			return found == nil
+		})
+
+		return found
+	}
+
+	BeforeAll(func() {
+		layersDialogApp = tview.NewApplication()
+		layersDialog = NewImageLayersDialog()
+		layersDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := layersDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+
+		runApp = func() {
+			if err := layersDialogApp.SetScreen(layersDialogScreen).SetRoot(layersDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		layersDialog.Display()
+		layersDialogApp.Draw()
+		Expect(layersDialog.IsDisplay()).To(Equal(true))
+	})
+
+	It("set focus", func() {
+		layersDialogApp.SetFocus(layersDialog)
+		layersDialogApp.Draw()
+		Expect(layersDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("set layers", func() {
+		layersDialog.SetLayers(layers)
+		layersDialogApp.Draw()
+		Expect(layersDialog.table.GetRowCount()).To(Equal(3))
+		Expect(layersDialog.table.GetCell(2, imgLayersCreatedByColIndex).Text).To(Equal("RUN update"))
+
+		// layers files are not loaded until requested
+		Expect(layersDialog.tree.GetRoot().GetChildren()).To(BeEmpty())
+	})
+
+	It("load layers files", func() {
+		loadWants := "load files selected"
+		loadAction := "load files init"
+
+		layersDialog.SetLoadFilesFunc(func() {
+			loadAction = loadWants
+		})
+
+		layersDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyRune, 'f', tcell.ModNone))
+		layersDialogApp.Draw()
+		Expect(loadAction).To(Equal(loadWants))
+
+		layersDialog.SetLayersFiles(layersFiles)
+		layersDialogApp.Draw()
+
+		// first layer files are added, overwritten and removed files are wasted
+		hostsNode := findNode("+ hosts (100B) (wasted)")
+		Expect(hostsNode).NotTo(BeNil())
+		Expect(hostsNode.GetColor()).To(Equal(style.PrgBarOKColor))
+		Expect(findNode("+ cache (200B) (wasted)")).NotTo(BeNil())
+	})
+
+	It("select next layer", func() {
+		layersDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone))
+		layersDialogApp.Draw()
+		Expect(layersDialog.layerIndex).To(Equal(1))
+
+		hostsNode := findNode("~ hosts (120B)")
+		Expect(hostsNode).NotTo(BeNil())
+		Expect(hostsNode.GetColor()).To(Equal(style.PrgBarWarnColor))
+
+		cacheNode := findNode("- cache (200B)")
+		Expect(cacheNode).NotTo(BeNil())
+		Expect(cacheNode.GetColor()).To(Equal(style.PrgBarCritColor))
+
+		Expect(findNode("+ app (30B)")).NotTo(BeNil())
+	})
+
+	It("toggle changed files only", func() {
+		Expect(findNode("etc/")).NotTo(BeNil())
+		layersDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyRune, 'c', tcell.ModNone))
+		layersDialogApp.Draw()
+		Expect(layersDialog.changedOnly).To(Equal(true))
+		Expect(findNode("etc/")).To(BeNil())
+		Expect(findNode("~ hosts (120B)")).NotTo(BeNil())
+	})
+
+	It("cancel button selected", func() {
+		cancelWants := "cancel selected"
+		cancelAction := "cancel init"
+
+		cancelFunc := func() {
+			cancelAction = cancelWants
+		}
+
+		layersDialog.SetCancelFunc(cancelFunc)
+		layersDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		layersDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		layersDialogApp.Draw()
+		layersDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		layersDialogApp.Draw()
+		Expect(cancelWants).To(Equal(cancelAction))
+	})
+
+	It("hide", func() {
+		layersDialog.Hide()
+		Expect(layersDialog.IsDisplay()).To(Equal(false))
+	})
+
+	AfterAll(func() {
+		layersDialogApp.Stop()
+	})
+})
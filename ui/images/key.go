@@ -54,6 +54,13 @@ func (img *Images) InputHandler() func(event *tcell.EventKey, setFocus func(p tv
 				return
 			}
 
+			// toggle details panel
+			if event.Rune() == utils.DetailsPanelKey.Rune() {
+				img.detailsPanel.Toggle()
+
+				return
+			}
+
 			if event.Key() == utils.DeleteKey.EventKey() {
 				img.rm()
 				setFocus(img)
@@ -0,0 +1,29 @@
+package images
+
+import putils "github.com/containers/podman-tui/pdcs/utils"
+
+// GetCommands returns the images screen commands and their descriptions.
+func (img *Images) GetCommands() [][]string {
+	return img.cmdDialog.GetCommands()
+}
+
+// RunCommand runs the images screen command on the selected item.
+func (img *Images) RunCommand(cmd string) {
+	img.runCommand(cmd)
+}
+
+// GetResources returns the images listed on the screen.
+func (img *Images) GetResources() []putils.ResourceReference {
+	data := img.getData()
+	resources := make([]putils.ResourceReference, 0, len(data))
+
+	for _, item := range data {
+		resources = append(resources, putils.ResourceReference{
+			Kind: putils.ResourceKindImage,
+			ID:   item.ID,
+			Name: item.Repository + ":" + item.Tag,
+		})
+	}
+
+	return resources
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/containers/podman-tui/pdcs/containers"
 	"github.com/containers/podman-tui/pdcs/networks"
+	"github.com/containers/podman-tui/pdcs/registry"
 	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/containers/podman-tui/ui/style"
 	"github.com/containers/podman-tui/ui/utils"
@@ -17,7 +18,7 @@ func (nets *Networks) runCommand(cmd string) {
 	case "connect":
 		nets.cconnect()
 	case "create":
-		nets.createDialog.Display()
+		nets.ccreate()
 	case "disconnect":
 		nets.cdisconnect()
 	case "inspect":
@@ -26,6 +27,12 @@ func (nets *Networks) runCommand(cmd string) {
 		nets.cprune()
 	case "rm":
 		nets.rm()
+	case "topology":
+		nets.topology()
+	case "update":
+		nets.cupdate()
+	case "used by":
+		nets.usedBy()
 	}
 }
 
@@ -145,10 +152,48 @@ func (nets *Networks) disconnect() {
 	go disconnect()
 }
 
+func (nets *Networks) ccreate() {
+	initData := func() {
+		nets.progressDialog.SetTitle("podman network create")
+		nets.progressDialog.Display()
+
+		parentInterfaces, err := networks.ParentInterfaces()
+
+		nets.progressDialog.Hide()
+
+		if err != nil {
+			nets.displayError("NETWORK CREATE ERROR", err)
+			nets.appFocusHandler()
+
+			return
+		}
+
+		nets.createDialog.SetParentInterfaces(parentInterfaces)
+		nets.createDialog.Display()
+		nets.appFocusHandler()
+	}
+
+	go initData()
+}
+
 func (nets *Networks) create() {
-	createOpts := nets.createDialog.NetworkCreateOptions()
+	createOpts, err := nets.createDialog.NetworkCreateOptions()
+	if err != nil {
+		nets.displayError("NETWORK CREATE ERROR", err)
+
+		return
+	}
+
+	// validate options before closing the dialog so user can fix the input
+	if err := networks.ValidateCreateOptions(createOpts); err != nil {
+		nets.displayError("NETWORK CREATE ERROR", err)
+
+		return
+	}
 
-	_, err := networks.Create(createOpts)
+	nets.createDialog.Hide()
+
+	_, err = networks.Create(createOpts)
 	if err != nil {
 		nets.displayError("NETWORK CREATE ERROR", err)
 
@@ -176,26 +221,62 @@ func (nets *Networks) inspect() {
 
 	headerLabel := fmt.Sprintf("%s (%s)", netID, netName)
 
-	nets.messageDialog.SetTitle("podman network inspect")
-	nets.messageDialog.SetText(dialogs.MessageNetworkInfo, headerLabel, data)
-	nets.messageDialog.DisplayFullSize()
+	nets.inspectDialog.SetTitle("podman network inspect")
+	nets.inspectDialog.SetData(dialogs.MessageNetworkInfo, headerLabel, data)
+	nets.inspectDialog.Display()
 }
 
 func (nets *Networks) cprune() {
-	nets.confirmDialog.SetTitle("podman network prune")
+	nets.pruneDialog.Display()
+	nets.prunePreview()
+}
 
-	nets.confirmData = utils.PruneCommandLabel
+func (nets *Networks) prunePreview() {
+	errorTitle := "NETWORK PRUNE PREVIEW ERROR"
 
-	nets.confirmDialog.SetText("Are you sure you want to remove all un used network ?")
-	nets.confirmDialog.Display()
+	opts, err := nets.pruneDialog.GetPruneOptions()
+	if err != nil {
+		nets.displayError(errorTitle, err)
+
+		return
+	}
+
+	nets.progressDialog.SetTitle("network prune preview in progress")
+	nets.progressDialog.Display()
+
+	preview := func() {
+		reports, err := networks.PrunePreview(opts)
+
+		nets.progressDialog.Hide()
+
+		if err != nil {
+			nets.displayError(errorTitle, err)
+			nets.appFocusHandler()
+
+			return
+		}
+
+		nets.pruneDialog.SetPreview(reports)
+		nets.appFocusHandler()
+	}
+
+	go preview()
 }
 
 func (nets *Networks) prune() {
+	opts, err := nets.pruneDialog.GetPruneOptions()
+	if err != nil {
+		nets.displayError("NETWORK PRUNE ERROR", err)
+
+		return
+	}
+
+	nets.pruneDialog.Hide()
 	nets.progressDialog.SetTitle("network prune in progress")
 	nets.progressDialog.Display()
 
 	prune := func() {
-		err := networks.Prune()
+		err := networks.Prune(opts)
 
 		nets.progressDialog.Hide()
 
@@ -252,3 +333,166 @@ func (nets *Networks) remove() {
 
 	go remove(nets.selectedID)
 }
+
+func (nets *Networks) topology() {
+	nets.progressDialog.SetTitle("podman network topology")
+	nets.progressDialog.Display()
+
+	topology := func() {
+		report, err := networks.Topology()
+
+		nets.progressDialog.Hide()
+
+		if err != nil {
+			nets.displayError("NETWORK TOPOLOGY ERROR", err)
+			nets.appFocusHandler()
+
+			return
+		}
+
+		nets.topologyDialog.SetServiceName(registry.ConnectionName())
+		nets.topologyDialog.UpdateResults(report)
+		nets.topologyDialog.Display()
+		nets.appFocusHandler()
+	}
+
+	go topology()
+}
+
+func (nets *Networks) usedBy() {
+	netID, netName := nets.getSelectedItem()
+	if netID == "" {
+		nets.displayError("", errNoNetworkUsedBy)
+
+		return
+	}
+
+	nets.progressDialog.SetTitle("network used by in progress")
+	nets.progressDialog.Display()
+
+	usedBy := func() {
+		refs, err := containers.UsedByNetwork(netName)
+
+		nets.progressDialog.Hide()
+
+		if err != nil {
+			nets.displayError("NETWORK USED BY ERROR", err)
+			nets.appFocusHandler()
+
+			return
+		}
+
+		nets.navigateDialog.SetResourceInfo("network id", netID, netName)
+		nets.navigateDialog.SetReferences(refs)
+		nets.navigateDialog.Display()
+		nets.appFocusHandler()
+	}
+
+	go usedBy()
+}
+
+func (nets *Networks) goTo() {
+	ref, ok := nets.navigateDialog.GetSelectedReference()
+
+	nets.navigateDialog.Hide()
+
+	if !ok || nets.navigateHandler == nil {
+		return
+	}
+
+	nets.navigateHandler(ref.Kind, ref.Target())
+}
+
+func (nets *Networks) cupdate() {
+	if nets.selectedID == "" {
+		nets.displayError("", errNoNetworkUpdate)
+
+		return
+	}
+
+	netID, netName := nets.getSelectedItem()
+
+	var dnsServers []string
+
+	for _, netItem := range nets.getData() {
+		if netItem.Name == netName {
+			dnsServers = netItem.NetworkDNSServers
+
+			break
+		}
+	}
+
+	nets.updateDialog.SetNetworkInfo(netID, netName, dnsServers)
+	nets.updateDialog.Display()
+}
+
+func (nets *Networks) update() {
+	netName, updateOptions := nets.updateDialog.GetUpdateOptions()
+
+	update := func() {
+		nets.updateDialog.Hide()
+		nets.progressDialog.SetTitle("podman network update")
+		nets.progressDialog.Display()
+
+		err := networks.Update(netName, updateOptions)
+		if err != nil {
+			nets.progressDialog.Hide()
+			nets.displayError("NETWORK UPDATE ERROR", err)
+			nets.appFocusHandler()
+
+			return
+		}
+
+		nets.UpdateData()
+
+		// display updated network and its attached containers
+		topologyReport, err := networks.Topology()
+
+		nets.progressDialog.Hide()
+
+		if err != nil {
+			nets.displayError("NETWORK UPDATE ERROR", err)
+			nets.appFocusHandler()
+
+			return
+		}
+
+		for _, netReport := range topologyReport {
+			if netReport.Network.Name != netName {
+				continue
+			}
+
+			headerLabel := fmt.Sprintf("%s (%s)", netReport.Network.ID, netReport.Network.Name)
+
+			nets.messageDialog.SetTitle("podman network update")
+			nets.messageDialog.SetText(dialogs.MessageNetworkInfo, headerLabel, updateReportText(netReport))
+			nets.messageDialog.Display()
+
+			break
+		}
+
+		nets.appFocusHandler()
+	}
+
+	go update()
+}
+
+func updateReportText(netReport networks.TopologyReport) string {
+	var report strings.Builder
+
+	fmt.Fprintf(&report, "dns servers: %s\n", strings.Join(netReport.Network.NetworkDNSServers, ", "))
+
+	if len(netReport.Endpoints) == 0 {
+		report.WriteString("\nno attached containers")
+
+		return report.String()
+	}
+
+	report.WriteString("\nattached containers:\n")
+
+	for _, endpoint := range netReport.Endpoints {
+		fmt.Fprintf(&report, "  %s (%s)\n", utils.GetIDWithLimit(endpoint.ContainerID), endpoint.ContainerName)
+	}
+
+	return report.String()
+}
@@ -27,6 +27,8 @@ func (nets *Networks) SortView(option string, ascending bool) {
 
 // UpdateData retrieves networks list data.
 func (nets *Networks) UpdateData() {
+	nets.detailsPanel.Invalidate()
+
 	netList, err := networks.List()
 	if err != nil {
 		log.Error().Msgf("view: networks update %v", err)
@@ -0,0 +1,27 @@
+package networks
+
+import (
+	"github.com/containers/podman-tui/pdcs/networks"
+)
+
+// initDetailsPanel sets details panel title, its data loader and reloads
+// the details whenever the table selected item changes.
+func (nets *Networks) initDetailsPanel() {
+	nets.detailsPanel.SetTitle("network details")
+	nets.detailsPanel.SetLoadFunc(networks.Details)
+	nets.detailsPanel.SetSelectedItemFunc(nets.selectedItemID)
+	nets.detailsPanel.SetRefreshFunc(func() {
+		if nets.fastRefreshChan != nil {
+			nets.fastRefreshChan <- true
+		}
+	})
+	nets.table.SetSelectionChangedFunc(func(_, _ int) {
+		nets.detailsPanel.Update(nets.selectedItemID())
+	})
+}
+
+func (nets *Networks) selectedItemID() string {
+	id, _ := nets.getSelectedItem()
+
+	return id
+}
@@ -11,12 +11,16 @@ func (nets *Networks) Draw(screen tcell.Screen) {
 
 	x, y, w, h := nets.GetInnerRect()
 
-	nets.table.SetRect(x, y, w, h)
-	nets.refresh(w)
+	tableX, tableY, tableW, tableH := nets.detailsPanel.Split(x, y, w, h)
+
+	nets.table.SetRect(tableX, tableY, tableW, tableH)
+	nets.refresh(tableW)
 	nets.table.SetBorder(true)
 
 	nets.table.Draw(screen)
 
+	nets.detailsPanel.Draw(screen)
+
 	for _, dialog := range nets.getInnerDialogs() {
 		if dialog.IsDisplay() {
 			dialog.SetRect(x, y, w, h)
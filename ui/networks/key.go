@@ -46,6 +46,13 @@ func (nets *Networks) InputHandler() func(event *tcell.EventKey, setFocus func(p
 				return
 			}
 
+			// toggle details panel
+			if event.Rune() == utils.DetailsPanelKey.Rune() {
+				nets.detailsPanel.Toggle()
+
+				return
+			}
+
 			if event.Key() == utils.DeleteKey.EventKey() {
 				nets.rm()
 				setFocus(nets)
@@ -2,6 +2,7 @@ package netdialogs
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/containers/podman-tui/pdcs/networks"
@@ -15,7 +16,7 @@ import (
 
 const (
 	networkCreateDialogMaxWidth = 80
-	networkCreateDialogHeight   = 17
+	networkCreateDialogHeight   = 21
 )
 
 const (
@@ -32,11 +33,21 @@ const (
 	networkIPRangeFieldFocus
 	networkSubnetFieldFocus
 	networkDisableDNSCheckBoxFocus
+	networkDNSServersFieldFocus
+	networkIPAMDriverFieldFocus
+	networkInterfaceNameFieldFocus
+	networkParentFieldFocus
+	networkIsolateFieldFocus
+	networkMTUFieldFocus
+	networkMetricFieldFocus
+	networkVLANFieldFocus
+	networkRoutesFieldFocus
 )
 
 const (
 	basicInfoPageIndex = 0 + iota
 	ipSettingsPageIndex
+	interfacePageIndex
 )
 
 // NetworkCreateDialog implements network create dialog.
@@ -49,6 +60,7 @@ type NetworkCreateDialog struct {
 	categoryPages             *tview.Pages
 	basicInfoPage             *tview.Flex
 	ipSettingsPage            *tview.Flex
+	interfacePage             *tview.Flex
 	form                      *tview.Form
 	display                   bool
 	activePageIndex           int
@@ -63,6 +75,16 @@ type NetworkCreateDialog struct {
 	networkIPRangeField       *tview.InputField
 	networkSubnetField        *tview.InputField
 	networkDisableDNSCheckBox *tview.Checkbox
+	networkDNSServersField    *tview.InputField
+	networkIPAMDriverField    *tview.DropDown
+	networkInterfaceField     *tview.InputField
+	networkParentField        *tview.DropDown
+	parentInterfaces          []string
+	networkIsolateField       *tview.DropDown
+	networkMTUField           *tview.InputField
+	networkMetricField        *tview.InputField
+	networkVLANField          *tview.InputField
+	networkRoutesField        *tview.InputField
 	cancelHandler             func()
 	createHandler             func()
 }
@@ -76,8 +98,9 @@ func NewNetworkCreateDialog() *NetworkCreateDialog {
 		categoryPages:             tview.NewPages(),
 		basicInfoPage:             tview.NewFlex(),
 		ipSettingsPage:            tview.NewFlex(),
+		interfacePage:             tview.NewFlex(),
 		form:                      tview.NewForm(),
-		categoryLabels:            []string{"Basic Information", "IP Settings"},
+		categoryLabels:            []string{"Basic Information", "IP Settings", "Interface & Routes"},
 		activePageIndex:           0,
 		display:                   false,
 		networkNameField:          tview.NewInputField(),
@@ -90,11 +113,22 @@ func NewNetworkCreateDialog() *NetworkCreateDialog {
 		networkIPRangeField:       tview.NewInputField(),
 		networkSubnetField:        tview.NewInputField(),
 		networkDisableDNSCheckBox: tview.NewCheckbox(),
+		networkDNSServersField:    tview.NewInputField(),
+		networkIPAMDriverField:    tview.NewDropDown(),
+		networkInterfaceField:     tview.NewInputField(),
+		networkParentField:        tview.NewDropDown(),
+		networkIsolateField:       tview.NewDropDown(),
+		networkMTUField:           tview.NewInputField(),
+		networkMetricField:        tview.NewInputField(),
+		networkVLANField:          tview.NewInputField(),
+		networkRoutesField:        tview.NewInputField(),
 	}
 
 	bgColor := style.DialogBgColor
 	fgColor := style.DialogFgColor
 	buttonBgColor := style.ButtonBgColor
+	ddUnselectedStyle := style.DropDownUnselected
+	ddselectedStyle := style.DropDownSelected
 
 	netDialog.categories.SetDynamicColors(true).
 		SetWrap(true).
@@ -174,6 +208,75 @@ func NewNetworkCreateDialog() *NetworkCreateDialog {
 	netDialog.networkDisableDNSCheckBox.SetLabelColor(tcell.ColorWhite)
 	netDialog.networkDisableDNSCheckBox.SetFieldBackgroundColor(style.FieldBackgroundColor)
 
+	// dns servers
+	netDialog.networkDNSServersField.SetBackgroundColor(bgColor)
+	netDialog.networkDNSServersField.SetLabel(utils.StringToInputLabel("dns servers:", ipSettingsPageLabelWidth))
+	netDialog.networkDNSServersField.SetFieldStyle(style.InputFieldStyle)
+	netDialog.networkDNSServersField.SetLabelStyle(style.InputLabelStyle)
+
+	// ipam driver
+	netDialog.networkIPAMDriverField.SetLabel("ipam driver:")
+	netDialog.networkIPAMDriverField.SetLabelWidth(ipSettingsPageLabelWidth)
+	netDialog.networkIPAMDriverField.SetBackgroundColor(bgColor)
+	netDialog.networkIPAMDriverField.SetLabelColor(fgColor)
+	netDialog.networkIPAMDriverField.SetOptions(networks.IPAMDrivers(), nil)
+	netDialog.networkIPAMDriverField.SetListStyles(ddUnselectedStyle, ddselectedStyle)
+	netDialog.networkIPAMDriverField.SetFocusedStyle(style.DropDownFocused)
+	netDialog.networkIPAMDriverField.SetFieldBackgroundColor(style.FieldBackgroundColor)
+
+	// interface and routes page
+	interfacePageLabelWidth := 12
+
+	// interface name
+	netDialog.networkInterfaceField.SetBackgroundColor(bgColor)
+	netDialog.networkInterfaceField.SetLabel(utils.StringToInputLabel("interface:", interfacePageLabelWidth))
+	netDialog.networkInterfaceField.SetFieldStyle(style.InputFieldStyle)
+	netDialog.networkInterfaceField.SetLabelStyle(style.InputLabelStyle)
+
+	// macvlan/ipvlan parent interface
+	netDialog.networkParentField.SetLabel("parent:")
+	netDialog.networkParentField.SetLabelWidth(interfacePageLabelWidth)
+	netDialog.networkParentField.SetBackgroundColor(bgColor)
+	netDialog.networkParentField.SetLabelColor(fgColor)
+	netDialog.networkParentField.SetOptions([]string{""}, nil)
+	netDialog.networkParentField.SetListStyles(ddUnselectedStyle, ddselectedStyle)
+	netDialog.networkParentField.SetFocusedStyle(style.DropDownFocused)
+	netDialog.networkParentField.SetFieldBackgroundColor(style.FieldBackgroundColor)
+
+	// isolate
+	netDialog.networkIsolateField.SetLabel("isolate:")
+	netDialog.networkIsolateField.SetLabelWidth(interfacePageLabelWidth)
+	netDialog.networkIsolateField.SetBackgroundColor(bgColor)
+	netDialog.networkIsolateField.SetLabelColor(fgColor)
+	netDialog.networkIsolateField.SetOptions([]string{"", "true", "false", "strict"}, nil)
+	netDialog.networkIsolateField.SetListStyles(ddUnselectedStyle, ddselectedStyle)
+	netDialog.networkIsolateField.SetFocusedStyle(style.DropDownFocused)
+	netDialog.networkIsolateField.SetFieldBackgroundColor(style.FieldBackgroundColor)
+
+	// mtu
+	netDialog.networkMTUField.SetBackgroundColor(bgColor)
+	netDialog.networkMTUField.SetLabel(utils.StringToInputLabel("mtu:", interfacePageLabelWidth))
+	netDialog.networkMTUField.SetFieldStyle(style.InputFieldStyle)
+	netDialog.networkMTUField.SetLabelStyle(style.InputLabelStyle)
+
+	// metric
+	netDialog.networkMetricField.SetBackgroundColor(bgColor)
+	netDialog.networkMetricField.SetLabel(utils.StringToInputLabel("metric:", interfacePageLabelWidth))
+	netDialog.networkMetricField.SetFieldStyle(style.InputFieldStyle)
+	netDialog.networkMetricField.SetLabelStyle(style.InputLabelStyle)
+
+	// vlan
+	netDialog.networkVLANField.SetBackgroundColor(bgColor)
+	netDialog.networkVLANField.SetLabel(utils.StringToInputLabel("vlan:", interfacePageLabelWidth))
+	netDialog.networkVLANField.SetFieldStyle(style.InputFieldStyle)
+	netDialog.networkVLANField.SetLabelStyle(style.InputLabelStyle)
+
+	// routes
+	netDialog.networkRoutesField.SetBackgroundColor(bgColor)
+	netDialog.networkRoutesField.SetLabel(utils.StringToInputLabel("routes:", interfacePageLabelWidth))
+	netDialog.networkRoutesField.SetFieldStyle(style.InputFieldStyle)
+	netDialog.networkRoutesField.SetLabelStyle(style.InputLabelStyle)
+
 	// category pages
 	netDialog.categoryPages.SetBackgroundColor(bgColor)
 	netDialog.categoryPages.SetBorder(true)
@@ -296,6 +399,25 @@ func (d *NetworkCreateDialog) Focus(delegate func(p tview.Primitive)) { //nolint
 		delegate(d.networkSubnetField)
 	case networkDisableDNSCheckBoxFocus:
 		delegate(d.networkDisableDNSCheckBox)
+	case networkDNSServersFieldFocus:
+		delegate(d.networkDNSServersField)
+	case networkIPAMDriverFieldFocus:
+		delegate(d.networkIPAMDriverField)
+	// interface and routes page
+	case networkInterfaceNameFieldFocus:
+		delegate(d.networkInterfaceField)
+	case networkParentFieldFocus:
+		delegate(d.networkParentField)
+	case networkIsolateFieldFocus:
+		delegate(d.networkIsolateField)
+	case networkMTUFieldFocus:
+		delegate(d.networkMTUField)
+	case networkMetricFieldFocus:
+		delegate(d.networkMetricField)
+	case networkVLANFieldFocus:
+		delegate(d.networkVLANField)
+	case networkRoutesFieldFocus:
+		delegate(d.networkRoutesField)
 	// category page
 	case categoryPagesFocus:
 		delegate(d.categoryPages)
@@ -307,7 +429,7 @@ func (d *NetworkCreateDialog) InputHandler() func(event *tcell.EventKey, setFocu
 	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
 		log.Debug().Msgf("network create dialog: event %v received", event)
 
-		if event.Key() == tcell.KeyEsc {
+		if event.Key() == tcell.KeyEsc && !d.dropdownHasFocus() {
 			d.cancelHandler()
 
 			return
@@ -337,6 +459,18 @@ func (d *NetworkCreateDialog) InputHandler() func(event *tcell.EventKey, setFocu
 			}
 		}
 
+		if d.interfacePage.HasFocus() {
+			if handler := d.interfacePage.InputHandler(); handler != nil {
+				if event.Key() == tcell.KeyTab {
+					d.setInterfacePageNextFocus()
+				}
+
+				handler(event, setFocus)
+
+				return
+			}
+		}
+
 		if d.categories.HasFocus() {
 			if categroryHandler := d.categories.InputHandler(); categroryHandler != nil {
 				categroryHandler(event, setFocus)
@@ -413,13 +547,15 @@ func (d *NetworkCreateDialog) SetCreateFunc(handler func()) *NetworkCreateDialog
 }
 
 // NetworkCreateOptions returns new network options.
-func (d *NetworkCreateDialog) NetworkCreateOptions() networks.CreateOptions { //nolint:cyclop
+func (d *NetworkCreateDialog) NetworkCreateOptions() (networks.CreateOptions, error) { //nolint:cyclop
 	var (
-		labels   = make(map[string]string)
-		options  = make(map[string]string)
-		subnets  []string
-		gateways []string
-		ipranges []string
+		labels     = make(map[string]string)
+		options    = make(map[string]string)
+		subnets    []string
+		gateways   []string
+		ipranges   []string
+		dnsServers []string
+		routes     []string
 	)
 
 	for label := range strings.SplitSeq(d.networkLabelsField.GetText(), " ") {
@@ -462,6 +598,23 @@ func (d *NetworkCreateDialog) NetworkCreateOptions() networks.CreateOptions { //
 		ipranges = strings.Split(d.networkIPRangeField.GetText(), " ")
 	}
 
+	if strings.Trim(d.networkDNSServersField.GetText(), " ") != "" {
+		dnsServers = strings.Fields(d.networkDNSServersField.GetText())
+	}
+
+	if strings.Trim(d.networkRoutesField.GetText(), " ") != "" {
+		routes = strings.Fields(d.networkRoutesField.GetText())
+	}
+
+	_, ipamDriver := d.networkIPAMDriverField.GetCurrentOption()
+	parent := ""
+
+	parentIndex, _ := d.networkParentField.GetCurrentOption()
+	if parentIndex > 0 && parentIndex <= len(d.parentInterfaces) {
+		parent = d.parentInterfaces[parentIndex-1]
+	}
+	_, isolate := d.networkIsolateField.GetCurrentOption()
+
 	opts := networks.CreateOptions{
 		Name:           strings.TrimSpace(d.networkNameField.GetText()),
 		Labels:         labels,
@@ -473,9 +626,59 @@ func (d *NetworkCreateDialog) NetworkCreateOptions() networks.CreateOptions { //
 		Subnets:        subnets,
 		IPRanges:       ipranges,
 		DisableDNS:     d.networkDisableDNSCheckBox.IsChecked(),
+		DNSServers:     dnsServers,
+		IPAMDriver:     ipamDriver,
+		Routes:         routes,
+		InterfaceName:  strings.TrimSpace(d.networkInterfaceField.GetText()),
+		Parent:         parent,
+		Isolate:        isolate,
+	}
+
+	numericFields := []struct {
+		name  string
+		field *tview.InputField
+		value *int
+	}{
+		{"mtu", d.networkMTUField, &opts.MTU},
+		{"metric", d.networkMetricField, &opts.Metric},
+		{"vlan", d.networkVLANField, &opts.VLAN},
 	}
 
-	return opts
+	for _, numField := range numericFields {
+		fieldVal := strings.TrimSpace(numField.field.GetText())
+		if fieldVal == "" {
+			continue
+		}
+
+		val, err := strconv.Atoi(fieldVal)
+		if err != nil {
+			return networks.CreateOptions{}, fmt.Errorf("invalid %s value %q %w", numField.name, fieldVal, err)
+		}
+
+		*numField.value = val
+	}
+
+	return opts, nil
+}
+
+// SetParentInterfaces sets macvlan/ipvlan parent interface drop down options.
+// The local host interfaces are labeled as such.
+func (d *NetworkCreateDialog) SetParentInterfaces(interfaces []networks.ParentInterface) {
+	parentOptions := []string{""}
+	d.parentInterfaces = make([]string, 0, len(interfaces))
+
+	for _, parentInterface := range interfaces {
+		option := parentInterface.Name
+		if parentInterface.Local {
+			option += " (local host)"
+		}
+
+		parentOptions = append(parentOptions, option)
+		d.parentInterfaces = append(d.parentInterfaces, parentInterface.Name)
+	}
+
+	d.networkParentField.SetOptions(parentOptions, nil)
+	d.networkParentField.SetCurrentOption(0)
 }
 
 func (d *NetworkCreateDialog) setupLayout() {
@@ -505,11 +708,33 @@ func (d *NetworkCreateDialog) setupLayout() {
 	d.ipSettingsPage.AddItem(d.networkSubnetField, 1, 0, true)
 	d.ipSettingsPage.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, true)
 	d.ipSettingsPage.AddItem(d.networkDisableDNSCheckBox, 1, 0, true)
+	d.ipSettingsPage.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, true)
+	d.ipSettingsPage.AddItem(d.networkDNSServersField, 1, 0, true)
+	d.ipSettingsPage.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, true)
+	d.ipSettingsPage.AddItem(d.networkIPAMDriverField, 1, 0, true)
 	d.ipSettingsPage.SetBackgroundColor(bgColor)
 
+	// interface and routes page
+	d.interfacePage.SetDirection(tview.FlexRow)
+	d.interfacePage.AddItem(d.networkInterfaceField, 1, 0, true)
+	d.interfacePage.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, true)
+	d.interfacePage.AddItem(d.networkParentField, 1, 0, true)
+	d.interfacePage.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, true)
+	d.interfacePage.AddItem(d.networkIsolateField, 1, 0, true)
+	d.interfacePage.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, true)
+	d.interfacePage.AddItem(d.networkMTUField, 1, 0, true)
+	d.interfacePage.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, true)
+	d.interfacePage.AddItem(d.networkMetricField, 1, 0, true)
+	d.interfacePage.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, true)
+	d.interfacePage.AddItem(d.networkVLANField, 1, 0, true)
+	d.interfacePage.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, true)
+	d.interfacePage.AddItem(d.networkRoutesField, 1, 0, true)
+	d.interfacePage.SetBackgroundColor(bgColor)
+
 	// adding category pages
 	d.categoryPages.AddPage(d.categoryLabels[basicInfoPageIndex], d.basicInfoPage, true, true)
 	d.categoryPages.AddPage(d.categoryLabels[ipSettingsPageIndex], d.ipSettingsPage, true, true)
+	d.categoryPages.AddPage(d.categoryLabels[interfacePageIndex], d.interfacePage, true, true)
 
 	// add it to layout.
 	_, layoutWidth := utils.AlignStringListWidth(d.categoryLabels)
@@ -587,6 +812,25 @@ func (d *NetworkCreateDialog) initData() {
 	d.networkIPRangeField.SetText("")
 	d.networkSubnetField.SetText("")
 	d.networkDisableDNSCheckBox.SetChecked(false)
+	d.networkDNSServersField.SetText("")
+	d.networkIPAMDriverField.SetCurrentOption(0)
+	d.networkInterfaceField.SetText("")
+	d.networkParentField.SetCurrentOption(0)
+	d.networkIsolateField.SetCurrentOption(0)
+	d.networkMTUField.SetText("")
+	d.networkMetricField.SetText("")
+	d.networkVLANField.SetText("")
+	d.networkRoutesField.SetText("")
+}
+
+// dropdownHasFocus returns true if network create dialog dropdown primitives
+// has focus.
+func (d *NetworkCreateDialog) dropdownHasFocus() bool {
+	if d.networkIPAMDriverField.HasFocus() || d.networkParentField.HasFocus() {
+		return true
+	}
+
+	return d.networkIsolateField.HasFocus()
 }
 
 func (d *NetworkCreateDialog) setBasicInfoPageNextFocus() {
@@ -642,5 +886,57 @@ func (d *NetworkCreateDialog) setIPSettingsPageNextFocus() {
 		return
 	}
 
+	if d.networkDisableDNSCheckBox.HasFocus() {
+		d.focusElement = networkDNSServersFieldFocus
+
+		return
+	}
+
+	if d.networkDNSServersField.HasFocus() {
+		d.focusElement = networkIPAMDriverFieldFocus
+
+		return
+	}
+
+	d.focusElement = formFocus
+}
+
+func (d *NetworkCreateDialog) setInterfacePageNextFocus() {
+	if d.networkInterfaceField.HasFocus() {
+		d.focusElement = networkParentFieldFocus
+
+		return
+	}
+
+	if d.networkParentField.HasFocus() {
+		d.focusElement = networkIsolateFieldFocus
+
+		return
+	}
+
+	if d.networkIsolateField.HasFocus() {
+		d.focusElement = networkMTUFieldFocus
+
+		return
+	}
+
+	if d.networkMTUField.HasFocus() {
+		d.focusElement = networkMetricFieldFocus
+
+		return
+	}
+
+	if d.networkMetricField.HasFocus() {
+		d.focusElement = networkVLANFieldFocus
+
+		return
+	}
+
+	if d.networkVLANField.HasFocus() {
+		d.focusElement = networkRoutesFieldFocus
+
+		return
+	}
+
 	d.focusElement = formFocus
 }
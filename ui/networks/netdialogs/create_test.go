@@ -53,6 +53,14 @@ var _ = Describe("network create", Ordered, func() {
 		netCreateDialog.networkIPRangeField.SetText("sample")
 		netCreateDialog.networkSubnetField.SetText("sample")
 		netCreateDialog.networkDisableDNSCheckBox.SetChecked(true)
+		netCreateDialog.networkDNSServersField.SetText("sample")
+		netCreateDialog.networkIPAMDriverField.SetCurrentOption(1)
+		netCreateDialog.networkInterfaceField.SetText("sample")
+		netCreateDialog.networkIsolateField.SetCurrentOption(1)
+		netCreateDialog.networkMTUField.SetText("sample")
+		netCreateDialog.networkMetricField.SetText("sample")
+		netCreateDialog.networkVLANField.SetText("sample")
+		netCreateDialog.networkRoutesField.SetText("sample")
 
 		netCreateDialog.initData()
 
@@ -66,6 +74,16 @@ var _ = Describe("network create", Ordered, func() {
 		Expect(netCreateDialog.networkIPRangeField.GetText()).To(Equal(""))
 		Expect(netCreateDialog.networkSubnetField.GetText()).To(Equal(""))
 		Expect(netCreateDialog.networkDisableDNSCheckBox.IsChecked()).To(Equal(false))
+		Expect(netCreateDialog.networkDNSServersField.GetText()).To(Equal(""))
+		ipamIndex, _ := netCreateDialog.networkIPAMDriverField.GetCurrentOption()
+		Expect(ipamIndex).To(Equal(0))
+		Expect(netCreateDialog.networkInterfaceField.GetText()).To(Equal(""))
+		isolateIndex, _ := netCreateDialog.networkIsolateField.GetCurrentOption()
+		Expect(isolateIndex).To(Equal(0))
+		Expect(netCreateDialog.networkMTUField.GetText()).To(Equal(""))
+		Expect(netCreateDialog.networkMetricField.GetText()).To(Equal(""))
+		Expect(netCreateDialog.networkVLANField.GetText()).To(Equal(""))
+		Expect(netCreateDialog.networkRoutesField.GetText()).To(Equal(""))
 	})
 
 	It("set focus", func() {
@@ -163,6 +181,16 @@ var _ = Describe("network create", Ordered, func() {
 		netCreateDialog.setIPSettingsPageNextFocus()
 		Expect(netCreateDialog.focusElement).To(Equal(networkDisableDNSCheckBoxFocus))
 
+		netCreateDialogApp.SetFocus(netCreateDialog)
+		netCreateDialogApp.Draw()
+		netCreateDialog.setIPSettingsPageNextFocus()
+		Expect(netCreateDialog.focusElement).To(Equal(networkDNSServersFieldFocus))
+
+		netCreateDialogApp.SetFocus(netCreateDialog)
+		netCreateDialogApp.Draw()
+		netCreateDialog.setIPSettingsPageNextFocus()
+		Expect(netCreateDialog.focusElement).To(Equal(networkIPAMDriverFieldFocus))
+
 		netCreateDialogApp.SetFocus(netCreateDialog)
 		netCreateDialogApp.Draw()
 		netCreateDialog.setIPSettingsPageNextFocus()
@@ -170,6 +198,29 @@ var _ = Describe("network create", Ordered, func() {
 
 	})
 
+	It("interface page next focus", func() {
+		netCreateDialog.setActiveCategory(interfacePageIndex)
+		netCreateDialog.focusElement = networkInterfaceNameFieldFocus
+		netCreateDialogApp.Draw()
+
+		wants := []int{
+			networkParentFieldFocus,
+			networkIsolateFieldFocus,
+			networkMTUFieldFocus,
+			networkMetricFieldFocus,
+			networkVLANFieldFocus,
+			networkRoutesFieldFocus,
+			formFocus,
+		}
+
+		for _, want := range wants {
+			netCreateDialogApp.SetFocus(netCreateDialog)
+			netCreateDialogApp.Draw()
+			netCreateDialog.setInterfacePageNextFocus()
+			Expect(netCreateDialog.focusElement).To(Equal(want))
+		}
+	})
+
 	It("next category", func() {
 		netCreateDialog.Hide()
 		netCreateDialog.Display()
@@ -179,6 +230,8 @@ var _ = Describe("network create", Ordered, func() {
 		netCreateDialog.nextCategory()
 		Expect(netCreateDialog.activePageIndex).To(Equal(ipSettingsPageIndex))
 		netCreateDialog.nextCategory()
+		Expect(netCreateDialog.activePageIndex).To(Equal(interfacePageIndex))
+		netCreateDialog.nextCategory()
 		Expect(netCreateDialog.activePageIndex).To(Equal(basicInfoPageIndex))
 	})
 
@@ -189,6 +242,8 @@ var _ = Describe("network create", Ordered, func() {
 		netCreateDialogApp.SetFocus(netCreateDialog)
 		netCreateDialogApp.Draw()
 		netCreateDialog.previousCategory()
+		Expect(netCreateDialog.activePageIndex).To(Equal(interfacePageIndex))
+		netCreateDialog.previousCategory()
 		Expect(netCreateDialog.activePageIndex).To(Equal(ipSettingsPageIndex))
 		netCreateDialog.previousCategory()
 		Expect(netCreateDialog.activePageIndex).To(Equal(basicInfoPageIndex))
@@ -334,7 +389,8 @@ var _ = Describe("network create", Ordered, func() {
 			netCreateDialogApp.Draw()
 		}
 
-		networkCreateOptions := netCreateDialog.NetworkCreateOptions()
+		networkCreateOptions, err := netCreateDialog.NetworkCreateOptions()
+		Expect(err).To(BeNil())
 		Expect(networkCreateOptions.Name).To(Equal(netName))
 		netLabelValue := networkCreateOptions.Labels[netLabel.key]
 		Expect(netLabelValue).To(Equal(netLabel.value))
@@ -352,6 +408,64 @@ var _ = Describe("network create", Ordered, func() {
 		Expect(networkCreateOptions.DisableDNS).To(Equal(disableDNS))
 	})
 
+	It("interface and routes options", func() {
+		netCreateDialog.Hide()
+		netCreateDialog.Display()
+		netCreateDialog.SetParentInterfaces([]networks.ParentInterface{
+			{Name: "eth0"},
+			{Name: "eth1", Local: true},
+		})
+
+		netCreateDialog.networkDriverField.SetText("macvlan")
+		netCreateDialog.networkDNSServersField.SetText("10.0.0.53 10.0.1.53")
+		netCreateDialog.networkIPAMDriverField.SetCurrentOption(2)
+		netCreateDialog.networkParentField.SetCurrentOption(2)
+		netCreateDialog.networkIsolateField.SetCurrentOption(3)
+		netCreateDialog.networkMTUField.SetText("9000")
+		netCreateDialog.networkMetricField.SetText("100")
+		netCreateDialog.networkVLANField.SetText("42")
+		netCreateDialog.networkRoutesField.SetText("10.10.0.0/16,10.89.0.1,100")
+
+		networkCreateOptions, err := netCreateDialog.NetworkCreateOptions()
+		Expect(err).To(BeNil())
+		Expect(networkCreateOptions.DNSServers).To(Equal([]string{"10.0.0.53", "10.0.1.53"}))
+		Expect(networkCreateOptions.IPAMDriver).To(Equal(networks.IPAMDrivers()[2]))
+		_, parentOption := netCreateDialog.networkParentField.GetCurrentOption()
+		Expect(parentOption).To(Equal("eth1 (local host)"))
+		Expect(networkCreateOptions.Parent).To(Equal("eth1"))
+		Expect(networkCreateOptions.Isolate).To(Equal("strict"))
+		Expect(networkCreateOptions.MTU).To(Equal(9000))
+		Expect(networkCreateOptions.Metric).To(Equal(100))
+		Expect(networkCreateOptions.VLAN).To(Equal(42))
+		Expect(networkCreateOptions.Routes).To(Equal([]string{"10.10.0.0/16,10.89.0.1,100"}))
+		Expect(networks.ValidateCreateOptions(networkCreateOptions)).To(BeNil())
+
+		// invalid numeric value
+		netCreateDialog.networkMTUField.SetText("large")
+		_, err = netCreateDialog.NetworkCreateOptions()
+		Expect(err).NotTo(BeNil())
+
+		// invalid route and parent with bridge driver
+		netCreateDialog.networkMTUField.SetText("")
+		netCreateDialog.networkRoutesField.SetText("10.10.0.0/16")
+		networkCreateOptions, err = netCreateDialog.NetworkCreateOptions()
+		Expect(err).To(BeNil())
+		Expect(networks.ValidateCreateOptions(networkCreateOptions)).NotTo(BeNil())
+
+		netCreateDialog.networkRoutesField.SetText("")
+		netCreateDialog.networkDriverField.SetText("bridge")
+		networkCreateOptions, err = netCreateDialog.NetworkCreateOptions()
+		Expect(err).To(BeNil())
+		Expect(networks.ValidateCreateOptions(networkCreateOptions)).To(MatchError(networks.ErrParentInterfaceDriver))
+
+		// interface name and parent interface
+		netCreateDialog.networkDriverField.SetText("macvlan")
+		netCreateDialog.networkInterfaceField.SetText("mv0")
+		networkCreateOptions, err = netCreateDialog.NetworkCreateOptions()
+		Expect(err).To(BeNil())
+		Expect(networks.ValidateCreateOptions(networkCreateOptions)).To(MatchError(networks.ErrParentInterfaceName))
+	})
+
 	AfterAll(func() {
 		netCreateDialogApp.Stop()
 	})
@@ -0,0 +1,338 @@
+package netdialogs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/networks"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	netTopologyTreeFocus = 0 + iota
+	netTopologyFormFocus
+)
+
+// NetworkTopologyDialog implements network topology dialog primitive.
+type NetworkTopologyDialog struct {
+	*tview.Box
+
+	layout        *tview.Flex
+	serviceName   *tview.InputField
+	tree          *tview.TreeView
+	form          *tview.Form
+	display       bool
+	focusElement  int
+	cancelHandler func()
+}
+
+// NewNetworkTopologyDialog returns new network topology dialog primitive.
+func NewNetworkTopologyDialog() *NetworkTopologyDialog {
+	dialog := &NetworkTopologyDialog{
+		Box:         tview.NewBox(),
+		layout:      tview.NewFlex(),
+		serviceName: tview.NewInputField(),
+		tree:        tview.NewTreeView(),
+		form:        tview.NewForm(),
+	}
+
+	bgColor := style.DialogBgColor
+
+	// service name input field
+	serviceNameLabel := "SERVICE NAME:"
+
+	dialog.serviceName.SetBackgroundColor(bgColor)
+	dialog.serviceName.SetLabel("[::b]" + serviceNameLabel)
+	dialog.serviceName.SetLabelWidth(len(serviceNameLabel) + 1)
+	dialog.serviceName.SetFieldBackgroundColor(bgColor)
+	dialog.serviceName.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(style.DialogFgColor))
+
+	// topology tree
+	dialog.tree.SetBackgroundColor(bgColor)
+	dialog.tree.SetBorder(true)
+	dialog.tree.SetBorderColor(style.DialogSubBoxBorderColor)
+	dialog.tree.SetGraphicsColor(style.DialogSubBoxBorderColor)
+	dialog.tree.SetSelectedFunc(func(node *tview.TreeNode) {
+		node.SetExpanded(!node.IsExpanded())
+	})
+
+	// form
+	dialog.form.AddButton("Cancel", nil)
+	dialog.form.SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	treeLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+
+	treeLayout.SetBackgroundColor(bgColor)
+	treeLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	treeLayout.AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(dialog.serviceName, 1, 0, false).
+		AddItem(dialog.tree, 0, 1, true),
+		0, 1, true)
+	treeLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout.SetDirection(tview.FlexRow)
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetTitle("PODMAN NETWORK TOPOLOGY")
+	dialog.layout.AddItem(treeLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
+
+	dialog.UpdateResults(nil)
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *NetworkTopologyDialog) Display() {
+	d.display = true
+	d.focusElement = netTopologyTreeFocus
+}
+
+// IsDisplay returns true if this primitive is shown.
+func (d *NetworkTopologyDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *NetworkTopologyDialog) Hide() {
+	d.display = false
+	d.focusElement = netTopologyTreeFocus
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *NetworkTopologyDialog) HasFocus() bool {
+	if d.tree.HasFocus() || d.form.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus() || d.layout.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *NetworkTopologyDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case netTopologyTreeFocus:
+		delegate(d.tree)
+	case netTopologyFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = netTopologyTreeFocus
+
+				d.Focus(delegate)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *NetworkTopologyDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("network topology dialog: event %v received", event)
+
+		if event.Key() == utils.CloseDialogKey.Key {
+			d.cancelHandler()
+
+			return
+		}
+
+		if event.Key() == utils.SwitchFocusKey.Key && d.tree.HasFocus() {
+			d.focusElement = netTopologyFormFocus
+
+			d.Focus(setFocus)
+
+			return
+		}
+
+		if d.tree.HasFocus() {
+			if treeHandler := d.tree.InputHandler(); treeHandler != nil {
+				treeHandler(utils.ParseKeyEventKey(event), setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+// SetRect set rects for this primitive.
+func (d *NetworkTopologyDialog) SetRect(x, y, width, height int) {
+	dX := x + 1
+	dY := y + 1
+	dWidth := width - 2   //nolint:mnd
+	dHeight := height - 2 //nolint:mnd
+
+	d.Box.SetRect(dX, dY, dWidth, dHeight)
+}
+
+// Draw draws this primitive into the screen.
+func (d *NetworkTopologyDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.DrawForSubclass(screen, d)
+
+	x, y, width, height := d.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *NetworkTopologyDialog) SetCancelFunc(handler func()) *NetworkTopologyDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetServiceName sets topology dialog service (connection) name.
+func (d *NetworkTopologyDialog) SetServiceName(name string) {
+	d.serviceName.SetText(name)
+}
+
+// UpdateResults updates topology tree nodes.
+func (d *NetworkTopologyDialog) UpdateResults(report []networks.TopologyReport) {
+	root := tview.NewTreeNode("networks").
+		SetColor(style.DialogFgColor).
+		SetSelectable(false)
+
+	for _, netReport := range report {
+		root.AddChild(topologyNetworkNode(netReport))
+	}
+
+	d.tree.SetRoot(root)
+	d.tree.SetTopLevel(1)
+
+	if len(root.GetChildren()) > 0 {
+		d.tree.SetCurrentNode(root.GetChildren()[0])
+	}
+}
+
+func topologyNetworkNode(netReport networks.TopologyReport) *tview.TreeNode {
+	netInfo := netReport.Network
+	netLabel := fmt.Sprintf("[::b]%s[::-] (%s) driver=%s interface=%s dns=%t internal=%t ipv6=%t",
+		netInfo.Name,
+		utils.GetIDWithLimit(netInfo.ID),
+		netInfo.Driver,
+		netInfo.NetworkInterface,
+		netInfo.DNSEnabled,
+		netInfo.Internal,
+		netInfo.IPv6Enabled)
+
+	netNode := tview.NewTreeNode(netLabel).
+		SetColor(style.DialogFgColor).
+		SetSelectable(true).
+		SetExpanded(true)
+
+	for _, subnet := range netInfo.Subnets {
+		subnetLabel := "subnet " + subnet.Subnet.String()
+
+		if subnet.Gateway != nil {
+			subnetLabel = fmt.Sprintf("%s gateway %s", subnetLabel, subnet.Gateway.String())
+		}
+
+		if subnet.LeaseRange != nil {
+			subnetLabel = fmt.Sprintf("%s range %s-%s", subnetLabel,
+				subnet.LeaseRange.StartIP.String(),
+				subnet.LeaseRange.EndIP.String())
+		}
+
+		netNode.AddChild(tview.NewTreeNode(subnetLabel).
+			SetColor(style.DialogSubBoxBorderColor).
+			SetSelectable(false))
+	}
+
+	if len(netReport.Endpoints) == 0 {
+		netNode.AddChild(tview.NewTreeNode("no attached containers").
+			SetColor(style.DialogSubBoxBorderColor).
+			SetSelectable(false))
+
+		return netNode
+	}
+
+	for _, endpoint := range netReport.Endpoints {
+		netNode.AddChild(topologyEndpointNode(endpoint))
+	}
+
+	return netNode
+}
+
+func topologyEndpointNode(endpoint networks.TopologyEndpoint) *tview.TreeNode {
+	var (
+		endpointLabel string
+		endpointColor = style.DialogFgColor
+	)
+
+	if endpoint.IsInfra && endpoint.PodName != "" {
+		endpointLabel = fmt.Sprintf("pod %s (%s)", endpoint.PodName, utils.GetIDWithLimit(endpoint.PodID))
+	} else {
+		endpointLabel = fmt.Sprintf("container %s (%s)",
+			endpoint.ContainerName,
+			utils.GetIDWithLimit(endpoint.ContainerID))
+
+		if endpoint.PodName != "" {
+			endpointLabel = fmt.Sprintf("%s pod=%s", endpointLabel, endpoint.PodName)
+		}
+	}
+
+	if endpoint.IsMultiNetwork() {
+		endpointColor = style.PausedStatusFgColor
+		endpointLabel = fmt.Sprintf("%s [multi-network: %s]", endpointLabel, strings.Join(endpoint.Networks, ", "))
+	}
+
+	endpointNode := tview.NewTreeNode(endpointLabel).
+		SetColor(endpointColor).
+		SetSelectable(true).
+		SetExpanded(true)
+
+	details := make([]string, 0)
+
+	if len(endpoint.IPAddresses) > 0 {
+		details = append(details, "ip "+strings.Join(endpoint.IPAddresses, ", "))
+	}
+
+	if endpoint.MacAddress != "" {
+		details = append(details, "mac "+endpoint.MacAddress)
+	}
+
+	if len(endpoint.Aliases) > 0 {
+		details = append(details, "aliases "+strings.Join(endpoint.Aliases, ", "))
+	}
+
+	for _, detail := range details {
+		endpointNode.AddChild(tview.NewTreeNode(detail).
+			SetColor(style.DialogFgColor).
+			SetSelectable(false))
+	}
+
+	return endpointNode
+}
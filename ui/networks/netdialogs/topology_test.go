@@ -0,0 +1,122 @@
+package netdialogs
+
+import (
+	"net"
+
+	"github.com/containers/podman-tui/pdcs/networks"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+	"go.podman.io/common/libnetwork/types"
+)
+
+var _ = Describe("network topology", Ordered, func() {
+	var netTopologyDialogApp *tview.Application
+	var netTopologyDialogScreen tcell.SimulationScreen
+	var netTopologyDialog *NetworkTopologyDialog
+	var runApp func()
+
+	BeforeAll(func() {
+		netTopologyDialogApp = tview.NewApplication()
+		netTopologyDialog = NewNetworkTopologyDialog()
+		netTopologyDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := netTopologyDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := netTopologyDialogApp.SetScreen(netTopologyDialogScreen).SetRoot(netTopologyDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		netTopologyDialog.Display()
+		Expect(netTopologyDialog.IsDisplay()).To(Equal(true))
+		Expect(netTopologyDialog.focusElement).To(Equal(netTopologyTreeFocus))
+	})
+
+	It("set focus", func() {
+		netTopologyDialogApp.SetFocus(netTopologyDialog)
+		Expect(netTopologyDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("update results", func() {
+		subnet, err := types.ParseCIDR("10.89.0.0/24")
+		Expect(err).To(BeNil())
+
+		report := []networks.TopologyReport{
+			{
+				Network: types.Network{
+					Name:    "backend",
+					ID:      "0123456789abcdef",
+					Driver:  "bridge",
+					Subnets: []types.Subnet{{Subnet: subnet, Gateway: net.ParseIP("10.89.0.1")}},
+				},
+				Endpoints: []networks.TopologyEndpoint{
+					{
+						ContainerID:   "abcdef0123456789",
+						ContainerName: "web",
+						IPAddresses:   []string{"10.89.0.2/24"},
+						MacAddress:    "aa:bb:cc:dd:ee:ff",
+						Aliases:       []string{"web", "abcdef012345"},
+						Networks:      []string{"backend", "frontend"},
+					},
+				},
+			},
+			{
+				Network: types.Network{
+					Name:   "frontend",
+					ID:     "fedcba9876543210",
+					Driver: "bridge",
+				},
+			},
+		}
+
+		netTopologyDialog.UpdateResults(report)
+
+		netNodes := netTopologyDialog.tree.GetRoot().GetChildren()
+		Expect(netNodes).To(HaveLen(2))
+
+		// subnet + endpoint
+		backendChildren := netNodes[0].GetChildren()
+		Expect(backendChildren).To(HaveLen(2))
+		Expect(backendChildren[0].GetText()).To(Equal("subnet 10.89.0.0/24 gateway 10.89.0.1"))
+		Expect(backendChildren[1].GetText()).To(ContainSubstring("multi-network: backend, frontend"))
+		Expect(backendChildren[1].GetChildren()).To(HaveLen(3))
+
+		// no endpoints
+		frontendChildren := netNodes[1].GetChildren()
+		Expect(frontendChildren).To(HaveLen(1))
+		Expect(frontendChildren[0].GetText()).To(Equal("no attached containers"))
+	})
+
+	It("cancel button selected", func() {
+		cancelWants := "cancel selected"
+		cancelAction := "cancel init"
+		cancelFunc := func() {
+			cancelAction = cancelWants
+		}
+		netTopologyDialog.SetCancelFunc(cancelFunc)
+		netTopologyDialog.focusElement = netTopologyFormFocus
+		netTopologyDialogApp.SetFocus(netTopologyDialog)
+		netTopologyDialogApp.Draw()
+		netTopologyDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		netTopologyDialogApp.Draw()
+		Expect(cancelAction).To(Equal(cancelWants))
+	})
+
+	It("hide", func() {
+		netTopologyDialog.Hide()
+		Expect(netTopologyDialog.IsDisplay()).To(Equal(false))
+	})
+
+	AfterAll(func() {
+		netTopologyDialogApp.Stop()
+	})
+})
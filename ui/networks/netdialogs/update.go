@@ -0,0 +1,300 @@
+package netdialogs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/networks"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	netUpdateDialogMaxWidth  = 70
+	netUpdateDialogMaxHeight = 13
+	netUpdateLabelPadding    = 1
+)
+
+const (
+	netUpdateAddDNSFocus = 0 + iota
+	netUpdateRemoveDNSFocus
+	netUpdateFormFocus
+)
+
+// NetworkUpdateDialog implements network update dialog primitive.
+type NetworkUpdateDialog struct {
+	*tview.Box
+
+	layout         *tview.Flex
+	network        *tview.InputField
+	currentDNS     *tview.InputField
+	addDNSField    *tview.InputField
+	removeDNSField *tview.InputField
+	form           *tview.Form
+	display        bool
+	networkName    string
+	focusElement   int
+	updateHandler  func()
+	cancelHandler  func()
+}
+
+// NewNetworkUpdateDialog returns new network update dialog primitive.
+func NewNetworkUpdateDialog() *NetworkUpdateDialog {
+	dialog := &NetworkUpdateDialog{
+		Box:            tview.NewBox(),
+		layout:         tview.NewFlex(),
+		network:        tview.NewInputField(),
+		currentDNS:     tview.NewInputField(),
+		addDNSField:    tview.NewInputField(),
+		removeDNSField: tview.NewInputField(),
+		form:           tview.NewForm(),
+	}
+
+	bgColor := style.DialogBgColor
+	labelWidth := 13
+
+	// network input field
+	dialog.network.SetBackgroundColor(style.DialogBgColor)
+	dialog.network.SetLabel("[::b]NETWORK ID:")
+	dialog.network.SetFieldBackgroundColor(style.DialogBgColor)
+	dialog.network.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(style.DialogFgColor))
+
+	// current dns servers
+	dialog.currentDNS.SetBackgroundColor(bgColor)
+	dialog.currentDNS.SetLabel(utils.StringToInputLabel("current dns:", labelWidth))
+	dialog.currentDNS.SetFieldBackgroundColor(bgColor)
+	dialog.currentDNS.SetLabelStyle(style.InputLabelStyle)
+	dialog.currentDNS.SetDisabled(true)
+
+	// add dns servers
+	dialog.addDNSField.SetBackgroundColor(bgColor)
+	dialog.addDNSField.SetLabel(utils.StringToInputLabel("add dns:", labelWidth))
+	dialog.addDNSField.SetFieldStyle(style.InputFieldStyle)
+	dialog.addDNSField.SetLabelStyle(style.InputLabelStyle)
+
+	// remove dns servers
+	dialog.removeDNSField.SetBackgroundColor(bgColor)
+	dialog.removeDNSField.SetLabel(utils.StringToInputLabel("remove dns:", labelWidth))
+	dialog.removeDNSField.SetFieldStyle(style.InputFieldStyle)
+	dialog.removeDNSField.SetLabelStyle(style.InputLabelStyle)
+
+	// form
+	dialog.form.AddButton("Cancel", nil)
+	dialog.form.AddButton("Update", nil)
+	dialog.form.SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	optionsLayout := tview.NewFlex().SetDirection(tview.FlexRow)
+
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(dialog.network, 1, 0, false)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(dialog.currentDNS, 1, 0, false)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(dialog.addDNSField, 1, 0, true)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(dialog.removeDNSField, 1, 0, true)
+
+	mainOptsLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+
+	mainOptsLayout.SetBackgroundColor(bgColor)
+	mainOptsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainOptsLayout.AddItem(optionsLayout, 0, 1, true)
+	mainOptsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout.SetDirection(tview.FlexRow)
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetTitle("PODMAN NETWORK UPDATE")
+	dialog.layout.AddItem(mainOptsLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *NetworkUpdateDialog) Display() {
+	d.display = true
+	d.focusElement = netUpdateAddDNSFocus
+}
+
+// IsDisplay returns true if this primitive is shown.
+func (d *NetworkUpdateDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *NetworkUpdateDialog) Hide() {
+	d.display = false
+	d.focusElement = netUpdateAddDNSFocus
+
+	d.SetNetworkInfo("", "", nil)
+	d.addDNSField.SetText("")
+	d.removeDNSField.SetText("")
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *NetworkUpdateDialog) HasFocus() bool {
+	if d.addDNSField.HasFocus() || d.removeDNSField.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus() || d.form.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *NetworkUpdateDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case netUpdateAddDNSFocus:
+		delegate(d.addDNSField)
+	case netUpdateRemoveDNSFocus:
+		delegate(d.removeDNSField)
+	case netUpdateFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = netUpdateAddDNSFocus
+
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *NetworkUpdateDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("network update dialog: event %v received", event)
+
+		if event.Key() == utils.CloseDialogKey.Key {
+			d.cancelHandler()
+
+			return
+		}
+
+		if event.Key() == utils.SwitchFocusKey.Key {
+			d.setFocusElement()
+		}
+
+		if d.addDNSField.HasFocus() {
+			if handler := d.addDNSField.InputHandler(); handler != nil {
+				handler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.removeDNSField.HasFocus() {
+			if handler := d.removeDNSField.InputHandler(); handler != nil {
+				handler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+// SetRect set rects for this primitive.
+func (d *NetworkUpdateDialog) SetRect(x, y, width, height int) {
+	if width > netUpdateDialogMaxWidth {
+		emptySpace := (width - netUpdateDialogMaxWidth) / 2 //nolint:mnd
+		x += emptySpace
+		width = netUpdateDialogMaxWidth
+	}
+
+	if height > netUpdateDialogMaxHeight {
+		emptySpace := (height - netUpdateDialogMaxHeight) / 2 //nolint:mnd
+		y += emptySpace
+		height = netUpdateDialogMaxHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// Draw draws this primitive into the screen.
+func (d *NetworkUpdateDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.DrawForSubclass(screen, d)
+
+	x, y, width, height := d.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetUpdateFunc sets form update button selected function.
+func (d *NetworkUpdateDialog) SetUpdateFunc(handler func()) *NetworkUpdateDialog {
+	d.updateHandler = handler
+	updateButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+	updateButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *NetworkUpdateDialog) SetCancelFunc(handler func()) *NetworkUpdateDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 2) //nolint:mnd
+
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetNetworkInfo sets selected network information in update dialog.
+func (d *NetworkUpdateDialog) SetNetworkInfo(id string, name string, dnsServers []string) {
+	d.networkName = name
+	network := fmt.Sprintf("%12s (%s)", id, name)
+	network = utils.LabelWidthLeftPadding(network, netUpdateLabelPadding)
+
+	d.network.SetText(network)
+	d.currentDNS.SetText(strings.Join(dnsServers, " "))
+}
+
+// GetUpdateOptions returns network name and update options.
+func (d *NetworkUpdateDialog) GetUpdateOptions() (string, networks.UpdateOptions) {
+	opts := networks.UpdateOptions{
+		AddDNSServers:    strings.Fields(d.addDNSField.GetText()),
+		RemoveDNSServers: strings.Fields(d.removeDNSField.GetText()),
+	}
+
+	return d.networkName, opts
+}
+
+func (d *NetworkUpdateDialog) setFocusElement() {
+	switch d.focusElement {
+	case netUpdateAddDNSFocus:
+		d.focusElement = netUpdateRemoveDNSFocus
+	case netUpdateRemoveDNSFocus:
+		d.focusElement = netUpdateFormFocus
+	}
+}
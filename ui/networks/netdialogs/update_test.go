@@ -0,0 +1,131 @@
+package netdialogs
+
+import (
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("network update", Ordered, func() {
+	var netUpdateDialogApp *tview.Application
+	var netUpdateDialogScreen tcell.SimulationScreen
+	var netUpdateDialog *NetworkUpdateDialog
+	var runApp func()
+
+	BeforeAll(func() {
+		netUpdateDialogApp = tview.NewApplication()
+		netUpdateDialog = NewNetworkUpdateDialog()
+		netUpdateDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := netUpdateDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := netUpdateDialogApp.SetScreen(netUpdateDialogScreen).SetRoot(netUpdateDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		netUpdateDialog.Display()
+		Expect(netUpdateDialog.IsDisplay()).To(Equal(true))
+		Expect(netUpdateDialog.focusElement).To(Equal(netUpdateAddDNSFocus))
+	})
+
+	It("set focus", func() {
+		netUpdateDialogApp.SetFocus(netUpdateDialog)
+		Expect(netUpdateDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("cancel button selected", func() {
+		cancelWants := "cancel selected"
+		cancelAction := "cancel init"
+		cancelFunc := func() {
+			cancelAction = cancelWants
+		}
+		netUpdateDialog.SetCancelFunc(cancelFunc)
+		netUpdateDialog.focusElement = netUpdateFormFocus
+		netUpdateDialogApp.SetFocus(netUpdateDialog)
+		netUpdateDialogApp.Draw()
+		netUpdateDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		netUpdateDialogApp.Draw()
+		Expect(cancelAction).To(Equal(cancelWants))
+	})
+
+	It("update button selected", func() {
+		updateWants := "update selected"
+		updateAction := "update init"
+		updateFunc := func() {
+			updateAction = updateWants
+		}
+		netUpdateDialog.SetUpdateFunc(updateFunc)
+		netUpdateDialog.focusElement = netUpdateFormFocus
+		netUpdateDialogApp.SetFocus(netUpdateDialog)
+		netUpdateDialogApp.Draw()
+		netUpdateDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		netUpdateDialogApp.Draw()
+		netUpdateDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		netUpdateDialogApp.Draw()
+		Expect(updateAction).To(Equal(updateWants))
+	})
+
+	It("get update options", func() {
+		var (
+			netName   = "network01"
+			netID     = "001122334455"
+			addDNS    = "10.10.10.10 10.10.10.11"
+			removeDNS = "8.8.8.8"
+		)
+
+		netUpdateDialog.Hide()
+		netUpdateDialog.SetNetworkInfo(netID, netName, []string{"8.8.8.8"})
+		netUpdateDialog.Display()
+		netUpdateDialogApp.SetFocus(netUpdateDialog)
+		netUpdateDialogApp.Draw()
+		Expect(netUpdateDialog.currentDNS.GetText()).To(Equal("8.8.8.8"))
+
+		// add dns servers
+		netUpdateDialog.focusElement = netUpdateAddDNSFocus
+		netUpdateDialogApp.SetFocus(netUpdateDialog)
+		netUpdateDialogApp.Draw()
+
+		for _, r := range addDNS {
+			netUpdateDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyRune, r, tcell.ModNone))
+		}
+
+		netUpdateDialogApp.Draw()
+
+		// remove dns servers
+		netUpdateDialog.focusElement = netUpdateRemoveDNSFocus
+		netUpdateDialogApp.SetFocus(netUpdateDialog)
+		netUpdateDialogApp.Draw()
+
+		for _, r := range removeDNS {
+			netUpdateDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyRune, r, tcell.ModNone))
+		}
+
+		netUpdateDialogApp.Draw()
+
+		name, opts := netUpdateDialog.GetUpdateOptions()
+		Expect(name).To(Equal(netName))
+		Expect(opts.AddDNSServers).To(Equal([]string{"10.10.10.10", "10.10.10.11"}))
+		Expect(opts.RemoveDNSServers).To(Equal([]string{"8.8.8.8"}))
+	})
+
+	It("hide", func() {
+		netUpdateDialog.Hide()
+		Expect(netUpdateDialog.IsDisplay()).To(Equal(false))
+		name, opts := netUpdateDialog.GetUpdateOptions()
+		Expect(name).To(Equal(""))
+		Expect(opts.AddDNSServers).To(BeEmpty())
+	})
+
+	AfterAll(func() {
+		netUpdateDialogApp.Stop()
+	})
+})
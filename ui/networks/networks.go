@@ -25,6 +25,8 @@ var (
 	errNoNetworkInspect    = errors.New("there is no network to display inspect")
 	errNoNetworkDisconnect = errors.New("there is no network to disconnect")
 	errNoNetworkConnect    = errors.New("there is no network to connect")
+	errNoNetworkUpdate     = errors.New("there is no network to update")
+	errNoNetworkUsedBy     = errors.New("there is no network to list its containers")
 )
 
 var UIViewHeaders = []string{"id", "name", "driver"}
@@ -36,19 +38,27 @@ type Networks struct {
 	title            string
 	headers          []string
 	table            *tview.Table
+	detailsPanel     *dialogs.DetailsPanel
 	errorDialog      *dialogs.ErrorDialog
 	progressDialog   *dialogs.ProgressDialog
 	confirmDialog    *dialogs.ConfirmDialog
 	cmdDialog        *dialogs.CommandDialog
 	messageDialog    *dialogs.MessageDialog
+	inspectDialog    *dialogs.InspectDialog
 	sortDialog       *dialogs.SortDialog
 	createDialog     *netdialogs.NetworkCreateDialog
 	connectDialog    *netdialogs.NetworkConnectDialog
 	disconnectDialog *netdialogs.NetworkDisconnectDialog
+	topologyDialog   *netdialogs.NetworkTopologyDialog
+	updateDialog     *netdialogs.NetworkUpdateDialog
+	pruneDialog      *dialogs.PruneDialog
+	navigateDialog   *dialogs.NavigateDialog
 	networkList      networkListReport
 	selectedID       string
 	confirmData      string
 	appFocusHandler  func()
+	fastRefreshChan  chan bool
+	navigateHandler  func(kind string, id string)
 }
 
 type networkListReport struct {
@@ -70,13 +80,19 @@ func NewNetworks() *Networks {
 		title:            "networks",
 		headers:          UIViewHeaders,
 		errorDialog:      dialogs.NewErrorDialog(),
+		detailsPanel:     dialogs.NewDetailsPanel(),
 		progressDialog:   dialogs.NewProgressDialog(),
 		confirmDialog:    dialogs.NewConfirmDialog(),
 		messageDialog:    dialogs.NewMessageDialog(""),
+		inspectDialog:    dialogs.NewInspectDialog(),
 		sortDialog:       dialogs.NewSortDialog(sortHeaderItems, 0),
 		createDialog:     netdialogs.NewNetworkCreateDialog(),
 		connectDialog:    netdialogs.NewNetworkConnectDialog(),
 		disconnectDialog: netdialogs.NewNetworkDisconnectDialog(),
+		topologyDialog:   netdialogs.NewNetworkTopologyDialog(),
+		updateDialog:     netdialogs.NewNetworkUpdateDialog(),
+		pruneDialog:      dialogs.NewPruneDialog(false, false),
+		navigateDialog:   dialogs.NewNavigateDialog(),
 		networkList:      networkListReport{sortBy: UIViewHeaders[viewNetworkNameColIndex], ascending: true},
 	}
 
@@ -86,8 +102,10 @@ func NewNetworks() *Networks {
 		{"disconnect", "disconnect a container from a network"},
 		{"inspect", "displays the raw CNI network configuration"},
 		{"prune", "remove all unused networks"},
-		// {"reload", "reload the network for containers"},
 		{"rm", "remove a CNI networks"},
+		{"topology", "display networks with their attached containers and pods"},
+		{"update", "update network DNS servers"},
+		{"used by", "list containers connected to the selected network and switch to one of them"},
 	})
 
 	nets.table = tview.NewTable()
@@ -124,13 +142,16 @@ func NewNetworks() *Networks {
 		nets.messageDialog.Hide()
 	})
 
+	// set inspect dialog functions
+	nets.inspectDialog.SetCancelFunc(func() {
+		nets.inspectDialog.Hide()
+	})
+
 	// set confirm dialogs functions
 	nets.confirmDialog.SetSelectedFunc(func() {
 		nets.confirmDialog.Hide()
 
 		switch nets.confirmData {
-		case utils.PruneCommandLabel:
-			nets.prune()
 		case "rm":
 			nets.remove()
 		}
@@ -145,10 +166,7 @@ func NewNetworks() *Networks {
 		nets.createDialog.Hide()
 	})
 
-	nets.createDialog.SetCreateFunc(func() {
-		nets.createDialog.Hide()
-		nets.create()
-	})
+	nets.createDialog.SetCreateFunc(nets.create)
 
 	// set connect dialog functions
 	nets.connectDialog.SetCancelFunc(nets.connectDialog.Hide)
@@ -158,18 +176,49 @@ func NewNetworks() *Networks {
 	nets.disconnectDialog.SetCancelFunc(nets.disconnectDialog.Hide)
 	nets.disconnectDialog.SetDisconnectFunc(nets.disconnect)
 
+	// set topology dialog functions
+	nets.topologyDialog.SetCancelFunc(nets.topologyDialog.Hide)
+
+	// set update dialog functions
+	nets.updateDialog.SetCancelFunc(nets.updateDialog.Hide)
+	nets.updateDialog.SetUpdateFunc(nets.update)
+
+	// prune dialog functions
+	nets.pruneDialog.SetTitle("podman network prune")
+	nets.pruneDialog.SetCancelFunc(nets.pruneDialog.Hide)
+	nets.pruneDialog.SetPreviewFunc(nets.prunePreview)
+	nets.pruneDialog.SetPruneFunc(nets.prune)
+
+	// set navigate dialog functions
+	nets.navigateDialog.SetTitle("podman network used by")
+	nets.navigateDialog.SetCancelFunc(nets.navigateDialog.Hide)
+	nets.navigateDialog.SetGoToFunc(nets.goTo)
+
 	// set sort dialog functions
 	nets.sortDialog.SetCancelFunc(nets.sortDialog.Hide)
 	nets.sortDialog.SetSelectFunc(nets.SortView)
 
+	// set details panel functions
+	nets.initDetailsPanel()
+
 	return nets
 }
 
+// SetFastRefreshChannel sets channel for fastRefresh func.
+func (nets *Networks) SetFastRefreshChannel(refresh chan bool) {
+	nets.fastRefreshChan = refresh
+}
+
 // SetAppFocusHandler sets application focus handler.
 func (nets *Networks) SetAppFocusHandler(handler func()) {
 	nets.appFocusHandler = handler
 }
 
+// SetNavigateFunc sets the handler which switches to another resource screen.
+func (nets *Networks) SetNavigateFunc(handler func(kind string, id string)) {
+	nets.navigateHandler = handler
+}
+
 // GetTitle returns primitive title.
 func (nets *Networks) GetTitle() string {
 	return nets.title
@@ -245,6 +294,24 @@ func (nets *Networks) getSelectedItem() (string, string) {
 	return netID, netName
 }
 
+// GetSelectedItemID returns the selected network ID.
+func (nets *Networks) GetSelectedItemID() string {
+	netID, _ := nets.getSelectedItem()
+
+	return netID
+}
+
+// SelectItem selects the network by its ID or name.
+func (nets *Networks) SelectItem(id string) {
+	for i, network := range nets.getData() {
+		if utils.MatchIDOrName(id, network.ID, network.Name) {
+			nets.table.Select(i+1, 0)
+
+			return
+		}
+	}
+}
+
 func (nets *Networks) getInnerDialogs() []utils.UIDialog {
 	dialogs := []utils.UIDialog{
 		nets.errorDialog,
@@ -252,9 +319,14 @@ func (nets *Networks) getInnerDialogs() []utils.UIDialog {
 		nets.confirmDialog,
 		nets.cmdDialog,
 		nets.messageDialog,
+		nets.inspectDialog,
 		nets.connectDialog,
 		nets.createDialog,
 		nets.disconnectDialog,
+		nets.topologyDialog,
+		nets.updateDialog,
+		nets.pruneDialog,
+		nets.navigateDialog,
 		nets.sortDialog,
 	}
 
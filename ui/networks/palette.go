@@ -0,0 +1,30 @@
+package networks
+
+import putils "github.com/containers/podman-tui/pdcs/utils"
+
+// GetCommands returns the networks screen commands and their descriptions.
+func (nets *Networks) GetCommands() [][]string {
+	return nets.cmdDialog.GetCommands()
+}
+
+// RunCommand runs the networks screen command on the selected item.
+func (nets *Networks) RunCommand(cmd string) {
+	nets.runCommand(cmd)
+}
+
+// GetResources returns the networks listed on the screen.
+func (nets *Networks) GetResources() []putils.ResourceReference {
+	data := nets.getData()
+	resources := make([]putils.ResourceReference, 0, len(data))
+
+	for _, item := range data {
+		resources = append(resources, putils.ResourceReference{
+			Kind: putils.ResourceKindNetwork,
+			ID:   item.ID,
+			Name: item.Name,
+			Info: item.Driver,
+		})
+	}
+
+	return resources
+}
@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/containers/podman-tui/config"
+	"github.com/containers/podman-tui/pdcs/containers"
 	ppods "github.com/containers/podman-tui/pdcs/pods"
 	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/pods/poddialogs"
 	"github.com/containers/podman-tui/ui/style"
 	"github.com/containers/podman-tui/ui/utils"
 	"github.com/rs/zerolog/log"
@@ -13,8 +16,13 @@ import (
 
 func (p *Pods) runCommand(cmd string) { //nolint:cyclop
 	switch cmd {
+	case "attach":
+		p.preContainerSelect("attach")
 	case "create":
+		p.presetName = ""
 		p.createDialog.Display()
+	case "exec":
+		p.preContainerSelect("exec")
 	case "inspect":
 		p.inspect()
 	case "kill":
@@ -22,10 +30,8 @@ func (p *Pods) runCommand(cmd string) { //nolint:cyclop
 	case "pause":
 		p.pause()
 	case utils.PruneCommandLabel:
-		p.confirmDialog.SetTitle("podman pod prune")
-		p.confirmData = utils.PruneCommandLabel
-		p.confirmDialog.SetText("Are you sure you want to remove all stopped pods ?")
-		p.confirmDialog.Display()
+		p.pruneDialog.Display()
+		p.prunePreview()
 	case "restart":
 		p.restart()
 	case "rm":
@@ -85,6 +91,94 @@ func (p *Pods) create() {
 	go createFunc()
 }
 
+func (p *Pods) presets() {
+	presets, err := config.PodPresets()
+	if err != nil {
+		p.displayError("POD PRESETS ERROR", err)
+
+		return
+	}
+
+	if len(presets) == 0 {
+		p.displayError("", errNoPodPresets)
+
+		return
+	}
+
+	names := make([]string, 0, len(presets))
+	for _, preset := range presets {
+		names = append(names, preset.Name)
+	}
+
+	p.presetsDialog.SetTitle("podman pod presets")
+	p.presetsDialog.SetPresets(names)
+	p.presetsDialog.Display()
+}
+
+func (p *Pods) loadPreset() {
+	name := p.presetsDialog.GetSelectedPreset()
+
+	p.presetsDialog.Hide()
+
+	presets, err := config.PodPresets()
+	if err != nil {
+		p.displayError("POD PRESET LOAD ERROR", err)
+
+		return
+	}
+
+	for _, preset := range presets {
+		if preset.Name == name {
+			p.createDialog.SetPodSpec(preset.Options)
+			p.presetName = name
+
+			return
+		}
+	}
+
+	p.displayError("POD PRESET LOAD ERROR", fmt.Errorf("%w: %q", config.ErrPresetNotFound, name))
+}
+
+func (p *Pods) deletePreset() {
+	name := p.presetsDialog.GetSelectedPreset()
+	if err := config.RemovePodPreset(name); err != nil {
+		p.presetsDialog.Hide()
+		p.displayError("POD PRESET DELETE ERROR", err)
+
+		return
+	}
+
+	if p.presetName == name {
+		p.presetName = ""
+	}
+
+	p.presetsDialog.Hide()
+	p.presets()
+}
+
+func (p *Pods) savePreset() {
+	p.cmdInputDialog.SetTitle("podman pod preset save")
+	p.cmdInputDialog.SetDescription("save the dialog values as a preset, existing preset with the same name will be overwritten.")
+	p.cmdInputDialog.SetSelectButtonLabel("save")
+	p.cmdInputDialog.SetLabel("preset name ")
+	p.cmdInputDialog.SetInputText(p.presetName)
+
+	p.cmdInputDialog.SetSelectedFunc(func() {
+		name := strings.TrimSpace(p.cmdInputDialog.GetInputText())
+		p.cmdInputDialog.Hide()
+
+		if err := config.SavePodPreset(name, p.createDialog.GetPodSpec()); err != nil {
+			p.displayError("POD PRESET SAVE ERROR", err)
+
+			return
+		}
+
+		p.presetName = name
+	})
+
+	p.cmdInputDialog.Display()
+}
+
 func (p *Pods) inspect() {
 	podID, podName := p.getSelectedItem()
 	if podID == "" {
@@ -104,9 +198,9 @@ func (p *Pods) inspect() {
 
 	headerLabel := fmt.Sprintf("%12s (%s)", podID, podName)
 
-	p.messageDialog.SetTitle("podman pod inspect")
-	p.messageDialog.SetText(dialogs.MessagePodInfo, headerLabel, data)
-	p.messageDialog.DisplayFullSize()
+	p.inspectDialog.SetTitle("podman pod inspect")
+	p.inspectDialog.SetData(dialogs.MessagePodInfo, headerLabel, data)
+	p.inspectDialog.Display()
 }
 
 func (p *Pods) kill() {
@@ -165,12 +259,50 @@ func (p *Pods) pause() {
 	go pause(p.selectedID)
 }
 
+func (p *Pods) prunePreview() {
+	opts, err := p.pruneDialog.GetPruneOptions()
+	if err != nil {
+		p.displayError("PODS PRUNE PREVIEW ERROR", err)
+
+		return
+	}
+
+	p.progressDialog.SetTitle("pod prune preview in progress")
+	p.progressDialog.Display()
+
+	preview := func() {
+		reports, err := ppods.PrunePreview(opts)
+
+		p.progressDialog.Hide()
+
+		if err != nil {
+			p.displayError("PODS PRUNE PREVIEW ERROR", err)
+			p.appFocusHandler()
+
+			return
+		}
+
+		p.pruneDialog.SetPreview(reports)
+		p.appFocusHandler()
+	}
+
+	go preview()
+}
+
 func (p *Pods) prune() {
+	opts, err := p.pruneDialog.GetPruneOptions()
+	if err != nil {
+		p.displayError("PODS PRUNE ERROR", err)
+
+		return
+	}
+
+	p.pruneDialog.Hide()
 	p.progressDialog.SetTitle("pod prune in progress")
 	p.progressDialog.Display()
 
 	unpause := func() {
-		errData, err := ppods.Prune()
+		errData, err := ppods.Prune(opts)
 
 		p.progressDialog.Hide()
 
@@ -373,3 +505,150 @@ func (p *Pods) unpause() {
 
 	go unpause(p.selectedID)
 }
+
+func (p *Pods) preContainerSelect(action string) {
+	podID, podName := p.getSelectedItem()
+	if podID == "" {
+		if action == "exec" {
+			p.displayError("", errNoPodExec)
+		} else {
+			p.displayError("", errNoPodAttach)
+		}
+
+		return
+	}
+
+	podContainers, defaultID := p.getPodContainers(podID)
+	if len(podContainers) == 0 {
+		p.displayError("", errNoPodCnt)
+
+		return
+	}
+
+	p.cntSelectAction = action
+
+	p.cntSelectDialog.SetTitle(action)
+	p.cntSelectDialog.SetPodInfo(podID, podName)
+	p.cntSelectDialog.SetContainers(podContainers, defaultID)
+	p.cntSelectDialog.Display()
+}
+
+// getPodContainers returns pod's containers and its default container ID.
+// The default container is the first running non infra container.
+func (p *Pods) getPodContainers(podID string) ([]poddialogs.PodContainer, string) {
+	var (
+		podContainers []poddialogs.PodContainer
+		defaultID     string
+		firstID       string
+	)
+
+	for _, report := range p.getData() {
+		if !strings.HasPrefix(report.Id, podID) {
+			continue
+		}
+
+		for _, cnt := range report.Containers {
+			infra := cnt.Id == report.InfraId
+
+			podContainers = append(podContainers, poddialogs.PodContainer{
+				ID:     cnt.Id,
+				Name:   cnt.Names,
+				Status: cnt.Status,
+				Infra:  infra,
+			})
+
+			if infra {
+				continue
+			}
+
+			if firstID == "" {
+				firstID = cnt.Id
+			}
+
+			if defaultID == "" && cnt.Status == "running" {
+				defaultID = cnt.Id
+			}
+		}
+
+		break
+	}
+
+	if defaultID == "" {
+		defaultID = firstID
+	}
+
+	return podContainers, defaultID
+}
+
+func (p *Pods) containerSelected() {
+	cntID, cntName := p.cntSelectDialog.GetSelectedContainer()
+	if cntID == "" {
+		return
+	}
+
+	p.cntSelectDialog.Hide()
+
+	p.selectedCntID = cntID
+	p.selectedCntName = cntName
+
+	switch p.cntSelectAction {
+	case "exec":
+		p.execDialog.SetContainerID(utils.GetIDWithLimit(cntID), cntName)
+		p.execDialog.Display()
+	case "attach":
+		p.attach()
+	}
+}
+
+func (p *Pods) exec() {
+	p.execDialog.Hide()
+
+	cntID, cntName := p.selectedCntID, p.selectedCntName
+	_, _, width, height := p.table.GetInnerRect()
+
+	err := p.execSessions.Exec(cntID, cntName, p.execDialog.ContainerExecOptions(), width, height)
+	if err != nil {
+		title := fmt.Sprintf("CONTAINER (%s) EXEC ERROR", utils.GetIDWithLimit(cntID))
+
+		p.displayError(title, err)
+	}
+}
+
+func (p *Pods) attach() {
+	cntID, cntName := p.selectedCntID, p.selectedCntName
+
+	p.progressDialog.SetTitle("container attach in progress")
+	p.progressDialog.Display()
+
+	attachReady := make(chan bool)
+	stdin, stdout := p.terminalDialog.InitAttachChannels()
+	detachKeys := p.terminalDialog.DetachKeys()
+
+	attach := func() {
+		err := containers.Attach(cntID, stdin, stdout, attachReady, detachKeys)
+		if err != nil {
+			attachReady <- false
+
+			title := fmt.Sprintf("CONTAINER (%s) ATTACH ERROR", utils.GetIDWithLimit(cntID))
+
+			p.progressDialog.Hide()
+			p.displayError(title, err)
+			p.appFocusHandler()
+
+			return
+		}
+	}
+
+	waitForAttach := func() {
+		isReady := <-attachReady
+		if isReady {
+			p.progressDialog.Hide()
+			p.terminalDialog.SetContainerInfo(cntID, cntName)
+			p.terminalDialog.Display()
+			p.appFocusHandler()
+		}
+	}
+
+	go waitForAttach()
+	go attach()
+}
@@ -0,0 +1,70 @@
+package pods
+
+import (
+	"github.com/containers/podman-tui/ui/containers/cntdialogs/vterm"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rs/zerolog"
+	"go.podman.io/podman/v6/pkg/domain/entities"
+)
+
+var _ = Describe("pod container exec", Ordered, func() {
+	var podsView *Pods
+	var execSessions *vterm.VtermSessionsDialog
+
+	BeforeAll(func() {
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+
+		execSessions = vterm.NewVtermSessionsDialog()
+		podsView = NewPods(execSessions)
+		podsView.podsList.report = []*entities.ListPodsReport{
+			{
+				Id:      "pod01id",
+				Name:    "pod01",
+				InfraId: "infra01id",
+				Containers: []*entities.ListPodContainer{
+					{Id: "infra01id", Names: "pod01-infra", Status: "running"},
+					{Id: "cnt01id", Names: "cnt01", Status: "exited"},
+					{Id: "cnt02id", Names: "cnt02", Status: "running"},
+				},
+			},
+		}
+	})
+
+	It("pod containers", func() {
+		podContainers, defaultID := podsView.getPodContainers("pod01id")
+		Expect(podContainers).To(HaveLen(3))
+		Expect(podContainers[0].Infra).To(BeTrue())
+		Expect(defaultID).To(Equal("cnt02id"))
+	})
+
+	It("exec dispatch", func() {
+		podContainers, defaultID := podsView.getPodContainers("pod01id")
+
+		podsView.cntSelectAction = "exec"
+		podsView.cntSelectDialog.SetContainers(podContainers, defaultID)
+		podsView.cntSelectDialog.Display()
+		podsView.containerSelected()
+
+		Expect(podsView.cntSelectDialog.IsDisplay()).To(BeFalse())
+		Expect(podsView.execDialog.IsDisplay()).To(BeTrue())
+		Expect(podsView.selectedCntID).To(Equal("cnt02id"))
+		Expect(podsView.selectedCntName).To(Equal("cnt02"))
+	})
+
+	It("exec error", func() {
+		// there is no connection selected, exec session creation fails
+		podsView.exec()
+
+		Expect(podsView.execDialog.IsDisplay()).To(BeFalse())
+		Expect(podsView.errorDialog.IsDisplay()).To(BeTrue())
+		Expect(execSessions.SessionCount()).To(Equal(0))
+	})
+
+	It("hide all dialogs keeps shared sessions", func() {
+		execSessions.NewSession()
+		podsView.HideAllDialogs()
+
+		Expect(execSessions.SessionCount()).To(Equal(1))
+	})
+})
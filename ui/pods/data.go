@@ -27,6 +27,8 @@ func (pods *Pods) SortView(option string, ascending bool) {
 
 // UpdateData retrieves pods list data.
 func (pods *Pods) UpdateData() {
+	pods.detailsPanel.Invalidate()
+
 	podList, err := ppods.List()
 	if err != nil {
 		log.Error().Msgf("view: pods update %v", err)
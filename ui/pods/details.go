@@ -0,0 +1,27 @@
+package pods
+
+import (
+	ppods "github.com/containers/podman-tui/pdcs/pods"
+)
+
+// initDetailsPanel sets details panel title, its data loader and reloads
+// the details whenever the table selected item changes.
+func (pods *Pods) initDetailsPanel() {
+	pods.detailsPanel.SetTitle("pod details")
+	pods.detailsPanel.SetLoadFunc(ppods.Details)
+	pods.detailsPanel.SetSelectedItemFunc(pods.selectedItemID)
+	pods.detailsPanel.SetRefreshFunc(func() {
+		if pods.fastRefreshChan != nil {
+			pods.fastRefreshChan <- true
+		}
+	})
+	pods.table.SetSelectionChangedFunc(func(_, _ int) {
+		pods.detailsPanel.Update(pods.selectedItemID())
+	})
+}
+
+func (pods *Pods) selectedItemID() string {
+	id, _ := pods.getSelectedItem()
+
+	return id
+}
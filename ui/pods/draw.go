@@ -10,14 +10,18 @@ func (pods *Pods) Draw(screen tcell.Screen) { //nolint:cyclop
 	pods.SetBorder(false)
 
 	podViewX, podViewY, podViewW, podViewH := pods.GetInnerRect()
+	tableX, tableY, tableW, tableH := pods.detailsPanel.Split(podViewX, podViewY, podViewW, podViewH)
 
-	pods.refresh(podViewW)
-	pods.table.SetRect(podViewX, podViewY, podViewW, podViewH)
+	pods.refresh(tableW)
+	pods.table.SetRect(tableX, tableY, tableW, tableH)
 	pods.table.SetBorder(true)
 
 	pods.table.Draw(screen)
 
-	x, y, width, height := pods.table.GetInnerRect()
+	pods.detailsPanel.Draw(screen)
+
+	// dialogs are drawn over the table and details panel
+	x, y, width, height := podViewX+1, podViewY+1, podViewW-2, podViewH-2 //nolint:mnd
 
 	// error dialog
 	if pods.errorDialog.IsDisplay() {
@@ -35,6 +39,22 @@ func (pods *Pods) Draw(screen tcell.Screen) { //nolint:cyclop
 		return
 	}
 
+	// command input dialog
+	if pods.cmdInputDialog.IsDisplay() {
+		pods.cmdInputDialog.SetRect(x, y, width, height)
+		pods.cmdInputDialog.Draw(screen)
+
+		return
+	}
+
+	// presets dialog
+	if pods.presetsDialog.IsDisplay() {
+		pods.presetsDialog.SetRect(x, y, width, height)
+		pods.presetsDialog.Draw(screen)
+
+		return
+	}
+
 	// create dialog
 	if pods.createDialog.IsDisplay() {
 		pods.createDialog.SetRect(x, y, width, height)
@@ -64,6 +84,20 @@ func (pods *Pods) Draw(screen tcell.Screen) { //nolint:cyclop
 		return
 	}
 
+	// inspect dialog
+	if pods.inspectDialog.IsDisplay() {
+		pods.inspectDialog.SetRect(podViewX, podViewY, podViewW, podViewH)
+		pods.inspectDialog.Draw(screen)
+
+		return
+	}
+
+	// prune dialog (progress dialog is drawn on top during preview)
+	if pods.pruneDialog.IsDisplay() {
+		pods.pruneDialog.SetRect(x, y, width, height)
+		pods.pruneDialog.Draw(screen)
+	}
+
 	// progress dialog
 	if pods.progressDialog.IsDisplay() {
 		pods.progressDialog.SetRect(x, y, width, height)
@@ -93,4 +127,36 @@ func (pods *Pods) Draw(screen tcell.Screen) { //nolint:cyclop
 
 		return
 	}
+
+	// container select dialog
+	if pods.cntSelectDialog.IsDisplay() {
+		pods.cntSelectDialog.SetRect(x, y, width, height)
+		pods.cntSelectDialog.Draw(screen)
+
+		return
+	}
+
+	// exec dialog
+	if pods.execDialog.IsDisplay() {
+		pods.execDialog.SetRect(x, y, width, height)
+		pods.execDialog.Draw(screen)
+
+		return
+	}
+
+	// terminal dialog
+	if pods.terminalDialog.IsDisplay() {
+		pods.terminalDialog.SetRect(podViewX, podViewY, podViewW, podViewH)
+		pods.terminalDialog.Draw(screen)
+
+		return
+	}
+
+	// exec terminal sessions dialog
+	if pods.execSessions.IsDisplay() {
+		pods.execSessions.SetRect(podViewX, podViewY, podViewW, podViewH)
+		pods.execSessions.Draw(screen)
+
+		return
+	}
 }
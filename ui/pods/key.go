@@ -23,6 +23,20 @@ func (pods *Pods) InputHandler() func(event *tcell.EventKey, setFocus func(p tvi
 			}
 		}
 
+		// input dialog handler
+		if pods.cmdInputDialog.HasFocus() {
+			if cmdInputHandler := pods.cmdInputDialog.InputHandler(); cmdInputHandler != nil {
+				cmdInputHandler(event, setFocus)
+			}
+		}
+
+		// presets dialog handler
+		if pods.presetsDialog.HasFocus() {
+			if presetsDialogHandler := pods.presetsDialog.InputHandler(); presetsDialogHandler != nil {
+				presetsDialogHandler(event, setFocus)
+			}
+		}
+
 		// message dialog handler
 		if pods.messageDialog.HasFocus() {
 			if messageDialogHandler := pods.messageDialog.InputHandler(); messageDialogHandler != nil {
@@ -30,6 +44,13 @@ func (pods *Pods) InputHandler() func(event *tcell.EventKey, setFocus func(p tvi
 			}
 		}
 
+		// inspect dialog handler
+		if pods.inspectDialog.HasFocus() {
+			if inspectDialogHandler := pods.inspectDialog.InputHandler(); inspectDialogHandler != nil {
+				inspectDialogHandler(event, setFocus)
+			}
+		}
+
 		// create dialog handler
 		if pods.createDialog.HasFocus() {
 			if createDialogHandler := pods.createDialog.InputHandler(); createDialogHandler != nil {
@@ -44,6 +65,13 @@ func (pods *Pods) InputHandler() func(event *tcell.EventKey, setFocus func(p tvi
 			}
 		}
 
+		// prune dialog handler
+		if pods.pruneDialog.HasFocus() {
+			if pruneDialogHandler := pods.pruneDialog.InputHandler(); pruneDialogHandler != nil {
+				pruneDialogHandler(event, setFocus)
+			}
+		}
+
 		// command dialog handler
 		if pods.cmdDialog.HasFocus() {
 			if cmdHandler := pods.cmdDialog.InputHandler(); cmdHandler != nil {
@@ -72,6 +100,34 @@ func (pods *Pods) InputHandler() func(event *tcell.EventKey, setFocus func(p tvi
 			}
 		}
 
+		// container select dialog handler
+		if pods.cntSelectDialog.HasFocus() {
+			if cntSelectDialogHandler := pods.cntSelectDialog.InputHandler(); cntSelectDialogHandler != nil {
+				cntSelectDialogHandler(event, setFocus)
+			}
+		}
+
+		// exec dialog handler
+		if pods.execDialog.HasFocus() {
+			if execDialogHandler := pods.execDialog.InputHandler(); execDialogHandler != nil {
+				execDialogHandler(event, setFocus)
+			}
+		}
+
+		// terminal dialog handler
+		if pods.terminalDialog.HasFocus() {
+			if terminalDialogHandler := pods.terminalDialog.InputHandler(); terminalDialogHandler != nil {
+				terminalDialogHandler(event, setFocus)
+			}
+		}
+
+		// exec terminal sessions dialog handler
+		if pods.execSessions.HasFocus() {
+			if execSessionsHandler := pods.execSessions.InputHandler(); execSessionsHandler != nil {
+				execSessionsHandler(event, setFocus)
+			}
+		}
+
 		// table handlers
 		if pods.table.HasFocus() { //nolint:nestif
 			pods.selectedID, _ = pods.getSelectedItem()
@@ -94,6 +150,13 @@ func (pods *Pods) InputHandler() func(event *tcell.EventKey, setFocus func(p tvi
 				return
 			}
 
+			// toggle details panel
+			if event.Rune() == utils.DetailsPanelKey.Rune() {
+				pods.detailsPanel.Toggle()
+
+				return
+			}
+
 			if event.Key() == utils.DeleteKey.EventKey() {
 				pods.rm()
 				setFocus(pods)
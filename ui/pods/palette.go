@@ -0,0 +1,30 @@
+package pods
+
+import putils "github.com/containers/podman-tui/pdcs/utils"
+
+// GetCommands returns the pods screen commands and their descriptions.
+func (pods *Pods) GetCommands() [][]string {
+	return pods.cmdDialog.GetCommands()
+}
+
+// RunCommand runs the pods screen command on the selected item.
+func (pods *Pods) RunCommand(cmd string) {
+	pods.runCommand(cmd)
+}
+
+// GetResources returns the pods listed on the screen.
+func (pods *Pods) GetResources() []putils.ResourceReference {
+	data := pods.getData()
+	resources := make([]putils.ResourceReference, 0, len(data))
+
+	for _, item := range data {
+		resources = append(resources, putils.ResourceReference{
+			Kind: putils.ResourceKindPod,
+			ID:   item.Id,
+			Name: item.Name,
+			Info: item.Status,
+		})
+	}
+
+	return resources
+}
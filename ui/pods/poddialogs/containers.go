@@ -0,0 +1,408 @@
+package poddialogs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	podContainerSelectDialogMaxWidth  = 90
+	podContainerSelectDialogMaxHeight = 20
+	podContainerDefaultMark           = "*"
+)
+
+const (
+	podContainerSelectInfraFocus = 0 + iota
+	podContainerSelectTableFocus
+	podContainerSelectFormFocus
+)
+
+const (
+	podContainerDefaultColIndex = 0 + iota
+	podContainerIDColIndex
+	podContainerNameColIndex
+	podContainerStatusColIndex
+)
+
+// PodContainer represents a pod's container item in the container select dialog.
+type PodContainer struct {
+	ID     string
+	Name   string
+	Status string
+	Infra  bool
+}
+
+// PodContainerSelectDialog implements pod's container select dialog.
+// It is used to pick a pod's container for exec and attach commands.
+type PodContainerSelectDialog struct {
+	*tview.Box
+
+	layout        *tview.Flex
+	form          *tview.Form
+	podInfo       *tview.InputField
+	showInfra     *tview.Checkbox
+	table         *tview.Table
+	display       bool
+	focusElement  int
+	tableHeaders  []string
+	containers    []PodContainer
+	items         []PodContainer
+	defaultID     string
+	selectHandler func()
+	cancelHandler func()
+}
+
+// NewPodContainerSelectDialog returns new pod's container select dialog primitive.
+func NewPodContainerSelectDialog() *PodContainerSelectDialog {
+	selectDialog := &PodContainerSelectDialog{
+		Box:          tview.NewBox(),
+		layout:       tview.NewFlex().SetDirection(tview.FlexRow),
+		form:         tview.NewForm(),
+		podInfo:      tview.NewInputField(),
+		showInfra:    tview.NewCheckbox(),
+		table:        tview.NewTable(),
+		tableHeaders: []string{"", "container id", "name", "status"},
+		focusElement: podContainerSelectTableFocus,
+	}
+
+	bgColor := style.DialogBgColor
+
+	// pod info field
+	podInfoLabel := "POD ID:"
+
+	selectDialog.podInfo.SetBackgroundColor(bgColor)
+	selectDialog.podInfo.SetLabel("[::b]" + podInfoLabel)
+	selectDialog.podInfo.SetLabelWidth(len(podInfoLabel) + 1)
+	selectDialog.podInfo.SetFieldBackgroundColor(bgColor)
+	selectDialog.podInfo.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(style.DialogFgColor))
+
+	// show infra checkbox
+	showInfraLabel := "show infra container:"
+
+	selectDialog.showInfra.SetBackgroundColor(bgColor)
+	selectDialog.showInfra.SetLabel(showInfraLabel)
+	selectDialog.showInfra.SetLabelWidth(len(showInfraLabel) + 1)
+	selectDialog.showInfra.SetLabelColor(style.DialogFgColor)
+	selectDialog.showInfra.SetFieldBackgroundColor(style.FieldBackgroundColor)
+	selectDialog.showInfra.SetChangedFunc(func(_ bool) {
+		selectDialog.setTableItems()
+	})
+
+	// containers table
+	selectDialog.table.SetBackgroundColor(bgColor)
+	selectDialog.table.SetBorder(true)
+	selectDialog.table.SetBorderColor(style.DialogSubBoxBorderColor)
+	selectDialog.initTable()
+
+	// form
+	selectDialog.form.AddButton("Cancel", nil)
+	selectDialog.form.AddButton("Select", nil)
+	selectDialog.form.SetButtonsAlign(tview.AlignRight)
+	selectDialog.form.SetBackgroundColor(bgColor)
+	selectDialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	optionsLayout := tview.NewFlex().SetDirection(tview.FlexRow)
+
+	optionsLayout.SetBackgroundColor(bgColor)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(selectDialog.podInfo, 1, 0, false)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(selectDialog.showInfra, 1, 0, false)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(selectDialog.table, 0, 1, true)
+
+	mainOptsLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+
+	mainOptsLayout.SetBackgroundColor(bgColor)
+	mainOptsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainOptsLayout.AddItem(optionsLayout, 0, 1, true)
+	mainOptsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	selectDialog.layout.SetBackgroundColor(bgColor)
+	selectDialog.layout.SetBorder(true)
+	selectDialog.layout.SetBorderColor(style.DialogBorderColor)
+	selectDialog.layout.AddItem(mainOptsLayout, 0, 1, true)
+	selectDialog.layout.AddItem(selectDialog.form, dialogs.DialogFormHeight, 0, true)
+
+	return selectDialog
+}
+
+// Display displays this primitive.
+func (d *PodContainerSelectDialog) Display() {
+	d.display = true
+	d.focusElement = podContainerSelectTableFocus
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *PodContainerSelectDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *PodContainerSelectDialog) Hide() {
+	d.display = false
+	d.focusElement = podContainerSelectTableFocus
+
+	d.podInfo.SetText("")
+	d.showInfra.SetChecked(false)
+	d.SetContainers(nil, "")
+}
+
+// SetRect set rects for this primitive.
+func (d *PodContainerSelectDialog) SetRect(x, y, width, height int) {
+	if width > podContainerSelectDialogMaxWidth {
+		emptySpace := (width - podContainerSelectDialogMaxWidth) / 2 //nolint:mnd
+		x += emptySpace
+		width = podContainerSelectDialogMaxWidth
+	}
+
+	if height > podContainerSelectDialogMaxHeight {
+		emptySpace := (height - podContainerSelectDialogMaxHeight) / 2 //nolint:mnd
+		y += emptySpace
+		height = podContainerSelectDialogMaxHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *PodContainerSelectDialog) HasFocus() bool {
+	if d.table.HasFocus() || d.form.HasFocus() || d.showInfra.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus() || d.layout.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *PodContainerSelectDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case podContainerSelectInfraFocus:
+		delegate(d.showInfra)
+	case podContainerSelectTableFocus:
+		delegate(d.table)
+	case podContainerSelectFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = podContainerSelectInfraFocus
+
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// Draw draws this primitive into the screen.
+func (d *PodContainerSelectDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.DrawForSubclass(screen, d)
+
+	x, y, width, height := d.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *PodContainerSelectDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("pod container select dialog: event %v received", event)
+
+		if event.Key() == utils.CloseDialogKey.Key {
+			d.cancelHandler()
+
+			return
+		}
+
+		if event.Key() == utils.SwitchFocusKey.Key && !d.form.HasFocus() {
+			d.setFocusElement()
+			d.Focus(setFocus)
+
+			return
+		}
+
+		if d.showInfra.HasFocus() {
+			if handler := d.showInfra.InputHandler(); handler != nil {
+				handler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.table.HasFocus() {
+			if event.Key() == tcell.KeyEnter {
+				if d.selectHandler != nil && len(d.items) > 0 {
+					d.selectHandler()
+				}
+
+				return
+			}
+
+			if tableHandler := d.table.InputHandler(); tableHandler != nil {
+				tableHandler(utils.ParseKeyEventKey(event), setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+// SetSelectFunc sets form select button selected function.
+func (d *PodContainerSelectDialog) SetSelectFunc(handler func()) *PodContainerSelectDialog {
+	d.selectHandler = handler
+	selectButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+	selectButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *PodContainerSelectDialog) SetCancelFunc(handler func()) *PodContainerSelectDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 2) //nolint:mnd
+
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetTitle sets dialog title and select button label, i.e. exec or attach.
+func (d *PodContainerSelectDialog) SetTitle(action string) {
+	d.layout.SetTitle(strings.ToUpper("podman pod " + action))
+	if action != "" {
+		d.form.GetButton(d.form.GetButtonCount() - 1).SetLabel(strings.ToUpper(action[:1]) + action[1:])
+	}
+}
+
+// SetPodInfo sets selected pod information in the dialog.
+func (d *PodContainerSelectDialog) SetPodInfo(id string, name string) {
+	d.podInfo.SetText(fmt.Sprintf("%s (%s)", id, name))
+}
+
+// SetContainers sets pod's containers list and the default container to be pre-selected.
+func (d *PodContainerSelectDialog) SetContainers(containers []PodContainer, defaultID string) {
+	d.containers = containers
+	d.defaultID = defaultID
+
+	d.setTableItems()
+}
+
+// GetSelectedContainer returns selected container's ID and name.
+func (d *PodContainerSelectDialog) GetSelectedContainer() (string, string) {
+	row, _ := d.table.GetSelection()
+	if row < 1 || row > len(d.items) {
+		return "", ""
+	}
+
+	return d.items[row-1].ID, d.items[row-1].Name
+}
+
+func (d *PodContainerSelectDialog) setFocusElement() {
+	switch d.focusElement {
+	case podContainerSelectInfraFocus:
+		d.focusElement = podContainerSelectTableFocus
+	case podContainerSelectTableFocus:
+		d.focusElement = podContainerSelectFormFocus
+	}
+}
+
+func (d *PodContainerSelectDialog) setTableItems() {
+	d.items = nil
+	d.initTable()
+
+	selectedRow := 1
+
+	for _, cnt := range d.containers {
+		if cnt.Infra && !d.showInfra.IsChecked() {
+			continue
+		}
+
+		d.items = append(d.items, cnt)
+		rowIndex := len(d.items)
+
+		defaultMark := ""
+		if cnt.ID == d.defaultID {
+			defaultMark = podContainerDefaultMark
+			selectedRow = rowIndex
+		}
+
+		name := cnt.Name
+		if cnt.Infra {
+			name += " (infra)"
+		}
+
+		d.table.SetCell(rowIndex, podContainerDefaultColIndex,
+			tview.NewTableCell(defaultMark).
+				SetExpansion(0).
+				SetAlign(tview.AlignLeft))
+
+		d.table.SetCell(rowIndex, podContainerIDColIndex,
+			tview.NewTableCell(utils.GetIDWithLimit(cnt.ID)).
+				SetExpansion(1).
+				SetAlign(tview.AlignLeft))
+
+		d.table.SetCell(rowIndex, podContainerNameColIndex,
+			tview.NewTableCell(name).
+				SetExpansion(1).
+				SetAlign(tview.AlignLeft))
+
+		d.table.SetCell(rowIndex, podContainerStatusColIndex,
+			tview.NewTableCell(cnt.Status).
+				SetExpansion(1).
+				SetAlign(tview.AlignLeft))
+	}
+
+	if len(d.items) > 0 {
+		d.table.Select(selectedRow, 0)
+		d.table.ScrollToBeginning()
+	}
+}
+
+func (d *PodContainerSelectDialog) initTable() {
+	bgColor := style.TableHeaderBgColor
+	fgColor := style.TableHeaderFgColor
+
+	d.table.Clear()
+	d.table.SetFixed(1, 1)
+	d.table.SetSelectable(true, false)
+
+	for i := range d.tableHeaders {
+		d.table.SetCell(0, i,
+			tview.NewTableCell(fmt.Sprintf("[%s::b]%s", style.GetColorHex(fgColor), strings.ToUpper(d.tableHeaders[i]))).
+				SetExpansion(1).
+				SetBackgroundColor(bgColor).
+				SetTextColor(fgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
+	}
+}
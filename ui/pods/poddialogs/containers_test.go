@@ -0,0 +1,146 @@
+package poddialogs
+
+import (
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("pod container select", Ordered, func() {
+	var selectDialogApp *tview.Application
+	var selectDialogScreen tcell.SimulationScreen
+	var selectDialog *PodContainerSelectDialog
+	var runApp func()
+
+	podContainers := []PodContainer{
+		{ID: "infra01id", Name: "pod01-infra", Status: "running", Infra: true},
+		{ID: "cnt01id", Name: "cnt01", Status: "exited"},
+		{ID: "cnt02id", Name: "cnt02", Status: "running"},
+	}
+
+	BeforeAll(func() {
+		selectDialogApp = tview.NewApplication()
+		selectDialog = NewPodContainerSelectDialog()
+		selectDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := selectDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+
+		runApp = func() {
+			if err := selectDialogApp.SetScreen(selectDialogScreen).SetRoot(selectDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		selectDialog.Display()
+		selectDialogApp.Draw()
+		Expect(selectDialog.IsDisplay()).To(Equal(true))
+		Expect(selectDialog.focusElement).To(Equal(podContainerSelectTableFocus))
+	})
+
+	It("set title", func() {
+		selectDialog.SetTitle("exec")
+		Expect(selectDialog.layout.GetTitle()).To(Equal("PODMAN POD EXEC"))
+		Expect(selectDialog.form.GetButton(1).GetLabel()).To(Equal("Exec"))
+
+		selectDialog.SetTitle("attach")
+		Expect(selectDialog.layout.GetTitle()).To(Equal("PODMAN POD ATTACH"))
+		Expect(selectDialog.form.GetButton(1).GetLabel()).To(Equal("Attach"))
+	})
+
+	It("set pod info", func() {
+		selectDialog.SetPodInfo("pod01id", "pod01")
+		Expect(selectDialog.podInfo.GetText()).To(Equal("pod01id (pod01)"))
+	})
+
+	It("default container is pre-selected", func() {
+		selectDialog.SetContainers(podContainers, "cnt02id")
+		Expect(selectDialog.items).To(HaveLen(2))
+
+		cntID, cntName := selectDialog.GetSelectedContainer()
+		Expect(cntID).To(Equal("cnt02id"))
+		Expect(cntName).To(Equal("cnt02"))
+		Expect(selectDialog.table.GetCell(2, podContainerDefaultColIndex).Text).To(Equal(podContainerDefaultMark))
+	})
+
+	It("show infra container", func() {
+		selectDialog.SetContainers(podContainers, "cnt01id")
+		selectDialog.showInfra.SetChecked(true)
+		selectDialog.setTableItems()
+		Expect(selectDialog.items).To(HaveLen(3))
+		Expect(selectDialog.table.GetCell(1, podContainerNameColIndex).Text).To(Equal("pod01-infra (infra)"))
+
+		cntID, _ := selectDialog.GetSelectedContainer()
+		Expect(cntID).To(Equal("cnt01id"))
+
+		selectDialog.showInfra.SetChecked(false)
+		selectDialog.setTableItems()
+		Expect(selectDialog.items).To(HaveLen(2))
+	})
+
+	It("select dispatch", func() {
+		selected := ""
+		selectDialog.SetSelectFunc(func() {
+			selected, _ = selectDialog.GetSelectedContainer()
+		})
+
+		selectDialog.SetContainers(podContainers, "cnt01id")
+		selectDialog.focusElement = podContainerSelectTableFocus
+		selectDialogApp.SetFocus(selectDialog)
+		selectDialogApp.Draw()
+
+		selectDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone))
+		selectDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		Eventually(func() string { return selected }).Should(Equal("cnt02id"))
+	})
+
+	It("no select dispatch without containers", func() {
+		called := false
+		selectDialog.SetSelectFunc(func() {
+			called = true
+		})
+
+		selectDialog.SetContainers(nil, "")
+		selectDialogApp.SetFocus(selectDialog)
+		selectDialogApp.Draw()
+
+		selectDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		selectDialogApp.Draw()
+		Consistently(func() bool { return called }).Should(BeFalse())
+
+		cntID, cntName := selectDialog.GetSelectedContainer()
+		Expect(cntID).To(Equal(""))
+		Expect(cntName).To(Equal(""))
+	})
+
+	It("cancel", func() {
+		cancelled := false
+		selectDialog.SetCancelFunc(func() {
+			cancelled = true
+		})
+
+		selectDialogApp.QueueEvent(tcell.NewEventKey(utils.CloseDialogKey.Key, 0, tcell.ModNone))
+		Eventually(func() bool { return cancelled }).Should(BeTrue())
+	})
+
+	It("hide", func() {
+		selectDialog.SetContainers(podContainers, "cnt01id")
+		selectDialog.Hide()
+		Expect(selectDialog.IsDisplay()).To(Equal(false))
+		Expect(selectDialog.podInfo.GetText()).To(Equal(""))
+		Expect(selectDialog.items).To(BeEmpty())
+	})
+
+	AfterAll(func() {
+		selectDialogApp.Stop()
+	})
+})
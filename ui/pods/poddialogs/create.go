@@ -2,6 +2,7 @@ package poddialogs
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/containers/podman-tui/pdcs/networks"
@@ -130,6 +131,8 @@ type PodCreateDialog struct {
 	podNamespaceSubgidNameField *tview.InputField
 	cancelHandler               func()
 	createHandler               func()
+	presetsHandler              func()
+	savePresetHandler           func()
 }
 
 // NewPodCreateDialog returns new pod create dialog primitive PodCreateDialog.
@@ -211,6 +214,8 @@ func NewPodCreateDialog() *PodCreateDialog {
 
 	// form
 	podDialog.form.SetBackgroundColor(style.DialogBgColor)
+	podDialog.form.AddButton("Presets", nil)
+	podDialog.form.AddButton("Save preset", nil)
 	podDialog.form.AddButton("Cancel", nil)
 	podDialog.form.AddButton("Create", nil)
 	podDialog.form.SetButtonsAlign(tview.AlignRight)
@@ -234,6 +239,7 @@ func NewPodCreateDialog() *PodCreateDialog {
 func (d *PodCreateDialog) Display() {
 	d.display = true
 	d.initData()
+	d.form.SetFocus(0)
 	d.focusElement = createPodCategoryPagesFocus
 }
 
@@ -552,6 +558,105 @@ func (d *PodCreateDialog) SetCreateFunc(handler func()) *PodCreateDialog {
 	return d
 }
 
+// SetSavePresetFunc sets form save preset button selected function.
+func (d *PodCreateDialog) SetSavePresetFunc(handler func()) *PodCreateDialog {
+	d.savePresetHandler = handler
+	savePresetButton := d.form.GetButton(d.form.GetButtonCount() - 3) //nolint:mnd
+
+	savePresetButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetPresetsFunc sets form presets button selected function.
+func (d *PodCreateDialog) SetPresetsFunc(handler func()) *PodCreateDialog {
+	d.presetsHandler = handler
+	presetsButton := d.form.GetButton(d.form.GetButtonCount() - 4) //nolint:mnd
+
+	presetsButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetPodSpec sets the dialog fields from the given pod create options.
+func (d *PodCreateDialog) SetPodSpec(opts pods.CreateOptions) { //nolint:cyclop
+	labels := make([]string, 0, len(opts.Labels))
+	for key, value := range opts.Labels {
+		labels = append(labels, key+"="+value)
+	}
+
+	slices.Sort(labels)
+
+	d.podNameField.SetText(opts.Name)
+	d.podNoHostsCheckBox.SetChecked(opts.NoHost)
+	d.podLabelsField.SetText(strings.Join(labels, " "))
+
+	// security options
+	var selinuxLabels []string
+
+	d.podApparmorField.SetText("")
+	d.podSeccompField.SetText("")
+	d.podMaskField.SetText("")
+	d.podUnmaskField.SetText("")
+	d.podNoNewPrivField.SetChecked(false)
+
+	for _, secOpt := range opts.SecurityOpts {
+		key, value, _ := strings.Cut(secOpt, "=")
+
+		switch key {
+		case "no-new-privileges":
+			d.podNoNewPrivField.SetChecked(true)
+		case "apparmor":
+			d.podApparmorField.SetText(value)
+		case "seccomp":
+			d.podSeccompField.SetText(value)
+		case "label":
+			selinuxLabels = append(selinuxLabels, value)
+		case "mask":
+			d.podMaskField.SetText(value)
+		case "unmask":
+			d.podUnmaskField.SetText(value)
+		}
+	}
+
+	d.podSelinuxLabelField.SetText(strings.Join(selinuxLabels, " "))
+
+	d.podDNSServerField.SetText(strings.Join(opts.DNSServer, " "))
+	d.podDNSOptionsField.SetText(strings.Join(opts.DNSOptions, " "))
+	d.podDNSSearchDomaindField.SetText(strings.Join(opts.DNSSearchDomain, " "))
+
+	d.podInfraCheckBox.SetChecked(opts.Infra)
+	d.podInfraCommandField.SetText(opts.InfraCommand)
+	d.podInfraImageField.SetText(opts.InfraImage)
+
+	d.podHostnameField.SetText(opts.Hostname)
+	d.podIPAddressField.SetText(opts.IPAddress)
+	d.podMacAddressField.SetText(opts.MacAddress)
+	d.podAddHostField.SetText(strings.Join(opts.AddHost, " "))
+
+	utils.SelectDropDownOption(d.podNetworkField, opts.Network)
+	d.podPublishField.SetText(strings.Join(opts.Publish, " "))
+
+	d.podMemoryField.SetText(opts.Memory)
+	d.podMemorySwapField.SetText(opts.MemorySwap)
+	d.podCPUsField.SetText(opts.CPUs)
+	d.podCPUSharesField.SetText(opts.CPUShares)
+	d.podCPUSetCPUsField.SetText(opts.CPUSetCPUs)
+	d.podCPUSetMemsField.SetText(opts.CPUSetMems)
+	d.podShmSizeField.SetText(opts.ShmSize)
+	d.podShmSizeSystemdField.SetText(opts.ShmSizeSystemd)
+
+	// namespace
+	d.podNamespaceShareField.SetText(strings.Join(opts.NamespaceShare, " "))
+	d.podNamespacePidField.SetText(opts.NamespacePid)
+	d.podNamespaceUserField.SetText(opts.NamespaceUser)
+	d.podNamespaceUtsField.SetText(opts.NamespaceUts)
+	d.podNamespaceUidmapField.SetText(opts.NamespaceUidmap)
+	d.podNamespaceSubuidNameField.SetText(opts.NamespaceSubuidName)
+	d.podNamespaceGidmapField.SetText(opts.NamespaceGidmap)
+	d.podNamespaceSubgidNameField.SetText(opts.NamespaceSubgidName)
+}
+
 // GetPodSpec returns pod create option spec.
 func (d *PodCreateDialog) GetPodSpec() pods.CreateOptions { //nolint:gocognit,cyclop
 	var (
@@ -0,0 +1,13 @@
+package poddialogs_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPoddialogs(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Pods Dialogs Suite")
+}
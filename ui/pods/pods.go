@@ -6,6 +6,8 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/containers/podman-tui/ui/containers/cntdialogs"
+	"github.com/containers/podman-tui/ui/containers/cntdialogs/vterm"
 	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/containers/podman-tui/ui/pods/poddialogs"
 	"github.com/containers/podman-tui/ui/style"
@@ -34,6 +36,10 @@ var (
 	errNoPodKill    = errors.New("there is no pod to kill")
 	errNoPodInspect = errors.New("there is no pod to display inspect")
 	errNoPodStat    = errors.New("there is no pod to display stats")
+	errNoPodExec    = errors.New("there is no pod to perform exec")
+	errNoPodAttach  = errors.New("there is no pod to attach")
+	errNoPodCnt     = errors.New("there is no container in the pod")
+	errNoPodPresets = errors.New("there is no saved pod preset")
 	errPodRemove    = errors.New("remove error")
 	errPodPrune     = errors.New("prune error")
 )
@@ -47,18 +53,32 @@ type Pods struct {
 	title           string
 	headers         []string
 	table           *tview.Table
+	detailsPanel    *dialogs.DetailsPanel
 	errorDialog     *dialogs.ErrorDialog
 	progressDialog  *dialogs.ProgressDialog
 	confirmDialog   *dialogs.ConfirmDialog
 	cmdDialog       *dialogs.CommandDialog
+	cmdInputDialog  *dialogs.SimpleInputDialog
+	presetsDialog   *dialogs.PresetsDialog
 	messageDialog   *dialogs.MessageDialog
+	inspectDialog   *dialogs.InspectDialog
 	topDialog       *dialogs.TopDialog
 	sortDialog      *dialogs.SortDialog
 	createDialog    *poddialogs.PodCreateDialog
 	statsDialog     *poddialogs.PodStatsDialog
+	cntSelectDialog *poddialogs.PodContainerSelectDialog
+	execDialog      *cntdialogs.ContainerExecDialog
+	terminalDialog  *vterm.VtermDialog
+	execSessions    *vterm.VtermSessionsDialog
+	pruneDialog     *dialogs.PruneDialog
 	podsList        podsListReport
 	selectedID      string
 	confirmData     string
+	cntSelectAction string
+	selectedCntID   string
+	selectedCntName string
+	presetName      string
+	fastRefreshChan chan bool
 	appFocusHandler func()
 }
 
@@ -70,7 +90,8 @@ type podsListReport struct {
 }
 
 // NewPods returns pods page view.
-func NewPods() *Pods {
+// The container exec sessions are shared with the containers page.
+func NewPods(execSessions *vterm.VtermSessionsDialog) *Pods {
 	sortHeaderItems := []string{
 		UIViewHeaders[viewPodNameColIndex],
 		UIViewHeaders[viewPodCreatedColIndex],
@@ -79,24 +100,35 @@ func NewPods() *Pods {
 	}
 
 	pods := &Pods{
-		Box:            tview.NewBox(),
-		title:          "pods",
-		headers:        UIViewHeaders,
-		errorDialog:    dialogs.NewErrorDialog(),
-		confirmDialog:  dialogs.NewConfirmDialog(),
-		progressDialog: dialogs.NewProgressDialog(),
-		messageDialog:  dialogs.NewMessageDialog(""),
-		topDialog:      dialogs.NewTopDialog(),
-		sortDialog:     dialogs.NewSortDialog(sortHeaderItems, 1),
-		createDialog:   poddialogs.NewPodCreateDialog(),
-		statsDialog:    poddialogs.NewPodStatsDialog(),
-		podsList:       podsListReport{sortBy: UIViewHeaders[viewPodNameColIndex], ascending: true},
+		Box:             tview.NewBox(),
+		title:           "pods",
+		headers:         UIViewHeaders,
+		errorDialog:     dialogs.NewErrorDialog(),
+		detailsPanel:    dialogs.NewDetailsPanel(),
+		confirmDialog:   dialogs.NewConfirmDialog(),
+		progressDialog:  dialogs.NewProgressDialog(),
+		messageDialog:   dialogs.NewMessageDialog(""),
+		inspectDialog:   dialogs.NewInspectDialog(),
+		cmdInputDialog:  dialogs.NewSimpleInputDialog(""),
+		presetsDialog:   dialogs.NewPresetsDialog(),
+		topDialog:       dialogs.NewTopDialog(),
+		sortDialog:      dialogs.NewSortDialog(sortHeaderItems, 1),
+		createDialog:    poddialogs.NewPodCreateDialog(),
+		statsDialog:     poddialogs.NewPodStatsDialog(),
+		cntSelectDialog: poddialogs.NewPodContainerSelectDialog(),
+		execDialog:      cntdialogs.NewContainerExecDialog(),
+		terminalDialog:  vterm.NewVtermDialog(),
+		execSessions:    execSessions,
+		pruneDialog:     dialogs.NewPruneDialog(false, false),
+		podsList:        podsListReport{sortBy: UIViewHeaders[viewPodNameColIndex], ascending: true},
 	}
 
 	pods.topDialog.SetTitle("podman pod top")
 
 	pods.cmdDialog = dialogs.NewCommandDialog([][]string{
+		{"attach", "attach to a running container of the selected pod"},
 		{"create", "create a new pod"},
+		{"exec", "execute a command inside a running container of the selected pod"},
 		{"inspect", "display information describing the selected pod"},
 		{"kill", "send SIGTERM signal to containers in the pod"},
 		{"pause", "pause  the selected pod"},
@@ -144,6 +176,11 @@ func NewPods() *Pods {
 		pods.messageDialog.Hide()
 	})
 
+	// set inspect dialog functions
+	pods.inspectDialog.SetCancelFunc(func() {
+		pods.inspectDialog.Hide()
+	})
+
 	// set top dialog functions
 	pods.topDialog.SetCancelFunc(func() {
 		pods.topDialog.Hide()
@@ -153,10 +190,7 @@ func NewPods() *Pods {
 	pods.confirmDialog.SetSelectedFunc(func() {
 		pods.confirmDialog.Hide()
 
-		switch pods.confirmData {
-		case utils.PruneCommandLabel:
-			pods.prune()
-		case "rm":
+		if pods.confirmData == "rm" {
 			pods.remove()
 		}
 	})
@@ -175,13 +209,48 @@ func NewPods() *Pods {
 		pods.create()
 	})
 
+	pods.createDialog.SetPresetsFunc(pods.presets)
+	pods.createDialog.SetSavePresetFunc(pods.savePreset)
+
+	// set presets dialog functions
+	pods.presetsDialog.SetLoadFunc(pods.loadPreset)
+	pods.presetsDialog.SetDeleteFunc(pods.deletePreset)
+	pods.presetsDialog.SetCancelFunc(pods.presetsDialog.Hide)
+
+	// set input cmd dialog functions
+	pods.cmdInputDialog.SetCancelFunc(pods.cmdInputDialog.Hide)
+	pods.cmdInputDialog.SetSelectedFunc(pods.cmdInputDialog.Hide)
+
 	// set stats dialog functions
 	pods.statsDialog.SetDoneFunc(pods.statsDialog.Hide)
 
+	// set container select dialog functions
+	pods.cntSelectDialog.SetCancelFunc(pods.cntSelectDialog.Hide)
+	pods.cntSelectDialog.SetSelectFunc(pods.containerSelected)
+
+	// set exec dialog functions
+	pods.execDialog.SetCancelFunc(pods.execDialog.Hide)
+	pods.execDialog.SetExecFunc(pods.exec)
+
+	// terminal dialogs
+	pods.terminalDialog.SetCancelFunc(pods.terminalDialog.Hide)
+	pods.terminalDialog.SetFastRefreshHandler(func() {
+		pods.fastRefreshChan <- true
+	})
+
 	// set sort dialog functions
 	pods.sortDialog.SetCancelFunc(pods.sortDialog.Hide)
+
+	// set prune dialog functions
+	pods.pruneDialog.SetTitle("podman pod prune")
+	pods.pruneDialog.SetCancelFunc(pods.pruneDialog.Hide)
+	pods.pruneDialog.SetPreviewFunc(pods.prunePreview)
+	pods.pruneDialog.SetPruneFunc(pods.prune)
 	pods.sortDialog.SetSelectFunc(pods.SortView)
 
+	// set details panel functions
+	pods.initDetailsPanel()
+
 	return pods
 }
 
@@ -190,6 +259,11 @@ func (pods *Pods) SetAppFocusHandler(handler func()) {
 	pods.appFocusHandler = handler
 }
 
+// SetFastRefreshChannel sets channel for fastRefresh func.
+func (pods *Pods) SetFastRefreshChannel(refresh chan bool) {
+	pods.fastRefreshChan = refresh
+}
+
 // GetTitle returns primitive title.
 func (pods *Pods) GetTitle() string {
 	return pods.title
@@ -217,6 +291,22 @@ func (pods *Pods) HasFocus() bool { //nolint:cyclop
 		return true
 	}
 
+	if pods.cntSelectDialog.HasFocus() || pods.execDialog.HasFocus() {
+		return true
+	}
+
+	if pods.terminalDialog.HasFocus() || pods.execSessions.HasFocus() {
+		return true
+	}
+
+	if pods.cmdInputDialog.HasFocus() || pods.presetsDialog.HasFocus() {
+		return true
+	}
+
+	if pods.pruneDialog.HasFocus() || pods.inspectDialog.HasFocus() {
+		return true
+	}
+
 	return pods.Box.HasFocus()
 }
 
@@ -238,6 +328,22 @@ func (pods *Pods) SubDialogHasFocus() bool {
 		return true
 	}
 
+	if pods.cntSelectDialog.HasFocus() || pods.execDialog.HasFocus() {
+		return true
+	}
+
+	if pods.terminalDialog.HasFocus() || pods.execSessions.HasFocus() {
+		return true
+	}
+
+	if pods.cmdInputDialog.HasFocus() || pods.presetsDialog.HasFocus() {
+		return true
+	}
+
+	if pods.pruneDialog.HasFocus() || pods.inspectDialog.HasFocus() {
+		return true
+	}
+
 	return pods.sortDialog.HasFocus()
 }
 
@@ -257,6 +363,20 @@ func (pods *Pods) Focus(delegate func(p tview.Primitive)) {
 		return
 	}
 
+	// command input dialog
+	if pods.cmdInputDialog.IsDisplay() {
+		delegate(pods.cmdInputDialog)
+
+		return
+	}
+
+	// presets dialog
+	if pods.presetsDialog.IsDisplay() {
+		delegate(pods.presetsDialog)
+
+		return
+	}
+
 	// message dialog
 	if pods.messageDialog.IsDisplay() {
 		delegate(pods.messageDialog)
@@ -264,6 +384,13 @@ func (pods *Pods) Focus(delegate func(p tview.Primitive)) {
 		return
 	}
 
+	// inspect dialog
+	if pods.inspectDialog.IsDisplay() {
+		delegate(pods.inspectDialog)
+
+		return
+	}
+
 	// top dialog
 	if pods.topDialog.IsDisplay() {
 		delegate(pods.topDialog)
@@ -278,6 +405,13 @@ func (pods *Pods) Focus(delegate func(p tview.Primitive)) {
 		return
 	}
 
+	// prune dialog
+	if pods.pruneDialog.IsDisplay() {
+		delegate(pods.pruneDialog)
+
+		return
+	}
+
 	// create dialog
 	if pods.createDialog.IsDisplay() {
 		delegate(pods.createDialog)
@@ -299,6 +433,34 @@ func (pods *Pods) Focus(delegate func(p tview.Primitive)) {
 		return
 	}
 
+	// container select dialog
+	if pods.cntSelectDialog.IsDisplay() {
+		delegate(pods.cntSelectDialog)
+
+		return
+	}
+
+	// exec dialog
+	if pods.execDialog.IsDisplay() {
+		delegate(pods.execDialog)
+
+		return
+	}
+
+	// terminal dialog
+	if pods.terminalDialog.IsDisplay() {
+		delegate(pods.terminalDialog)
+
+		return
+	}
+
+	// exec terminal sessions dialog
+	if pods.execSessions.IsDisplay() {
+		delegate(pods.execSessions)
+
+		return
+	}
+
 	delegate(pods.table)
 }
 
@@ -320,10 +482,22 @@ func (pods *Pods) HideAllDialogs() {
 		pods.cmdDialog.Hide()
 	}
 
+	if pods.cmdInputDialog.IsDisplay() {
+		pods.cmdInputDialog.Hide()
+	}
+
+	if pods.presetsDialog.IsDisplay() {
+		pods.presetsDialog.Hide()
+	}
+
 	if pods.messageDialog.IsDisplay() {
 		pods.messageDialog.Hide()
 	}
 
+	if pods.inspectDialog.IsDisplay() {
+		pods.inspectDialog.Hide()
+	}
+
 	if pods.topDialog.IsDisplay() {
 		pods.topDialog.Hide()
 	}
@@ -339,6 +513,22 @@ func (pods *Pods) HideAllDialogs() {
 	if pods.sortDialog.IsDisplay() {
 		pods.sortDialog.Hide()
 	}
+
+	if pods.cntSelectDialog.IsDisplay() {
+		pods.cntSelectDialog.Hide()
+	}
+
+	if pods.execDialog.IsDisplay() {
+		pods.execDialog.Hide()
+	}
+
+	if pods.terminalDialog.IsDisplay() {
+		pods.terminalDialog.Hide()
+	}
+
+	if pods.pruneDialog.IsDisplay() {
+		pods.pruneDialog.Hide()
+	}
 }
 
 func (pods *Pods) getSelectedItem() (string, string) {
@@ -358,6 +548,24 @@ func (pods *Pods) getSelectedItem() (string, string) {
 	return id, name
 }
 
+// GetSelectedItemID returns the selected pod ID.
+func (pods *Pods) GetSelectedItemID() string {
+	id, _ := pods.getSelectedItem()
+
+	return id
+}
+
+// SelectItem selects the pod by its ID or name.
+func (pods *Pods) SelectItem(id string) {
+	for i, pod := range pods.getData() {
+		if utils.MatchIDOrName(id, pod.Id, pod.Name) {
+			pods.table.Select(i+1, 0)
+
+			return
+		}
+	}
+}
+
 func (pods *Pods) getAllItemsForStats() []poddialogs.PodStatsDropDownOptions {
 	var items []poddialogs.PodStatsDropDownOptions
 
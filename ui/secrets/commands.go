@@ -1,12 +1,16 @@
 package secrets
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/containers/podman-tui/pdcs/containers"
 	"github.com/containers/podman-tui/pdcs/secrets"
+	putils "github.com/containers/podman-tui/pdcs/utils"
 	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
 	"github.com/rs/zerolog/log"
 )
 
@@ -16,8 +20,14 @@ func (s *Secrets) runCommand(cmd string) {
 		s.createDialog.Display()
 	case "inspect":
 		s.inspect()
+	case "reveal":
+		s.creveal()
 	case "rm":
 		s.rm()
+	case "update":
+		s.cupdate()
+	case "used by":
+		s.usedBy()
 	}
 }
 
@@ -59,7 +69,7 @@ func (s *Secrets) inspect() {
 		return
 	}
 
-	data, err := secrets.Inspect(secID)
+	data, err := secrets.Inspect(secID, false)
 	if err != nil {
 		title := fmt.Sprintf("SECRET (%s) INSPECT ERROR", secID)
 		s.displayError(title, err)
@@ -69,9 +79,9 @@ func (s *Secrets) inspect() {
 
 	headerLabel := fmt.Sprintf("%s (%s)", secID, secName)
 
-	s.messageDialog.SetTitle("podman secret inspect")
-	s.messageDialog.SetText(dialogs.MessageSecretInfo, headerLabel, data)
-	s.messageDialog.DisplayFullSize()
+	s.inspectDialog.SetTitle("podman secret inspect")
+	s.inspectDialog.SetData(dialogs.MessageSecretInfo, headerLabel, data)
+	s.inspectDialog.Display()
 }
 
 func (s *Secrets) rm() {
@@ -82,6 +92,7 @@ func (s *Secrets) rm() {
 		return
 	}
 
+	s.confirmData = "rm"
 	s.confirmDialog.SetTitle("podman secret remove")
 
 	bgColor := style.GetColorHex(style.DialogBorderColor)
@@ -129,3 +140,163 @@ func (s *Secrets) remove() {
 
 	go remove(secID)
 }
+
+func (s *Secrets) creveal() {
+	_, secID, secName := s.getSelectedItem()
+	if secID == "" {
+		s.displayError("", errNoSecretReveal)
+
+		return
+	}
+
+	s.confirmData = "reveal"
+	s.confirmDialog.SetTitle("podman secret reveal")
+
+	bgColor := style.GetColorHex(style.DialogBorderColor)
+	fgColor := style.GetColorHex(style.DialogFgColor)
+	secretItem := fmt.Sprintf("[%s:%s:b]SECRET ID:[:-:-] %s (%s)", fgColor, bgColor, secID, secName)
+
+	description := fmt.Sprintf("%s\n\nAre you sure you want to display the selected secret value?", //nolint:perfsprint
+		secretItem)
+	s.confirmDialog.SetText(description)
+	s.confirmDialog.Display()
+}
+
+func (s *Secrets) reveal() {
+	_, secID, secName := s.getSelectedItem()
+	if secID == "" {
+		s.displayError("", errNoSecretReveal)
+
+		return
+	}
+
+	data, err := secrets.Inspect(secID, true)
+	if err != nil {
+		title := fmt.Sprintf("SECRET (%s) REVEAL ERROR", secID)
+		s.displayError(title, err)
+
+		return
+	}
+
+	headerLabel := fmt.Sprintf("%s (%s)", secID, secName)
+
+	s.inspectDialog.SetTitle("podman secret inspect --showsecret")
+	s.inspectDialog.SetData(dialogs.MessageSecretInfo, headerLabel, data)
+	s.inspectDialog.Display()
+}
+
+func (s *Secrets) cupdate() {
+	_, secID, secName := s.getSelectedItem()
+	if secID == "" {
+		s.displayError("", errNoSecretUpdate)
+
+		return
+	}
+
+	s.updateDialog.SetSecretInfo(secID, secName)
+	s.updateDialog.Display()
+}
+
+func (s *Secrets) update() {
+	secID, updateOpts := s.updateDialog.GetUpdateOptions()
+
+	if updateOpts.File != "" && updateOpts.Text != "" {
+		s.displayError("SECRET UPDATE ERROR", errSecretFileAndText)
+
+		return
+	}
+
+	if updateOpts.File == "" && updateOpts.Text == "" {
+		s.displayError("SECRET UPDATE ERROR", errEmptySecretFileOrText)
+
+		return
+	}
+
+	s.updateDialog.Hide()
+
+	err := secrets.Update(secID, updateOpts)
+	if err != nil {
+		title := fmt.Sprintf("SECRET (%s) UPDATE ERROR", secID)
+		s.displayError(title, err)
+	}
+
+	s.UpdateData()
+}
+
+func (s *Secrets) usedBy() {
+	_, secID, secName := s.getSelectedItem()
+	if secID == "" {
+		s.displayError("", errNoSecretUsedBy)
+
+		return
+	}
+
+	s.progressDialog.SetTitle("podman secret used by")
+	s.progressDialog.Display()
+
+	usedBy := func() {
+		report, err := secrets.UsedBy(secID)
+
+		s.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("SECRET (%s) USED BY ERROR", secID)
+			s.displayError(title, err)
+			s.appFocusHandler()
+
+			return
+		}
+
+		s.usedByDialog.SetSecretInfo(secID, secName)
+		s.usedByDialog.SetContainers(report)
+		s.usedByDialog.Display()
+		s.appFocusHandler()
+	}
+
+	go usedBy()
+}
+
+func (s *Secrets) goTo() {
+	cntID := s.usedByDialog.GetCurrentContainer()
+
+	s.usedByDialog.Hide()
+
+	if cntID == "" || s.navigateHandler == nil {
+		return
+	}
+
+	s.navigateHandler(putils.ResourceKindContainer, cntID)
+}
+
+func (s *Secrets) restart() {
+	cntIDs := s.usedByDialog.GetSelectedContainers()
+	if len(cntIDs) == 0 {
+		s.displayError("SECRET CONTAINER RESTART ERROR", errNoContainerRestart)
+
+		return
+	}
+
+	s.usedByDialog.Hide()
+	s.progressDialog.SetTitle("container restart in progress")
+	s.progressDialog.Display()
+
+	restart := func() {
+		var errList []error
+
+		for _, cntID := range cntIDs {
+			if err := containers.Restart(cntID); err != nil {
+				errList = append(errList, fmt.Errorf("%s: %w", utils.GetIDWithLimit(cntID), err))
+			}
+		}
+
+		s.progressDialog.Hide()
+
+		if len(errList) > 0 {
+			s.displayError("SECRET CONTAINER RESTART ERROR", errors.Join(errList...))
+		}
+
+		s.appFocusHandler()
+	}
+
+	go restart()
+}
@@ -27,6 +27,8 @@ func (s *Secrets) SortView(option string, ascending bool) {
 
 // UpdateData retrieves secrets list data.
 func (s *Secrets) UpdateData() {
+	s.detailsPanel.Invalidate()
+
 	secResponse, err := secrets.List()
 	if err != nil {
 		log.Error().Msgf("view: secrets update %v", err)
@@ -0,0 +1,27 @@
+package secrets
+
+import (
+	"github.com/containers/podman-tui/pdcs/secrets"
+)
+
+// initDetailsPanel sets details panel title, its data loader and reloads
+// the details whenever the table selected item changes.
+func (s *Secrets) initDetailsPanel() {
+	s.detailsPanel.SetTitle("secret details")
+	s.detailsPanel.SetLoadFunc(secrets.Details)
+	s.detailsPanel.SetSelectedItemFunc(s.selectedItemID)
+	s.detailsPanel.SetRefreshFunc(func() {
+		if s.fastRefreshChan != nil {
+			s.fastRefreshChan <- true
+		}
+	})
+	s.table.SetSelectionChangedFunc(func(_, _ int) {
+		s.detailsPanel.Update(s.selectedItemID())
+	})
+}
+
+func (s *Secrets) selectedItemID() string {
+	_, id, _ := s.getSelectedItem()
+
+	return id
+}
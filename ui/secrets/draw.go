@@ -9,11 +9,15 @@ func (s *Secrets) Draw(screen tcell.Screen) {
 
 	x, y, w, h := s.GetInnerRect()
 
-	s.table.SetRect(x, y, w, h)
-	s.refresh(w)
+	tableX, tableY, tableW, tableH := s.detailsPanel.Split(x, y, w, h)
+
+	s.table.SetRect(tableX, tableY, tableW, tableH)
+	s.refresh(tableW)
 	s.table.SetBorder(true)
 	s.table.Draw(screen)
 
+	s.detailsPanel.Draw(screen)
+
 	for _, dialog := range s.getInnerDialogs() {
 		if dialog.IsDisplay() {
 			dialog.SetRect(x, y, w, h)
@@ -45,6 +45,13 @@ func (s *Secrets) InputHandler() func(event *tcell.EventKey, setFocus func(p tvi
 				return
 			}
 
+			// toggle details panel
+			if event.Rune() == utils.DetailsPanelKey.Rune() {
+				s.detailsPanel.Toggle()
+
+				return
+			}
+
 			if event.Key() == utils.DeleteKey.EventKey() {
 				s.rm()
 				setFocus(s)
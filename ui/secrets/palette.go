@@ -0,0 +1,29 @@
+package secrets
+
+import putils "github.com/containers/podman-tui/pdcs/utils"
+
+// GetCommands returns the secrets screen commands and their descriptions.
+func (s *Secrets) GetCommands() [][]string {
+	return s.cmdDialog.GetCommands()
+}
+
+// RunCommand runs the secrets screen command on the selected item.
+func (s *Secrets) RunCommand(cmd string) {
+	s.runCommand(cmd)
+}
+
+// GetResources returns the secrets listed on the screen.
+func (s *Secrets) GetResources() []putils.ResourceReference {
+	data := s.getData()
+	resources := make([]putils.ResourceReference, 0, len(data))
+
+	for _, item := range data {
+		resources = append(resources, putils.ResourceReference{
+			Kind: putils.ResourceKindSecret,
+			ID:   item.ID,
+			Name: item.Spec.Name,
+		})
+	}
+
+	return resources
+}
@@ -0,0 +1,291 @@
+package secdialogs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/secrets"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	secretUpdateDialogMaxWidth  = 80
+	secretUpdateDialogMaxHeight = 11
+)
+
+const (
+	secretUpdateFileFocus = 0 + iota
+	secretUpdateTextFocus
+	secretUpdateFormFocus
+)
+
+// SecretUpdateDialog implements secret update (replace) dialog.
+type SecretUpdateDialog struct {
+	*tview.Box
+
+	layout        *tview.Flex
+	form          *tview.Form
+	secretInfo    *tview.InputField
+	secretFile    *tview.InputField
+	secretText    *tview.InputField
+	display       bool
+	focusElement  int
+	secretID      string
+	updateHandler func()
+	cancelHandler func()
+}
+
+// NewSecretUpdateDialog returns new secret update dialog primitive.
+func NewSecretUpdateDialog() *SecretUpdateDialog {
+	updateDialog := &SecretUpdateDialog{
+		Box:          tview.NewBox(),
+		layout:       tview.NewFlex().SetDirection(tview.FlexColumn),
+		form:         tview.NewForm(),
+		secretInfo:   tview.NewInputField(),
+		secretFile:   tview.NewInputField(),
+		secretText:   tview.NewInputField(),
+		display:      false,
+		focusElement: secretUpdateFileFocus,
+	}
+
+	bgColor := style.DialogBgColor
+
+	// secret info field
+	secretInfoLabel := "SECRET ID:"
+
+	updateDialog.secretInfo.SetBackgroundColor(bgColor)
+	updateDialog.secretInfo.SetLabel("[::b]" + secretInfoLabel)
+	updateDialog.secretInfo.SetLabelWidth(len(secretInfoLabel) + 1)
+	updateDialog.secretInfo.SetFieldBackgroundColor(bgColor)
+	updateDialog.secretInfo.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(style.DialogFgColor))
+
+	// secret file field
+	updateDialog.secretFile.SetBackgroundColor(bgColor)
+	updateDialog.secretFile.SetLabel(utils.StringToInputLabel("secret file:", labelWidth))
+	updateDialog.secretFile.SetFieldStyle(style.InputFieldStyle)
+	updateDialog.secretFile.SetLabelStyle(style.InputLabelStyle)
+
+	// secret text field
+	updateDialog.secretText.SetBackgroundColor(bgColor)
+	updateDialog.secretText.SetLabel(utils.StringToInputLabel("secret text:", labelWidth))
+	updateDialog.secretText.SetFieldStyle(style.InputFieldStyle)
+	updateDialog.secretText.SetLabelStyle(style.InputLabelStyle)
+	updateDialog.secretText.SetMaskCharacter('*')
+
+	// form
+	updateDialog.form.AddButton("Cancel", nil)
+	updateDialog.form.AddButton("Update", nil)
+	updateDialog.form.SetButtonsAlign(tview.AlignRight)
+	updateDialog.form.SetBackgroundColor(bgColor)
+	updateDialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	optionsLayout := tview.NewFlex().SetDirection(tview.FlexRow)
+
+	optionsLayout.SetBackgroundColor(bgColor)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(updateDialog.secretInfo, 1, 0, false)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(updateDialog.secretFile, 1, 0, true)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(updateDialog.secretText, 1, 0, true)
+	optionsLayout.AddItem(updateDialog.form, dialogs.DialogFormHeight, 0, true)
+
+	updateDialog.layout.SetBackgroundColor(bgColor)
+	updateDialog.layout.SetBorder(true)
+	updateDialog.layout.SetBorderColor(style.DialogBorderColor)
+	updateDialog.layout.SetTitle("PODMAN SECRET UPDATE")
+	updateDialog.layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	updateDialog.layout.AddItem(optionsLayout, 0, 1, true)
+	updateDialog.layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	return updateDialog
+}
+
+// Display displays this primitive.
+func (d *SecretUpdateDialog) Display() {
+	d.display = true
+	d.focusElement = secretUpdateFileFocus
+
+	d.secretFile.SetText("")
+	d.secretText.SetText("")
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *SecretUpdateDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *SecretUpdateDialog) Hide() {
+	d.display = false
+	d.secretID = ""
+
+	d.secretInfo.SetText("")
+	d.secretFile.SetText("")
+	d.secretText.SetText("")
+}
+
+// SetRect set rects for this primitive.
+func (d *SecretUpdateDialog) SetRect(x, y, width, height int) {
+	if width > secretUpdateDialogMaxWidth {
+		emptySpace := (width - secretUpdateDialogMaxWidth) / 2 //nolint:mnd
+		x += emptySpace
+		width = secretUpdateDialogMaxWidth
+	}
+
+	if height > secretUpdateDialogMaxHeight {
+		emptySpace := (height - secretUpdateDialogMaxHeight) / 2 //nolint:mnd
+		y += emptySpace
+		height = secretUpdateDialogMaxHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *SecretUpdateDialog) HasFocus() bool {
+	if d.layout.HasFocus() || d.form.HasFocus() {
+		return true
+	}
+
+	if d.secretFile.HasFocus() || d.secretText.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *SecretUpdateDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case secretUpdateFileFocus:
+		delegate(d.secretFile)
+	case secretUpdateTextFocus:
+		delegate(d.secretText)
+	case secretUpdateFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = secretUpdateFileFocus
+
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// Draw draws this primitive into the screen.
+func (d *SecretUpdateDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.DrawForSubclass(screen, d)
+
+	x, y, width, height := d.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *SecretUpdateDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("secret update dialog: event %v received", event)
+
+		if event.Key() == utils.CloseDialogKey.Key {
+			d.cancelHandler()
+
+			return
+		}
+
+		if event.Key() == utils.SwitchFocusKey.Key {
+			d.setFocusElement()
+		}
+
+		if d.secretFile.HasFocus() {
+			if fileHandler := d.secretFile.InputHandler(); fileHandler != nil {
+				fileHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.secretText.HasFocus() {
+			if textHandler := d.secretText.InputHandler(); textHandler != nil {
+				textHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+// SetUpdateFunc sets form update button selected function.
+func (d *SecretUpdateDialog) SetUpdateFunc(handler func()) *SecretUpdateDialog {
+	d.updateHandler = handler
+	updateButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+	updateButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *SecretUpdateDialog) SetCancelFunc(handler func()) *SecretUpdateDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 2) //nolint:mnd
+
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetSecretInfo sets selected secret information in update dialog.
+func (d *SecretUpdateDialog) SetSecretInfo(id string, name string) {
+	d.secretID = id
+
+	d.secretInfo.SetText(fmt.Sprintf("%s (%s)", id, name))
+}
+
+// GetUpdateOptions returns secret ID and update options.
+func (d *SecretUpdateDialog) GetUpdateOptions() (string, *secrets.SecretUpdateOptions) {
+	var updateOptions secrets.SecretUpdateOptions
+
+	updateOptions.File = strings.TrimSpace(d.secretFile.GetText())
+	updateOptions.Text = d.secretText.GetText()
+
+	return d.secretID, &updateOptions
+}
+
+func (d *SecretUpdateDialog) setFocusElement() {
+	switch d.focusElement {
+	case secretUpdateFileFocus:
+		d.focusElement = secretUpdateTextFocus
+	case secretUpdateTextFocus:
+		d.focusElement = secretUpdateFormFocus
+	}
+}
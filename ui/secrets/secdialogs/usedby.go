@@ -0,0 +1,380 @@
+package secdialogs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/secrets"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	secretUsedByDialogMaxWidth  = 90
+	secretUsedByDialogMaxHeight = 20
+	secretUsedBySelectedMark    = "[x]"
+	secretUsedByUnselectedMark  = "[ ]"
+)
+
+const (
+	secretUsedByTableFocus = 0 + iota
+	secretUsedByFormFocus
+)
+
+const (
+	usedBySelectedColIndex = 0 + iota
+	usedByIDColIndex
+	usedByNameColIndex
+	usedByStateColIndex
+)
+
+// SecretUsedByDialog implements secret used by dialog.
+// It lists containers which reference the secret, restarts the selected ones
+// or switches to the current one.
+type SecretUsedByDialog struct {
+	*tview.Box
+
+	layout         *tview.Flex
+	form           *tview.Form
+	secretInfo     *tview.InputField
+	table          *tview.Table
+	display        bool
+	focusElement   int
+	tableHeaders   []string
+	containers     []secrets.SecretUsageReport
+	selected       map[string]bool
+	restartHandler func()
+	goToHandler    func()
+	cancelHandler  func()
+}
+
+// NewSecretUsedByDialog returns new secret used by dialog primitive.
+func NewSecretUsedByDialog() *SecretUsedByDialog {
+	usedByDialog := &SecretUsedByDialog{
+		Box:          tview.NewBox(),
+		layout:       tview.NewFlex().SetDirection(tview.FlexRow),
+		form:         tview.NewForm(),
+		secretInfo:   tview.NewInputField(),
+		table:        tview.NewTable(),
+		tableHeaders: []string{"", "container id", "name", "state"},
+		selected:     make(map[string]bool),
+		focusElement: secretUsedByTableFocus,
+	}
+
+	bgColor := style.DialogBgColor
+
+	// secret info field
+	secretInfoLabel := "SECRET ID:"
+
+	usedByDialog.secretInfo.SetBackgroundColor(bgColor)
+	usedByDialog.secretInfo.SetLabel("[::b]" + secretInfoLabel)
+	usedByDialog.secretInfo.SetLabelWidth(len(secretInfoLabel) + 1)
+	usedByDialog.secretInfo.SetFieldBackgroundColor(bgColor)
+	usedByDialog.secretInfo.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(style.DialogFgColor))
+
+	// containers table
+	usedByDialog.table.SetBackgroundColor(bgColor)
+	usedByDialog.table.SetBorder(true)
+	usedByDialog.table.SetBorderColor(style.DialogSubBoxBorderColor)
+	usedByDialog.table.SetSelectedFunc(func(row, _ int) {
+		usedByDialog.toggleContainer(row)
+	})
+	usedByDialog.initTable()
+
+	// form
+	usedByDialog.form.AddButton("Cancel", nil)
+	usedByDialog.form.AddButton("Go To", nil)
+	usedByDialog.form.AddButton("Restart", nil)
+	usedByDialog.form.SetButtonsAlign(tview.AlignRight)
+	usedByDialog.form.SetBackgroundColor(bgColor)
+	usedByDialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	optionsLayout := tview.NewFlex().SetDirection(tview.FlexRow)
+
+	optionsLayout.SetBackgroundColor(bgColor)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(usedByDialog.secretInfo, 1, 0, false)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(usedByDialog.table, 0, 1, true)
+
+	mainOptsLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+
+	mainOptsLayout.SetBackgroundColor(bgColor)
+	mainOptsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainOptsLayout.AddItem(optionsLayout, 0, 1, true)
+	mainOptsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	usedByDialog.layout.SetBackgroundColor(bgColor)
+	usedByDialog.layout.SetBorder(true)
+	usedByDialog.layout.SetBorderColor(style.DialogBorderColor)
+	usedByDialog.layout.SetTitle("PODMAN SECRET USED BY")
+	usedByDialog.layout.AddItem(mainOptsLayout, 0, 1, true)
+	usedByDialog.layout.AddItem(usedByDialog.form, dialogs.DialogFormHeight, 0, true)
+
+	return usedByDialog
+}
+
+// Display displays this primitive.
+func (d *SecretUsedByDialog) Display() {
+	d.display = true
+	d.focusElement = secretUsedByTableFocus
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *SecretUsedByDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *SecretUsedByDialog) Hide() {
+	d.display = false
+	d.focusElement = secretUsedByTableFocus
+
+	d.secretInfo.SetText("")
+	d.SetContainers(nil)
+}
+
+// SetRect set rects for this primitive.
+func (d *SecretUsedByDialog) SetRect(x, y, width, height int) {
+	if width > secretUsedByDialogMaxWidth {
+		emptySpace := (width - secretUsedByDialogMaxWidth) / 2 //nolint:mnd
+		x += emptySpace
+		width = secretUsedByDialogMaxWidth
+	}
+
+	if height > secretUsedByDialogMaxHeight {
+		emptySpace := (height - secretUsedByDialogMaxHeight) / 2 //nolint:mnd
+		y += emptySpace
+		height = secretUsedByDialogMaxHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *SecretUsedByDialog) HasFocus() bool {
+	if d.table.HasFocus() || d.form.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus() || d.layout.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *SecretUsedByDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case secretUsedByTableFocus:
+		delegate(d.table)
+	case secretUsedByFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = secretUsedByTableFocus
+
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// Draw draws this primitive into the screen.
+func (d *SecretUsedByDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.DrawForSubclass(screen, d)
+
+	x, y, width, height := d.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *SecretUsedByDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("secret used by dialog: event %v received", event)
+
+		if event.Key() == utils.CloseDialogKey.Key {
+			d.cancelHandler()
+
+			return
+		}
+
+		if event.Key() == utils.SwitchFocusKey.Key && d.table.HasFocus() {
+			d.focusElement = secretUsedByFormFocus
+
+			d.Focus(setFocus)
+
+			return
+		}
+
+		if d.table.HasFocus() {
+			if event.Rune() == ' ' {
+				row, _ := d.table.GetSelection()
+				d.toggleContainer(row)
+
+				return
+			}
+
+			if tableHandler := d.table.InputHandler(); tableHandler != nil {
+				tableHandler(utils.ParseKeyEventKey(event), setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+// SetRestartFunc sets form restart button selected function.
+func (d *SecretUsedByDialog) SetRestartFunc(handler func()) *SecretUsedByDialog {
+	d.restartHandler = handler
+	restartButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+	restartButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *SecretUsedByDialog) SetCancelFunc(handler func()) *SecretUsedByDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 3) //nolint:mnd
+
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetGoToFunc sets form go to button selected function.
+func (d *SecretUsedByDialog) SetGoToFunc(handler func()) *SecretUsedByDialog {
+	d.goToHandler = handler
+	goToButton := d.form.GetButton(d.form.GetButtonCount() - 2) //nolint:mnd
+
+	goToButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetSecretInfo sets selected secret information in used by dialog.
+func (d *SecretUsedByDialog) SetSecretInfo(id string, name string) {
+	d.secretInfo.SetText(fmt.Sprintf("%s (%s)", id, name))
+}
+
+// SetContainers sets list of containers which reference the secret.
+func (d *SecretUsedByDialog) SetContainers(report []secrets.SecretUsageReport) {
+	d.containers = report
+	d.selected = make(map[string]bool)
+
+	d.initTable()
+
+	for i, cnt := range report {
+		rowIndex := i + 1
+
+		d.table.SetCell(rowIndex, usedBySelectedColIndex,
+			tview.NewTableCell(secretUsedByUnselectedMark).
+				SetExpansion(0).
+				SetAlign(tview.AlignLeft))
+
+		d.table.SetCell(rowIndex, usedByIDColIndex,
+			tview.NewTableCell(utils.GetIDWithLimit(cnt.ContainerID)).
+				SetExpansion(1).
+				SetAlign(tview.AlignLeft))
+
+		d.table.SetCell(rowIndex, usedByNameColIndex,
+			tview.NewTableCell(cnt.ContainerName).
+				SetExpansion(1).
+				SetAlign(tview.AlignLeft))
+
+		d.table.SetCell(rowIndex, usedByStateColIndex,
+			tview.NewTableCell(cnt.State).
+				SetExpansion(1).
+				SetAlign(tview.AlignLeft))
+	}
+
+	if len(report) > 0 {
+		d.table.Select(1, 0)
+		d.table.ScrollToBeginning()
+	}
+}
+
+// GetSelectedContainers returns list of selected container IDs.
+func (d *SecretUsedByDialog) GetSelectedContainers() []string {
+	selectedContainers := make([]string, 0)
+
+	for _, cnt := range d.containers {
+		if d.selected[cnt.ContainerID] {
+			selectedContainers = append(selectedContainers, cnt.ContainerID)
+		}
+	}
+
+	return selectedContainers
+}
+
+// GetCurrentContainer returns the container ID of the current table row.
+func (d *SecretUsedByDialog) GetCurrentContainer() string {
+	row, _ := d.table.GetSelection()
+	if row < 1 || row > len(d.containers) {
+		return ""
+	}
+
+	return d.containers[row-1].ContainerID
+}
+
+func (d *SecretUsedByDialog) toggleContainer(row int) {
+	if row < 1 || row > len(d.containers) {
+		return
+	}
+
+	cntID := d.containers[row-1].ContainerID
+	d.selected[cntID] = !d.selected[cntID]
+
+	mark := secretUsedByUnselectedMark
+	if d.selected[cntID] {
+		mark = secretUsedBySelectedMark
+	}
+
+	d.table.GetCell(row, usedBySelectedColIndex).SetText(mark)
+}
+
+func (d *SecretUsedByDialog) initTable() {
+	bgColor := style.TableHeaderBgColor
+	fgColor := style.TableHeaderFgColor
+
+	d.table.Clear()
+	d.table.SetFixed(1, 1)
+	d.table.SetSelectable(true, false)
+
+	for i := range d.tableHeaders {
+		d.table.SetCell(0, i,
+			tview.NewTableCell(fmt.Sprintf("[%s::b]%s", style.GetColorHex(fgColor), strings.ToUpper(d.tableHeaders[i]))).
+				SetExpansion(1).
+				SetBackgroundColor(bgColor).
+				SetTextColor(fgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
+	}
+}
@@ -25,6 +25,10 @@ const (
 var (
 	errNoSecretRemove        = errors.New("there is no secret to remove")
 	errNoSecretInspect       = errors.New("there is no secret to display inspect")
+	errNoSecretReveal        = errors.New("there is no secret to reveal")
+	errNoSecretUpdate        = errors.New("there is no secret to update")
+	errNoSecretUsedBy        = errors.New("there is no secret to display used by containers")
+	errNoContainerRestart    = errors.New("there is no container selected to restart")
 	errSecretFileAndText     = errors.New("cannot select secret file and secret text together")
 	errEmptySecretFileOrText = errors.New("secret content not provided")
 )
@@ -38,15 +42,22 @@ type Secrets struct {
 	title           string
 	headers         []string
 	table           *tview.Table
+	detailsPanel    *dialogs.DetailsPanel
 	cmdDialog       *dialogs.CommandDialog
 	messageDialog   *dialogs.MessageDialog
+	inspectDialog   *dialogs.InspectDialog
 	errorDialog     *dialogs.ErrorDialog
 	progressDialog  *dialogs.ProgressDialog
 	confirmDialog   *dialogs.ConfirmDialog
 	sortDialog      *dialogs.SortDialog
 	createDialog    *secdialogs.SecretCreateDialog
+	updateDialog    *secdialogs.SecretUpdateDialog
+	usedByDialog    *secdialogs.SecretUsedByDialog
 	secretList      secretListReport
+	confirmData     string
 	appFocusHandler func()
+	fastRefreshChan chan bool
+	navigateHandler func(kind string, id string)
 }
 
 type secretListReport struct {
@@ -71,18 +82,25 @@ func NewSecrets() *Secrets {
 		headers:        UIViewHeaders,
 		table:          tview.NewTable(),
 		messageDialog:  dialogs.NewMessageDialog(""),
+		inspectDialog:  dialogs.NewInspectDialog(),
 		errorDialog:    dialogs.NewErrorDialog(),
+		detailsPanel:   dialogs.NewDetailsPanel(),
 		progressDialog: dialogs.NewProgressDialog(),
 		confirmDialog:  dialogs.NewConfirmDialog(),
 		sortDialog:     dialogs.NewSortDialog(sortHeaderItems, 2), //nolint:mnd
 		createDialog:   secdialogs.NewSecretCreateDialog(),
+		updateDialog:   secdialogs.NewSecretUpdateDialog(),
+		usedByDialog:   secdialogs.NewSecretUsedByDialog(),
 		secretList:     secretListReport{sortBy: UIViewHeaders[viewSecretsNameColIndex], ascending: true},
 	}
 
 	secrets.cmdDialog = dialogs.NewCommandDialog([][]string{
 		{"create", "create a new secret"},
 		{"inspect", "inspect a secret"},
+		{"reveal", "inspect a secret and reveal its value"},
 		{"rm", "remove a secret"},
+		{"update", "replace a secret value"},
+		{"used by", "list containers which use a secret, restart or switch to them"},
 	})
 
 	secrets.table.SetTitle(fmt.Sprintf("[::b]%s[0]", strings.ToUpper(secrets.title)))
@@ -109,11 +127,21 @@ func NewSecrets() *Secrets {
 		secrets.messageDialog.Hide()
 	})
 
+	// set inspect dialog functions
+	secrets.inspectDialog.SetCancelFunc(func() {
+		secrets.inspectDialog.Hide()
+	})
+
 	// set confirm dialog functions
 	secrets.confirmDialog.SetSelectedFunc(func() {
 		secrets.confirmDialog.Hide()
 
-		secrets.remove()
+		switch secrets.confirmData {
+		case "reveal":
+			secrets.reveal()
+		case "rm":
+			secrets.remove()
+		}
 	})
 
 	secrets.confirmDialog.SetCancelFunc(func() {
@@ -130,18 +158,40 @@ func NewSecrets() *Secrets {
 		secrets.create()
 	})
 
+	// set update dialog function
+	secrets.updateDialog.SetCancelFunc(secrets.updateDialog.Hide)
+	secrets.updateDialog.SetUpdateFunc(secrets.update)
+
+	// set used by dialog function
+	secrets.usedByDialog.SetCancelFunc(secrets.usedByDialog.Hide)
+	secrets.usedByDialog.SetRestartFunc(secrets.restart)
+	secrets.usedByDialog.SetGoToFunc(secrets.goTo)
+
 	// set sort dialog function
 	secrets.sortDialog.SetCancelFunc(secrets.sortDialog.Hide)
 	secrets.sortDialog.SetSelectFunc(secrets.SortView)
 
+	// set details panel functions
+	secrets.initDetailsPanel()
+
 	return secrets
 }
 
+// SetFastRefreshChannel sets channel for fastRefresh func.
+func (s *Secrets) SetFastRefreshChannel(refresh chan bool) {
+	s.fastRefreshChan = refresh
+}
+
 // SetAppFocusHandler sets application focus handler.
 func (s *Secrets) SetAppFocusHandler(handler func()) {
 	s.appFocusHandler = handler
 }
 
+// SetNavigateFunc sets the handler which switches to another resource screen.
+func (s *Secrets) SetNavigateFunc(handler func(kind string, id string)) {
+	s.navigateHandler = handler
+}
+
 // GetTitle returns primitive title.
 func (s *Secrets) GetTitle() string {
 	return s.title
@@ -218,6 +268,24 @@ func (s *Secrets) getSelectedItem() (int, string, string) {
 	return rowIndex, secID, secName
 }
 
+// GetSelectedItemID returns the selected secret ID.
+func (s *Secrets) GetSelectedItemID() string {
+	_, secID, _ := s.getSelectedItem()
+
+	return secID
+}
+
+// SelectItem selects the secret by its ID or name.
+func (s *Secrets) SelectItem(id string) {
+	for i, secret := range s.getData() {
+		if utils.MatchIDOrName(id, secret.ID, secret.Spec.Name) {
+			s.table.Select(i+1, 0)
+
+			return
+		}
+	}
+}
+
 func (s *Secrets) getInnerDialogs() []utils.UIDialog {
 	dialogs := []utils.UIDialog{
 		s.progressDialog,
@@ -225,7 +293,10 @@ func (s *Secrets) getInnerDialogs() []utils.UIDialog {
 		s.confirmDialog,
 		s.cmdDialog,
 		s.createDialog,
+		s.updateDialog,
+		s.usedByDialog,
 		s.messageDialog,
+		s.inspectDialog,
 		s.sortDialog,
 	}
 
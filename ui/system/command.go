@@ -4,8 +4,15 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/containers/podman-tui/pdcs/images"
+	"github.com/containers/podman-tui/pdcs/networks"
+	"github.com/containers/podman-tui/pdcs/pods"
 	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/secrets"
 	"github.com/containers/podman-tui/pdcs/sysinfo"
+	putils "github.com/containers/podman-tui/pdcs/utils"
+	"github.com/containers/podman-tui/pdcs/volumes"
 	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/containers/podman-tui/ui/style"
 	"github.com/containers/podman-tui/ui/utils"
@@ -18,6 +25,8 @@ func (sys *System) runCommand(cmd string) {
 		sys.connAddDialog.Display()
 	case "connect":
 		sys.connect()
+	case "dependency graph":
+		sys.graph()
 	case "disconnect":
 		sys.disconnect()
 	case "disk usage":
@@ -94,7 +103,7 @@ func (sys *System) df() {
 	sys.progressDialog.Display()
 
 	diskUsage := func() {
-		response, err := sysinfo.DiskUsage()
+		response, items, err := sysinfo.DiskUsage()
 
 		sys.progressDialog.Hide()
 
@@ -108,6 +117,7 @@ func (sys *System) df() {
 		connName := registry.ConnectionName()
 		sys.dfDialog.SetServiceName(connName)
 		sys.dfDialog.UpdateDiskSummary(response)
+		sys.dfDialog.UpdateDiskUsageItems(items)
 		sys.dfDialog.Display()
 		sys.appFocusHandler()
 	}
@@ -115,6 +125,206 @@ func (sys *System) df() {
 	go diskUsage()
 }
 
+func (sys *System) dfRemovePrep() {
+	item := sys.dfDialog.GetSelectedItem()
+	if item == nil {
+		return
+	}
+
+	var (
+		title     string
+		itemLabel string
+		itemName  string
+	)
+
+	switch item.Type {
+	case sysinfo.DfTypeImages:
+		title = "podman image rm"
+		itemLabel = "IMAGE"
+		itemName = fmt.Sprintf("%s (%s)", utils.GetIDWithLimit(item.ID), item.Name)
+	case sysinfo.DfTypeContainers:
+		title = "podman container rm"
+		itemLabel = "CONTAINER"
+		itemName = fmt.Sprintf("%s (%s)", utils.GetIDWithLimit(item.ID), item.Name)
+	default:
+		title = "podman volume rm"
+		itemLabel = "VOLUME"
+		itemName = item.Name
+	}
+
+	sys.dfRemoveItem = item
+	sys.confirmData = "df_rm"
+	sys.confirmDialog.SetTitle(title)
+
+	bgColor := style.GetColorHex(style.DialogBorderColor)
+	fgColor := style.GetColorHex(style.DialogFgColor)
+	dfItem := fmt.Sprintf("[%s:%s:b]%s:[:-:-] %s", fgColor, bgColor, itemLabel, itemName)
+	confirmMsg := fmt.Sprintf("%s\n\nAre you sure you want to remove the selected %s ?",
+		dfItem, strings.ToLower(itemLabel))
+
+	sys.confirmDialog.SetText(confirmMsg)
+	sys.confirmDialog.Display()
+}
+
+func (sys *System) dfRemove() {
+	item := sys.dfRemoveItem
+	if item == nil {
+		return
+	}
+
+	sys.dfRemoveItem = nil
+
+	sys.progressDialog.SetTitle("disk usage item remove in progress")
+	sys.progressDialog.Display()
+
+	remove := func() {
+		var (
+			report []string
+			err    error
+		)
+
+		switch item.Type {
+		case sysinfo.DfTypeImages:
+			_, err = images.Remove(item.ID)
+		case sysinfo.DfTypeContainers:
+			report, err = containers.Remove(item.ID)
+		default:
+			err = volumes.Remove(item.Name)
+		}
+
+		sys.progressDialog.Hide()
+
+		if err == nil && len(report) > 0 {
+			err = fmt.Errorf("%w: %s", errRemove, strings.Join(report, "\n"))
+		}
+
+		if err != nil {
+			sys.displayError("SYSTEM DISK USAGE REMOVE ERROR", err)
+			sys.appFocusHandler()
+
+			return
+		}
+
+		sys.df()
+	}
+
+	go remove()
+}
+
+func (sys *System) graph() {
+	if !sys.destIsSet() {
+		return
+	}
+
+	sys.progressDialog.SetTitle("podman dependency graph in progress")
+	sys.progressDialog.Display()
+
+	dependencyGraph := func() {
+		graph, err := sysinfo.DependencyGraph()
+
+		sys.progressDialog.Hide()
+
+		if err != nil {
+			sys.displayError("SYSTEM DEPENDENCY GRAPH ERROR", err)
+			sys.appFocusHandler()
+
+			return
+		}
+
+		sys.graphDialog.SetServiceName(registry.ConnectionName())
+		sys.graphDialog.SetGraph(graph)
+		sys.graphDialog.Display()
+		sys.appFocusHandler()
+	}
+
+	go dependencyGraph()
+}
+
+func (sys *System) graphGoTo() {
+	node := sys.graphDialog.GetSelectedNode()
+	if node == nil || sys.navigateHandler == nil {
+		return
+	}
+
+	sys.graphDialog.Hide()
+	sys.navigateHandler(node.Kind, node.Target())
+}
+
+func (sys *System) graphRemovePrep() {
+	node := sys.graphDialog.GetSelectedNode()
+	if node == nil {
+		return
+	}
+
+	itemName := node.Name
+	if node.ID != "" && node.ID != node.Name {
+		itemName = fmt.Sprintf("%s (%s)", utils.GetIDWithLimit(node.ID), node.Name)
+	}
+
+	sys.graphRemoveNode = node
+	sys.confirmData = "graph_rm"
+	sys.confirmDialog.SetTitle(fmt.Sprintf("podman %s rm", node.Kind))
+
+	bgColor := style.GetColorHex(style.DialogBorderColor)
+	fgColor := style.GetColorHex(style.DialogFgColor)
+	graphItem := fmt.Sprintf("[%s:%s:b]%s:[:-:-] %s", fgColor, bgColor, strings.ToUpper(node.Kind), itemName)
+	confirmMsg := fmt.Sprintf("%s\n\nAre you sure you want to remove the selected %s ?", graphItem, node.Kind)
+
+	sys.confirmDialog.SetText(confirmMsg)
+	sys.confirmDialog.Display()
+}
+
+func (sys *System) graphRemove() {
+	node := sys.graphRemoveNode
+	if node == nil {
+		return
+	}
+
+	sys.graphRemoveNode = nil
+
+	sys.progressDialog.SetTitle(node.Kind + " remove in progress")
+	sys.progressDialog.Display()
+
+	remove := func() {
+		var (
+			report []string
+			err    error
+		)
+
+		switch node.Kind {
+		case putils.ResourceKindPod:
+			report, err = pods.Remove(node.ID)
+		case putils.ResourceKindContainer:
+			report, err = containers.Remove(node.ID)
+		case putils.ResourceKindImage:
+			_, err = images.Remove(node.ID)
+		case putils.ResourceKindVolume:
+			err = volumes.Remove(node.Name)
+		case putils.ResourceKindNetwork:
+			err = networks.Remove(node.Name)
+		case putils.ResourceKindSecret:
+			err = secrets.Remove(node.ID)
+		}
+
+		sys.progressDialog.Hide()
+
+		if err == nil && len(report) > 0 {
+			err = fmt.Errorf("%w: %s", errRemove, strings.Join(report, "\n"))
+		}
+
+		if err != nil {
+			sys.displayError("SYSTEM DEPENDENCY GRAPH REMOVE ERROR", err)
+			sys.appFocusHandler()
+
+			return
+		}
+
+		sys.graph()
+	}
+
+	go remove()
+}
+
 func (sys *System) events() {
 	if !sys.destIsSet() {
 		return
@@ -159,23 +369,55 @@ func (sys *System) cprune() {
 		return
 	}
 
-	connName := registry.ConnectionName()
+	sys.pruneDialog.SetTitle("podman system prune (" + registry.ConnectionName() + ")")
+	sys.pruneDialog.Display()
+	sys.prunePreview()
+}
 
-	sys.confirmDialog.SetTitle("podman system prune")
-	sys.confirmData = utils.PruneCommandLabel
-	confirmMsg := fmt.Sprintf(
-		"Are you sure you want to remove all unused pod, container, image and volume data on %s?",
-		connName)
-	sys.confirmDialog.SetText(confirmMsg)
-	sys.confirmDialog.Display()
+func (sys *System) prunePreview() {
+	opts, err := sys.pruneDialog.GetPruneOptions()
+	if err != nil {
+		sys.displayError("SYSTEM PRUNE PREVIEW ERROR", err)
+
+		return
+	}
+
+	sys.progressDialog.SetTitle("system prune preview in progress")
+	sys.progressDialog.Display()
+
+	preview := func() {
+		reports, err := sysinfo.PrunePreview(opts)
+
+		sys.progressDialog.Hide()
+
+		if err != nil {
+			sys.displayError("SYSTEM PRUNE PREVIEW ERROR", err)
+			sys.appFocusHandler()
+
+			return
+		}
+
+		sys.pruneDialog.SetPreview(reports)
+		sys.appFocusHandler()
+	}
+
+	go preview()
 }
 
 func (sys *System) prune() {
+	opts, err := sys.pruneDialog.GetPruneOptions()
+	if err != nil {
+		sys.displayError("SYSTEM PRUNE ERROR", err)
+
+		return
+	}
+
+	sys.pruneDialog.Hide()
 	sys.progressDialog.SetTitle("system prune in progress")
 	sys.progressDialog.Display()
 
 	prune := func() {
-		report, err := sysinfo.Prune()
+		report, err := sysinfo.Prune(opts)
 
 		sys.progressDialog.Hide()
 
@@ -0,0 +1,29 @@
+package system
+
+import putils "github.com/containers/podman-tui/pdcs/utils"
+
+// GetCommands returns the system screen commands and their descriptions.
+func (sys *System) GetCommands() [][]string {
+	return sys.cmdDialog.GetCommands()
+}
+
+// RunCommand runs the system screen command on the selected connection.
+func (sys *System) RunCommand(cmd string) {
+	sys.runCommand(cmd)
+}
+
+// GetResources returns the service connections listed on the screen.
+func (sys *System) GetResources() []putils.ResourceReference {
+	data := sys.getConnectionsData()
+	resources := make([]putils.ResourceReference, 0, len(data))
+
+	for _, item := range data {
+		resources = append(resources, putils.ResourceReference{
+			Kind: putils.ResourceKindConnection,
+			Name: item.Name,
+			Info: item.URI,
+		})
+	}
+
+	return resources
+}
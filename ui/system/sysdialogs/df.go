@@ -1,34 +1,55 @@
 package sysdialogs
 
 import (
+	"cmp"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/containers/podman-tui/pdcs/sysinfo"
 	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/containers/podman-tui/ui/style"
 	"github.com/containers/podman-tui/ui/utils"
+	"github.com/docker/go-units"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 	"github.com/rs/zerolog/log"
 )
 
 const (
-	dfDialogMaxWidth     = 60
-	dfDialogLabelPadding = 1
+	dfDialogMaxWidth         = 60
+	dfDialogDetailsMaxWidth  = 110
+	dfDialogDetailsMaxHeight = 30
+	dfDialogLabelPadding     = 1
+)
+
+const (
+	dfDialogFormFocus = 0 + iota
+	dfDialogTableFocus
 )
 
 // DfDialog is a simple dialog with disk usage result table.
+// Selecting a summary row drills down to the images, containers or volumes
+// list which can be sorted by size and the selected item removed.
 type DfDialog struct {
 	*tview.Box
 
 	layout        *tview.Flex
 	serviceName   *tview.InputField
 	table         *tview.Table
+	hint          *tview.TextView
 	form          *tview.Form
 	display       bool
+	focusElement  int
 	tableHeaders  []string
+	summary       []*sysinfo.DfSummary
+	items         []*sysinfo.DfItem
+	detailsType   string
+	detailsItems  []*sysinfo.DfItem
+	sortAscending bool
 	cancelHandler func()
+	removeHandler func()
 }
 
 // NewDfDialog returns new DfDialog primitive.
@@ -36,6 +57,7 @@ func NewDfDialog() *DfDialog {
 	dialog := &DfDialog{
 		Box:          tview.NewBox(),
 		serviceName:  tview.NewInputField(),
+		hint:         tview.NewTextView(),
 		tableHeaders: []string{"type", "total", "active", "size", "reclaimable"},
 		display:      false,
 	}
@@ -57,7 +79,13 @@ func NewDfDialog() *DfDialog {
 	dialog.table.SetBackgroundColor(style.DialogBgColor)
 	dialog.table.SetBorder(true)
 	dialog.table.SetBorderColor(style.DialogSubBoxBorderColor)
-	dialog.initTable()
+	dialog.initTable(dialog.tableHeaders)
+
+	// hint
+	dialog.hint.SetBackgroundColor(style.DialogBgColor)
+	dialog.hint.SetTextColor(style.DialogFgColor)
+	dialog.hint.SetDynamicColors(true)
+	dialog.setHint()
 
 	dialog.form = tview.NewForm().
 		AddButton("Cancel", nil).
@@ -77,10 +105,11 @@ func NewDfDialog() *DfDialog {
 	tableLayout.AddItem(utils.EmptyBoxSpace(style.DialogBgColor), 1, 0, true)
 	tableLayout.AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
 		AddItem(dialog.serviceName, 1, 0, false).
-		AddItem(dialog.table, 0, 1, true), 0, 1, true)
+		AddItem(dialog.table, 0, 1, true).
+		AddItem(dialog.hint, 1, 0, false), 0, 1, true)
 	tableLayout.AddItem(utils.EmptyBoxSpace(style.DialogBgColor), 1, 0, true)
 
-	dialog.layout.AddItem(tableLayout, 9, 0, true) //nolint:mnd
+	dialog.layout.AddItem(tableLayout, 0, 1, true)
 	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
 
 	return dialog
@@ -107,10 +136,19 @@ func (d *DfDialog) IsDisplay() bool {
 // Hide stops displaying this primitive.
 func (d *DfDialog) Hide() {
 	d.display = false
+	d.focusElement = dfDialogFormFocus
+	d.detailsType = ""
+	d.sortAscending = false
 }
 
 // Focus is called when this primitive receives focus.
 func (d *DfDialog) Focus(delegate func(p tview.Primitive)) {
+	if d.focusElement == dfDialogTableFocus {
+		delegate(d.table)
+
+		return
+	}
+
 	delegate(d.form)
 }
 
@@ -124,34 +162,97 @@ func (d *DfDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tv
 	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
 		log.Debug().Msgf("disk usage dialog: event %v received", event)
 
-		if event.Key() == tcell.KeyEsc || event.Key() == tcell.KeyEnter {
+		if event.Key() == utils.CloseDialogKey.Key {
+			if d.detailsType != "" {
+				d.showSummary()
+
+				return
+			}
+
 			d.cancelHandler()
 
 			return
 		}
 
-		// scroll between df items
-		if tableHandler := d.table.InputHandler(); tableHandler != nil {
-			tableHandler(event, setFocus)
+		if event.Key() == utils.SwitchFocusKey.Key {
+			if d.focusElement == dfDialogFormFocus {
+				d.focusElement = dfDialogTableFocus
+			} else {
+				d.focusElement = dfDialogFormFocus
+			}
+
+			d.Focus(setFocus)
+
+			return
+		}
+
+		if d.form.HasFocus() {
+			if event.Key() == tcell.KeyEnter {
+				d.cancelHandler()
+			}
 
 			return
 		}
+
+		d.tableInputHandler(event, setFocus)
 	})
 }
 
+func (d *DfDialog) tableInputHandler(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	if event.Key() == tcell.KeyEnter {
+		if d.detailsType == "" {
+			row, _ := d.table.GetSelection()
+			if row > 0 && row <= len(d.summary) {
+				d.showDetails(d.summary[row-1].Type())
+			}
+		}
+
+		return
+	}
+
+	if d.detailsType != "" {
+		if event.Rune() == utils.SortMenuKey.Rune() {
+			d.sortAscending = !d.sortAscending
+			d.showDetails(d.detailsType)
+
+			return
+		}
+
+		if event.Key() == utils.DeleteKey.EventKey() {
+			if d.removeHandler != nil && d.GetSelectedItem() != nil {
+				d.removeHandler()
+			}
+
+			return
+		}
+	}
+
+	// scroll between df items
+	if tableHandler := d.table.InputHandler(); tableHandler != nil {
+		tableHandler(utils.ParseKeyEventKey(event), setFocus)
+	}
+}
+
 // SetRect set rects for this primitive.
 func (d *DfDialog) SetRect(x, y, width, height int) {
+	maxWidth := dfDialogMaxWidth
+	dHeight := dialogs.DialogFormHeight + 12 //nolint:mnd
+
+	if d.detailsType != "" {
+		maxWidth = dfDialogDetailsMaxWidth
+		dHeight = dfDialogDetailsMaxHeight
+	}
+
 	dX := x + dialogs.DialogPadding
 	dY := y
 	dWidth := width - (2 * dialogs.DialogPadding) //nolint:mnd
 
-	if dWidth > dfDialogMaxWidth {
-		dWidth = dfDialogMaxWidth
+	if dWidth > maxWidth {
+		dWidth = maxWidth
 		emptySpace := (width - dWidth) / 2 //nolint:mnd
 		dX = x + emptySpace
 	}
 
-	dHeight := dialogs.DialogFormHeight + 11 //nolint:mnd
 	if height > dHeight {
 		dY = y + ((height - dHeight) / 2) //nolint:mnd
 		height = dHeight
@@ -179,11 +280,55 @@ func (d *DfDialog) SetCancelFunc(handler func()) *DfDialog {
 	return d
 }
 
+// SetRemoveFunc sets remove (delete key) function for the selected item.
+func (d *DfDialog) SetRemoveFunc(handler func()) *DfDialog {
+	d.removeHandler = handler
+
+	return d
+}
+
+// GetSelectedItem returns selected image, container or volume item.
+func (d *DfDialog) GetSelectedItem() *sysinfo.DfItem {
+	if d.detailsType == "" {
+		return nil
+	}
+
+	row, _ := d.table.GetSelection()
+	if row < 1 || row > len(d.detailsItems) {
+		return nil
+	}
+
+	return d.detailsItems[row-1]
+}
+
 // UpdateDiskSummary updates disk summary table result.
 func (d *DfDialog) UpdateDiskSummary(sum []*sysinfo.DfSummary) {
+	d.summary = sum
+
+	if d.detailsType == "" {
+		d.showSummary()
+	}
+}
+
+// UpdateDiskUsageItems updates images, containers and volumes disk usage items.
+func (d *DfDialog) UpdateDiskUsageItems(items []*sysinfo.DfItem) {
+	d.items = items
+
+	if d.detailsType != "" {
+		d.showDetails(d.detailsType)
+	}
+}
+
+func (d *DfDialog) showSummary() {
+	d.detailsType = ""
+	d.detailsItems = nil
+
+	d.initTable(d.tableHeaders)
+	d.setHint()
+
 	// add summaries
 	rowIndex := 1
-	for _, dfReport := range sum {
+	for _, dfReport := range d.summary {
 		d.table.SetCell(rowIndex, 0,
 			tview.NewTableCell(dfReport.Type()).
 				SetExpansion(1).
@@ -209,9 +354,104 @@ func (d *DfDialog) UpdateDiskSummary(sum []*sysinfo.DfSummary) {
 
 		rowIndex++
 	}
+
+	d.table.Select(1, 0)
+	d.table.ScrollToBeginning()
 }
 
-func (d *DfDialog) initTable() {
+func (d *DfDialog) showDetails(dfType string) {
+	d.detailsType = dfType
+	d.detailsItems = nil
+
+	for _, item := range d.items {
+		if item.Type == dfType {
+			d.detailsItems = append(d.detailsItems, item)
+		}
+	}
+
+	slices.SortStableFunc(d.detailsItems, func(a, b *sysinfo.DfItem) int {
+		if d.sortAscending {
+			return cmp.Compare(a.Size, b.Size)
+		}
+
+		return cmp.Compare(b.Size, a.Size)
+	})
+
+	sizeHeader := "size ▼"
+	if d.sortAscending {
+		sizeHeader = "size ▲"
+	}
+
+	var headers []string
+
+	switch dfType {
+	case sysinfo.DfTypeImages:
+		headers = []string{"id", "repository:tag", "containers", "shared", "unique", sizeHeader}
+	case sysinfo.DfTypeContainers:
+		headers = []string{"id", "name", "image", "status", sizeHeader}
+	default:
+		headers = []string{"name", "links", "reclaimable", sizeHeader}
+	}
+
+	d.initTable(headers)
+	d.setHint()
+
+	for i, item := range d.detailsItems {
+		var row []string
+
+		switch dfType {
+		case sysinfo.DfTypeImages:
+			row = []string{
+				utils.GetIDWithLimit(item.ID),
+				item.Name,
+				strconv.Itoa(item.Links),
+				units.HumanSize(float64(item.SharedSize)),
+				units.HumanSize(float64(item.UniqueSize)),
+				units.HumanSize(float64(item.Size)),
+			}
+		case sysinfo.DfTypeContainers:
+			row = []string{
+				utils.GetIDWithLimit(item.ID),
+				item.Name,
+				item.Image,
+				item.Status,
+				units.HumanSize(float64(item.Size)),
+			}
+		default:
+			row = []string{
+				item.Name,
+				strconv.Itoa(item.Links),
+				units.HumanSize(float64(item.Reclaimable)),
+				units.HumanSize(float64(item.Size)),
+			}
+		}
+
+		for col, value := range row {
+			d.table.SetCell(i+1, col,
+				tview.NewTableCell(tview.Escape(value)).
+					SetExpansion(1).
+					SetAlign(tview.AlignLeft))
+		}
+	}
+
+	d.table.Select(1, 0)
+	d.table.ScrollToBeginning()
+
+	d.focusElement = dfDialogTableFocus
+}
+
+func (d *DfDialog) setHint() {
+	hint := "[::b]tab:[::-] switch focus  [::b]enter:[::-] show details"
+
+	if d.detailsType != "" {
+		hint = fmt.Sprintf("[::b]%s:[::-] %d item(s)  [::b]s:[::-] sort by size  [::b]delete:[::-] remove  [::b]esc:[::-] back",
+			strings.ToLower(d.detailsType), len(d.detailsItems))
+	}
+
+	d.hint.SetText(hint)
+}
+
+func (d *DfDialog) initTable(headers []string) {
 	bgColor := style.TableHeaderBgColor
 	fgColor := style.TableHeaderFgColor
 
@@ -220,16 +460,13 @@ func (d *DfDialog) initTable() {
 	d.table.SetSelectable(true, false)
 
 	// add headers
-	for i := range d.tableHeaders {
+	for i := range headers {
 		d.table.SetCell(0, i,
-			tview.NewTableCell(fmt.Sprintf("[%s::b]%s", style.GetColorHex(fgColor), strings.ToUpper(d.tableHeaders[i]))).
+			tview.NewTableCell(fmt.Sprintf("[%s::b]%s", style.GetColorHex(fgColor), strings.ToUpper(headers[i]))).
 				SetExpansion(1).
 				SetBackgroundColor(bgColor).
 				SetTextColor(fgColor).
 				SetAlign(tview.AlignLeft).
 				SetSelectable(false))
 	}
-
-	d.table.SetFixed(1, 1)
-	d.table.SetSelectable(true, false)
 }
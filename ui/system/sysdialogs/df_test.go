@@ -1,6 +1,7 @@
 package sysdialogs
 
 import (
+	"github.com/containers/podman-tui/pdcs/sysinfo"
 	"github.com/gdamore/tcell/v2"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -59,6 +60,39 @@ var _ = Describe("system df", Ordered, func() {
 		Expect(cancelAction).To(Equal(cancelWants))
 	})
 
+	It("disk usage details", func() {
+		dfDialog.UpdateDiskUsageItems([]*sysinfo.DfItem{
+			{Type: sysinfo.DfTypeImages, ID: "img01", Name: "localhost/img01:latest", Size: 1024},
+			{Type: sysinfo.DfTypeImages, ID: "img02", Name: "localhost/img02:latest", Size: 4096},
+			{Type: sysinfo.DfTypeVolumes, ID: "vol01", Name: "vol01", Size: 2048},
+		})
+		dfDialog.showDetails(sysinfo.DfTypeImages)
+		Expect(dfDialog.table.GetRowCount()).To(Equal(3))
+		Expect(dfDialog.GetSelectedItem().ID).To(Equal("img02"))
+
+		dfDialogApp.SetFocus(dfDialog)
+		dfDialogApp.Draw()
+		dfDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyRune, 's', tcell.ModNone))
+		dfDialogApp.Draw()
+		Expect(dfDialog.GetSelectedItem().ID).To(Equal("img01"))
+	})
+
+	It("remove selected item", func() {
+		removed := ""
+		dfDialog.SetRemoveFunc(func() {
+			removed = dfDialog.GetSelectedItem().ID
+		})
+		dfDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyDelete, 0, tcell.ModNone))
+		dfDialogApp.Draw()
+		Expect(removed).To(Equal("img01"))
+	})
+
+	It("back to summary", func() {
+		dfDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
+		dfDialogApp.Draw()
+		Expect(dfDialog.GetSelectedItem()).To(BeNil())
+	})
+
 	It("hide", func() {
 		dfDialog.Hide()
 		Expect(dfDialog.IsDisplay()).To(Equal(false))
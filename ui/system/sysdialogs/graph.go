@@ -0,0 +1,388 @@
+package sysdialogs
+
+import (
+	"fmt"
+
+	"github.com/containers/podman-tui/pdcs/sysinfo"
+	putils "github.com/containers/podman-tui/pdcs/utils"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	graphDialogMaxWidth     = 110
+	graphDialogMaxHeight    = 35
+	graphDialogLabelPadding = 1
+	graphOrphanedOnlyRune   = 'o'
+)
+
+const (
+	graphDialogTreeFocus = 0 + iota
+	graphDialogFormFocus
+)
+
+// GraphDialog implements resource dependency graph dialog.
+// It displays pods, containers and their images, volumes, networks and secrets as a tree,
+// marks the orphaned resources and switches to or removes the selected one.
+type GraphDialog struct {
+	*tview.Box
+
+	layout        *tview.Flex
+	serviceName   *tview.InputField
+	summary       *tview.TextView
+	tree          *tview.TreeView
+	hint          *tview.TextView
+	form          *tview.Form
+	display       bool
+	focusElement  int
+	graph         []*sysinfo.GraphNode
+	orphanedOnly  bool
+	cancelHandler func()
+	goToHandler   func()
+	removeHandler func()
+}
+
+// NewGraphDialog returns new dependency graph dialog primitive.
+func NewGraphDialog() *GraphDialog {
+	dialog := &GraphDialog{
+		Box:          tview.NewBox(),
+		serviceName:  tview.NewInputField(),
+		summary:      tview.NewTextView(),
+		tree:         tview.NewTreeView(),
+		hint:         tview.NewTextView(),
+		focusElement: graphDialogTreeFocus,
+	}
+
+	bgColor := style.DialogBgColor
+
+	// service name input field
+	serviceNameLabel := "SERVICE NAME:"
+
+	dialog.serviceName.SetBackgroundColor(bgColor)
+	dialog.serviceName.SetLabel("[::b]" + serviceNameLabel)
+	dialog.serviceName.SetLabelWidth(len(serviceNameLabel))
+	dialog.serviceName.SetFieldBackgroundColor(bgColor)
+	dialog.serviceName.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(style.DialogFgColor))
+
+	// summary
+	dialog.summary.SetBackgroundColor(bgColor)
+	dialog.summary.SetTextColor(style.DialogFgColor)
+	dialog.summary.SetDynamicColors(true)
+
+	// dependency tree
+	dialog.tree.SetBackgroundColor(bgColor)
+	dialog.tree.SetBorder(true)
+	dialog.tree.SetBorderColor(style.DialogSubBoxBorderColor)
+	dialog.tree.SetGraphicsColor(style.DialogSubBoxBorderColor)
+
+	// hint
+	dialog.hint.SetBackgroundColor(bgColor)
+	dialog.hint.SetTextColor(style.DialogFgColor)
+	dialog.hint.SetDynamicColors(true)
+	dialog.hint.SetText("[::b]enter:[::-] go to  [::b]delete:[::-] remove  [::b]o:[::-] orphaned only")
+
+	// form
+	dialog.form = tview.NewForm().
+		AddButton("Cancel", nil).
+		SetButtonsAlign(tview.AlignRight)
+
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	dialog.layout = tview.NewFlex().SetDirection(tview.FlexRow)
+
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetTitle("SYSTEM DEPENDENCY GRAPH")
+
+	treeLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+
+	treeLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, true)
+	treeLayout.AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(dialog.serviceName, 1, 0, false).
+		AddItem(dialog.summary, 1, 0, false).
+		AddItem(dialog.tree, 0, 1, true).
+		AddItem(dialog.hint, 1, 0, false), 0, 1, true)
+	treeLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, true)
+
+	dialog.layout.AddItem(treeLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
+
+	return dialog
+}
+
+// SetServiceName sets dependency graph dialog service (connection) name.
+func (d *GraphDialog) SetServiceName(name string) {
+	d.serviceName.SetText(utils.LabelWidthLeftPadding(name, graphDialogLabelPadding))
+}
+
+// Display displays this primitive.
+func (d *GraphDialog) Display() {
+	d.display = true
+	d.focusElement = graphDialogTreeFocus
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *GraphDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *GraphDialog) Hide() {
+	d.display = false
+	d.focusElement = graphDialogTreeFocus
+	d.orphanedOnly = false
+}
+
+// Focus is called when this primitive receives focus.
+func (d *GraphDialog) Focus(delegate func(p tview.Primitive)) {
+	if d.focusElement == graphDialogFormFocus {
+		delegate(d.form)
+
+		return
+	}
+
+	delegate(d.tree)
+}
+
+// HasFocus returns true if this primitive has focus.
+func (d *GraphDialog) HasFocus() bool {
+	return d.form.HasFocus() || d.tree.HasFocus()
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *GraphDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("dependency graph dialog: event %v received", event)
+
+		if event.Key() == utils.CloseDialogKey.Key {
+			d.cancelHandler()
+
+			return
+		}
+
+		if event.Key() == utils.SwitchFocusKey.Key {
+			if d.focusElement == graphDialogTreeFocus {
+				d.focusElement = graphDialogFormFocus
+			} else {
+				d.focusElement = graphDialogTreeFocus
+			}
+
+			d.Focus(setFocus)
+
+			return
+		}
+
+		if d.form.HasFocus() {
+			if event.Key() == tcell.KeyEnter {
+				d.cancelHandler()
+			}
+
+			return
+		}
+
+		d.treeInputHandler(event, setFocus)
+	})
+}
+
+func (d *GraphDialog) treeInputHandler(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	switch {
+	case event.Key() == tcell.KeyEnter:
+		if d.goToHandler != nil && d.GetSelectedNode() != nil {
+			d.goToHandler()
+		}
+
+		return
+	case event.Key() == utils.DeleteKey.EventKey():
+		if d.removeHandler != nil && d.GetSelectedNode() != nil {
+			d.removeHandler()
+		}
+
+		return
+	case event.Rune() == graphOrphanedOnlyRune:
+		d.orphanedOnly = !d.orphanedOnly
+		d.setTree()
+
+		return
+	}
+
+	if treeHandler := d.tree.InputHandler(); treeHandler != nil {
+		treeHandler(utils.ParseKeyEventKey(event), setFocus)
+	}
+}
+
+// SetRect set rects for this primitive.
+func (d *GraphDialog) SetRect(x, y, width, height int) {
+	dX := x + dialogs.DialogPadding
+	dY := y
+	dWidth := width - (2 * dialogs.DialogPadding) //nolint:mnd
+
+	if dWidth > graphDialogMaxWidth {
+		dWidth = graphDialogMaxWidth
+		emptySpace := (width - dWidth) / 2 //nolint:mnd
+		dX = x + emptySpace
+	}
+
+	if height > graphDialogMaxHeight {
+		dY = y + ((height - graphDialogMaxHeight) / 2) //nolint:mnd
+		height = graphDialogMaxHeight
+	}
+
+	d.Box.SetRect(dX, dY, dWidth, height)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *GraphDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.DrawForSubclass(screen, d)
+	x, y, width, height := d.GetInnerRect()
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *GraphDialog) SetCancelFunc(handler func()) *GraphDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetGoToFunc sets go to (enter key) function for the selected node.
+func (d *GraphDialog) SetGoToFunc(handler func()) *GraphDialog {
+	d.goToHandler = handler
+
+	return d
+}
+
+// SetRemoveFunc sets remove (delete key) function for the selected node.
+func (d *GraphDialog) SetRemoveFunc(handler func()) *GraphDialog {
+	d.removeHandler = handler
+
+	return d
+}
+
+// SetGraph sets dependency graph nodes.
+func (d *GraphDialog) SetGraph(graph []*sysinfo.GraphNode) {
+	d.graph = graph
+
+	var pods, containers, orphaned int
+
+	for _, node := range graph {
+		switch {
+		case node.Orphaned:
+			orphaned++
+		case node.Kind == putils.ResourceKindPod:
+			pods++
+			containers += len(node.Children)
+		default:
+			containers++
+		}
+	}
+
+	d.summary.SetText(fmt.Sprintf("[::b]pods:[::-] %d  [::b]containers:[::-] %d  [::b]orphaned:[::-] %d",
+		pods, containers, orphaned))
+
+	d.setTree()
+}
+
+// GetSelectedNode returns the selected graph node.
+func (d *GraphDialog) GetSelectedNode() *sysinfo.GraphNode {
+	current := d.tree.GetCurrentNode()
+	if current == nil {
+		return nil
+	}
+
+	node, ok := current.GetReference().(*sysinfo.GraphNode)
+	if !ok {
+		return nil
+	}
+
+	return node
+}
+
+func (d *GraphDialog) setTree() {
+	selected := d.GetSelectedNode()
+
+	root := tview.NewTreeNode(".").
+		SetColor(style.DialogFgColor).
+		SetSelectable(false)
+
+	for _, node := range d.graph {
+		if d.orphanedOnly && !node.Orphaned {
+			continue
+		}
+
+		root.AddChild(newGraphTreeNode(node))
+	}
+
+	d.tree.SetRoot(root)
+	d.tree.SetTopLevel(1)
+
+	var current *tview.TreeNode
+
+	// keep the previously selected resource selected
+	if selected != nil {
+		root.Walk(func(treeNode, _ *tview.TreeNode) bool {
+			node, ok := treeNode.GetReference().(*sysinfo.GraphNode)
+			if ok && current == nil && node.Kind == selected.Kind && node.Target() == selected.Target() {
+				current = treeNode
+			}
+
+			return current == nil
+		})
+	}
+
+	if current == nil && len(root.GetChildren()) > 0 {
+		current = root.GetChildren()[0]
+	}
+
+	d.tree.SetCurrentNode(current)
+}
+
+func newGraphTreeNode(node *sysinfo.GraphNode) *tview.TreeNode {
+	text := fmt.Sprintf("%s %s", node.Kind, tview.Escape(node.Name))
+
+	if node.ID != "" && node.ID != node.Name {
+		text = fmt.Sprintf("%s (%s)", text, utils.GetIDWithLimit(node.ID))
+	}
+
+	if node.Info != "" {
+		text = fmt.Sprintf("%s - %s", text, tview.Escape(node.Info))
+	}
+
+	color := style.DialogFgColor
+
+	switch {
+	case node.Orphaned:
+		text += " (orphaned)"
+		color = style.PrgBarWarnColor
+	case len(node.Children) > 0:
+		color = style.HelpHeaderFgColor
+	}
+
+	treeNode := tview.NewTreeNode(text).
+		SetReference(node).
+		SetColor(color).
+		SetSelectable(true).
+		SetExpanded(true)
+
+	for _, child := range node.Children {
+		treeNode.AddChild(newGraphTreeNode(child))
+	}
+
+	return treeNode
+}
@@ -0,0 +1,121 @@
+package sysdialogs
+
+import (
+	"github.com/containers/podman-tui/pdcs/sysinfo"
+	putils "github.com/containers/podman-tui/pdcs/utils"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("system dependency graph", Ordered, func() {
+	var graphDialogApp *tview.Application
+	var graphDialogScreen tcell.SimulationScreen
+	var graphDialog *GraphDialog
+	var runApp func()
+
+	graph := []*sysinfo.GraphNode{
+		{
+			ResourceReference: putils.ResourceReference{Kind: putils.ResourceKindPod, ID: "pod01id", Name: "pod01"},
+			Children: []*sysinfo.GraphNode{
+				{
+					ResourceReference: putils.ResourceReference{Kind: putils.ResourceKindContainer, ID: "cnt01id", Name: "cnt01"},
+					Children: []*sysinfo.GraphNode{
+						{ResourceReference: putils.ResourceReference{Kind: putils.ResourceKindImage, ID: "img01id", Name: "img01"}},
+					},
+				},
+			},
+		},
+		{
+			ResourceReference: putils.ResourceReference{Kind: putils.ResourceKindVolume, Name: "vol01"},
+			Orphaned:          true,
+		},
+	}
+
+	BeforeAll(func() {
+		graphDialogApp = tview.NewApplication()
+		graphDialog = NewGraphDialog()
+		graphDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := graphDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+
+		runApp = func() {
+			if err := graphDialogApp.SetScreen(graphDialogScreen).SetRoot(graphDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		graphDialog.Display()
+		graphDialogApp.Draw()
+		Expect(graphDialog.IsDisplay()).To(Equal(true))
+	})
+
+	It("set focus", func() {
+		graphDialogApp.SetFocus(graphDialog)
+		graphDialogApp.Draw()
+		Expect(graphDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("set graph", func() {
+		graphDialog.SetGraph(graph)
+		Expect(graphDialog.summary.GetText(true)).To(Equal("pods: 1  containers: 1  orphaned: 1"))
+		Expect(graphDialog.GetSelectedNode().Name).To(Equal("pod01"))
+	})
+
+	It("go to selected node", func() {
+		goTo := ""
+		graphDialog.SetGoToFunc(func() {
+			goTo = graphDialog.GetSelectedNode().Name
+		})
+		graphDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone))
+		graphDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		graphDialogApp.Draw()
+		Eventually(func() string { return goTo }).Should(Equal("cnt01"))
+	})
+
+	It("orphaned only", func() {
+		graphDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyRune, 'o', tcell.ModNone))
+		graphDialogApp.Draw()
+		Eventually(func() string { return graphDialog.GetSelectedNode().Name }).Should(Equal("vol01"))
+		Expect(graphDialog.GetSelectedNode().Orphaned).To(Equal(true))
+	})
+
+	It("remove selected node", func() {
+		removed := ""
+		graphDialog.SetRemoveFunc(func() {
+			removed = graphDialog.GetSelectedNode().Name
+		})
+		graphDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyDelete, 0, tcell.ModNone))
+		graphDialogApp.Draw()
+		Eventually(func() string { return removed }).Should(Equal("vol01"))
+	})
+
+	It("cancel button selected", func() {
+		cancelWants := "cancel selected"
+		cancelAction := "cancel init"
+		graphDialog.SetCancelFunc(func() {
+			cancelAction = cancelWants
+		})
+		graphDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
+		graphDialogApp.Draw()
+		Eventually(func() string { return cancelAction }).Should(Equal(cancelWants))
+	})
+
+	It("hide", func() {
+		graphDialog.Hide()
+		Expect(graphDialog.IsDisplay()).To(Equal(false))
+	})
+
+	AfterAll(func() {
+		graphDialogApp.Stop()
+	})
+})
@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/sysinfo"
 	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/containers/podman-tui/ui/style"
 	"github.com/containers/podman-tui/ui/system/sysdialogs"
@@ -22,7 +23,10 @@ const (
 	viewSystemIdentityColIndex
 )
 
-var ErrConnectionInprogres = errors.New("connection is in progress, need to disconnect")
+var (
+	ErrConnectionInprogres = errors.New("connection is in progress, need to disconnect")
+	errRemove              = errors.New("remove error")
+)
 
 var UIViewHeaders = []string{"name", "default", "status", "uri", "identity"}
 
@@ -41,8 +45,12 @@ type System struct {
 	sortDialog               *dialogs.SortDialog
 	eventDialog              *sysdialogs.EventsDialog
 	dfDialog                 *sysdialogs.DfDialog
+	graphDialog              *sysdialogs.GraphDialog
 	connPrgDialog            *sysdialogs.ConnectDialog
 	connAddDialog            *sysdialogs.AddConnectionDialog
+	pruneDialog              *dialogs.PruneDialog
+	dfRemoveItem             *sysinfo.DfItem
+	graphRemoveNode          *sysinfo.GraphNode
 	confirmData              string
 	connectionList           connectionListReport
 	connectionListFunc       func() []registry.Connection
@@ -52,6 +60,7 @@ type System struct {
 	connectionConnectFunc    func(registry.Connection)
 	connectionDisconnectFunc func()
 	appFocusHandler          func()
+	navigateHandler          func(kind string, id string)
 }
 
 type connectionListReport struct {
@@ -82,8 +91,10 @@ func NewSystem() *System {
 		sortDialog:       dialogs.NewSortDialog(UIViewHeaders, 0),
 		eventDialog:      sysdialogs.NewEventDialog(),
 		dfDialog:         sysdialogs.NewDfDialog(),
+		graphDialog:      sysdialogs.NewGraphDialog(),
 		connPrgDialog:    sysdialogs.NewConnectDialog(),
 		connAddDialog:    sysdialogs.NewAddConnectionDialog(),
+		pruneDialog:      dialogs.NewPruneDialog(true, true),
 		connectionList:   connectionListReport{sortBy: "name", ascending: true},
 	}
 
@@ -110,6 +121,7 @@ func NewSystem() *System {
 	sys.cmdDialog = dialogs.NewCommandDialog([][]string{
 		{"add connection", "record destination for the Podman TUI service"},
 		{"connect", "connect to selected destination"},
+		{"dependency graph", "display pods, containers and their images, volumes, networks and secrets graph"},
 		{"disconnect", "disconnect from connected destination"},
 		{"disk usage", "display destination podman related disk usage"},
 		{"events", "display destination system events"},
@@ -134,10 +146,12 @@ func NewSystem() *System {
 		sys.confirmDialog.Hide()
 
 		switch sys.confirmData {
-		case utils.PruneCommandLabel:
-			sys.prune()
 		case "remove_conn":
 			sys.remove()
+		case "df_rm":
+			sys.dfRemove()
+		case "graph_rm":
+			sys.graphRemove()
 		}
 	})
 
@@ -160,6 +174,13 @@ func NewSystem() *System {
 		sys.dfDialog.Hide()
 	})
 
+	sys.dfDialog.SetRemoveFunc(sys.dfRemovePrep)
+
+	// set dependency graph dialog functions
+	sys.graphDialog.SetCancelFunc(sys.graphDialog.Hide)
+	sys.graphDialog.SetGoToFunc(sys.graphGoTo)
+	sys.graphDialog.SetRemoveFunc(sys.graphRemovePrep)
+
 	// set connection progress bar cancel function
 	sys.connPrgDialog.SetCancelFunc(func() {
 		sys.connPrgDialog.Hide()
@@ -176,6 +197,11 @@ func NewSystem() *System {
 
 	// set connection sort dialog functions
 	sys.sortDialog.SetCancelFunc(sys.sortDialog.Hide)
+
+	// set prune dialog functions
+	sys.pruneDialog.SetCancelFunc(sys.pruneDialog.Hide)
+	sys.pruneDialog.SetPreviewFunc(sys.prunePreview)
+	sys.pruneDialog.SetPruneFunc(sys.prune)
 	sys.sortDialog.SetSelectFunc(sys.SortView)
 
 	return sys
@@ -186,6 +212,11 @@ func (sys *System) SetAppFocusHandler(handler func()) {
 	sys.appFocusHandler = handler
 }
 
+// SetNavigateFunc sets the handler which switches to another resource screen.
+func (sys *System) SetNavigateFunc(handler func(kind string, id string)) {
+	sys.navigateHandler = handler
+}
+
 // GetTitle returns primitive title.
 func (sys *System) GetTitle() string {
 	return sys.title
@@ -306,6 +337,17 @@ func (sys *System) getSelectedItem() *sysSelectedItem {
 	return &selectedItem
 }
 
+// SelectItem selects the service connection by its name.
+func (sys *System) SelectItem(name string) {
+	for i, conn := range sys.getConnectionsData() {
+		if conn.Name == name {
+			sys.connTable.Select(i+1, 0)
+
+			return
+		}
+	}
+}
+
 func (sys *System) hideAllDialogs(all bool) {
 	for _, dialog := range sys.getInnerDialogs(all) {
 		if dialog.IsDisplay() {
@@ -321,8 +363,10 @@ func (sys *System) getInnerDialogs(all bool) []utils.UIDialog {
 			sys.cmdDialog,
 			sys.confirmDialog,
 			sys.messageDialog,
-			sys.dfDialog,
 			sys.errorDialog,
+			sys.dfDialog,
+			sys.graphDialog,
+			sys.pruneDialog,
 			sys.connPrgDialog,
 			sys.eventDialog,
 			sys.connAddDialog,
@@ -335,8 +379,10 @@ func (sys *System) getInnerDialogs(all bool) []utils.UIDialog {
 		sys.cmdDialog,
 		sys.confirmDialog,
 		sys.messageDialog,
-		sys.dfDialog,
 		sys.errorDialog,
+		sys.dfDialog,
+		sys.graphDialog,
+		sys.pruneDialog,
 		sys.eventDialog,
 		sys.connAddDialog,
 		sys.sortDialog,
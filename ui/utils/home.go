@@ -1,16 +1,16 @@
-//go:build !windows
-
 package utils
 
-import "go.podman.io/storage/pkg/unshare"
+import (
+	putils "github.com/containers/podman-tui/pdcs/utils"
+)
 
 // UserHomeDir returns user's home directory.
 func UserHomeDir() (string, error) {
-	// only get HomeDir when necessary.
-	home, err := unshare.HomeDir()
-	if err != nil {
-		return "", err
-	}
+	return putils.UserHomeDir()
+}
 
-	return home, nil
+// ResolveHomeDir converts a path referencing the home directory via "~"
+// to an absolute path.
+func ResolveHomeDir(path string) (string, error) {
+	return putils.ResolveHomeDir(path)
 }
@@ -20,6 +20,12 @@ var (
 		KeyLabel: "s",
 		KeyDesc:  "display sort menu",
 	}
+	DetailsPanelKey = uiKeyInfo{
+		Key:      tcell.Key(256), //nolint:mnd
+		KeyRune:  rune('d'),
+		KeyLabel: "d",
+		KeyDesc:  "toggle details panel (right, bottom, off)",
+	}
 	NextScreenKey = uiKeyInfo{
 		Key:      tcell.Key(256), //nolint:mnd
 		KeyRune:  rune('l'),
@@ -32,6 +38,12 @@ var (
 		KeyLabel: "h",
 		KeyDesc:  "switch to previous screen",
 	}
+	NavigateBackKey = uiKeyInfo{
+		Key:      tcell.Key(256), //nolint:mnd
+		KeyRune:  rune('b'),
+		KeyLabel: "b",
+		KeyDesc:  "navigate back to the previous resource",
+	}
 	MoveUpKey = uiKeyInfo{
 		Key:      tcell.KeyUp,
 		KeyRune:  rune('k'),
@@ -94,6 +106,17 @@ var (
 		KeyLabel: "Ctrl+c",
 		KeyDesc:  "exit application",
 	}
+	CommandPaletteKey = uiKeyInfo{
+		Key:      tcell.KeyCtrlP,
+		KeyLabel: "Ctrl+p",
+		KeyDesc:  "display command palette",
+	}
+	CommandLineKey = uiKeyInfo{
+		Key:      tcell.Key(256), //nolint:mnd
+		KeyRune:  rune(':'),
+		KeyLabel: ":",
+		KeyDesc:  "display command line",
+	}
 	HelpScreenKey = uiKeyInfo{
 		Key:      tcell.KeyF1,
 		KeyLabel: "F1",
@@ -140,8 +163,10 @@ var (
 var UIKeysBindings = []uiKeyInfo{
 	CommandMenuKey,
 	SortMenuKey,
+	DetailsPanelKey,
 	NextScreenKey,
 	PreviousScreenKey,
+	NavigateBackKey,
 	MoveUpKey,
 	MoveDownKey,
 	CloseDialogKey,
@@ -154,6 +179,8 @@ var UIKeysBindings = []uiKeyInfo{
 	ScrollUpKey,
 	ScrollDownKey,
 	AppExitKey,
+	CommandPaletteKey,
+	CommandLineKey,
 	HelpScreenKey,
 	SystemScreenKey,
 	PodsScreenKey,
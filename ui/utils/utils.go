@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/pkg/errors"
@@ -25,8 +26,9 @@ const (
 )
 
 var (
-	ErrURLMissingScheme = errors.New("url missing scheme")
-	ErrInvalidFilename  = errors.New("invalid filename (should not contain ':')")
+	ErrURLMissingScheme  = errors.New("url missing scheme")
+	ErrInvalidFilename   = errors.New("invalid filename (should not contain ':')")
+	ErrUnterminatedQuote = errors.New("unterminated quoted string")
 )
 
 // GetIDWithLimit return ID string with limited string characters.
@@ -40,6 +42,140 @@ func GetIDWithLimit(id string) string {
 	return id
 }
 
+// FuzzyMatch returns the score of the case insensitive pattern characters matched in order within the text.
+// Consecutive characters and characters at the start of a word score higher.
+func FuzzyMatch(pattern string, text string) (int, bool) {
+	patternRunes := []rune(strings.ToLower(pattern))
+	textRunes := []rune(strings.ToLower(text))
+
+	if len(patternRunes) == 0 {
+		return 0, true
+	}
+
+	score := 0
+	patternIndex := 0
+	lastMatch := -2
+
+	for i, char := range textRunes {
+		if char != patternRunes[patternIndex] {
+			continue
+		}
+
+		score++
+
+		if lastMatch == i-1 {
+			score += 2 //nolint:mnd
+		}
+
+		if i == 0 || !(unicode.IsLetter(textRunes[i-1]) || unicode.IsDigit(textRunes[i-1])) {
+			score += 3 //nolint:mnd
+		}
+
+		lastMatch = i
+		patternIndex++
+
+		if patternIndex == len(patternRunes) {
+			return score, true
+		}
+	}
+
+	return 0, false
+}
+
+// SplitCommandLine splits the command line into words the way a POSIX shell does.
+// Single and double quoted strings are kept as one word, backslash escapes the next
+// character (inside double quotes only ", \, $ and `) and backslash newline continues the line.
+func SplitCommandLine(line string) ([]string, error) { //nolint:cyclop
+	words := make([]string, 0)
+
+	var (
+		word    strings.Builder
+		inWord  bool
+		quote   rune
+		escaped bool
+	)
+
+	for _, char := range strings.ReplaceAll(line, "\r\n", "\n") {
+		switch {
+		case escaped:
+			escaped = false
+
+			if char == '\n' {
+				continue
+			}
+
+			if quote == '"' && !strings.ContainsRune("\"\\$`", char) {
+				word.WriteRune('\\')
+			}
+
+			inWord = true
+
+			word.WriteRune(char)
+		case quote == '\'':
+			if char == quote {
+				quote = 0
+
+				continue
+			}
+
+			word.WriteRune(char)
+		case char == '\\':
+			escaped = true
+		case quote == '"':
+			if char == quote {
+				quote = 0
+
+				continue
+			}
+
+			word.WriteRune(char)
+		case char == '"' || char == '\'':
+			inWord = true
+			quote = char
+		case unicode.IsSpace(char):
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+			}
+
+			inWord = false
+		default:
+			inWord = true
+
+			word.WriteRune(char)
+		}
+	}
+
+	if quote != 0 || escaped {
+		return nil, ErrUnterminatedQuote
+	}
+
+	if inWord {
+		words = append(words, word.String())
+	}
+
+	return words, nil
+}
+
+// MatchIDOrName returns true if the query is the (truncated) ID or one of the names of a resource.
+func MatchIDOrName(query string, id string, names ...string) bool {
+	if query == "" {
+		return false
+	}
+
+	if strings.HasPrefix(id, query) {
+		return true
+	}
+
+	for _, name := range names {
+		if strings.TrimPrefix(name, "/") == strings.TrimPrefix(query, "/") {
+			return true
+		}
+	}
+
+	return false
+}
+
 // LabelWidthLeftPadding adds left space padding.
 func LabelWidthLeftPadding(input string, padding int) string {
 	label := input
@@ -129,25 +265,18 @@ func EmptyBoxSpace(bgColor tcell.Color) *tview.Box {
 	return box
 }
 
-// ResolveHomeDir converts a path referencing the home directory via "~"
-// to an absolute path.
-func ResolveHomeDir(path string) (string, error) {
-	// check if the path references the home dir to avoid work
-	// don't use strings.HasPrefix(path, "~") as this doesn't match "~" alone
-	// use strings.HasPrefix(...) to not match "something/~/something"
-	if path != "~" && !strings.HasPrefix(path, "~/") {
-		// path does not reference home dir -> Nothing to do
-		return path, nil
-	}
+// SelectDropDownOption selects dropdown option by its text,
+// the first option is selected if the text is not found.
+func SelectDropDownOption(dropdown *tview.DropDown, option string) {
+	for i := range dropdown.GetOptionCount() {
+		dropdown.SetCurrentOption(i)
 
-	// only get HomeDir when necessary
-	home, err := UserHomeDir()
-	if err != nil {
-		return "", err
+		if _, text := dropdown.GetCurrentOption(); text == option {
+			return
+		}
 	}
 
-	// replace the first "~" (start of path) with the HomeDir to resolve "~"
-	return strings.Replace(path, "~", home, 1), nil
+	dropdown.SetCurrentOption(0)
 }
 
 // ValidateFileName returns an error if filename contains ":"
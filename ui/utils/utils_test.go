@@ -5,6 +5,7 @@ import (
 	"github.com/gdamore/tcell/v2"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
 )
 
 var _ = Describe("utils", func() {
@@ -28,6 +29,19 @@ var _ = Describe("utils", func() {
 		Expect(emptyBox.GetTitle()).To(Equal(""))
 	})
 
+	It("select dropdown option", func() {
+		dropdown := tview.NewDropDown()
+		dropdown.SetOptions([]string{"", "bridge", "host"}, nil)
+
+		SelectDropDownOption(dropdown, "host")
+		index, _ := dropdown.GetCurrentOption()
+		Expect(index).To(Equal(2))
+
+		SelectDropDownOption(dropdown, "unknown")
+		index, _ = dropdown.GetCurrentOption()
+		Expect(index).To(Equal(0))
+	})
+
 	It("validate file name", func() {
 		validFilename := "filename01"
 		invalidFilename := "filename:01"
@@ -89,4 +103,51 @@ var _ = Describe("utils", func() {
 			}
 		}
 	})
+
+	It("match id or name", func() {
+		fullID := "a1b2c3d4e5f6a7b8c9d0"
+		Expect(MatchIDOrName("a1b2c3d4e5f6", fullID, "web01")).To(Equal(true))
+		Expect(MatchIDOrName("web01", fullID, "web02", "web01")).To(Equal(true))
+		Expect(MatchIDOrName("/web01", fullID, "web01")).To(Equal(true))
+		Expect(MatchIDOrName("b1b2", fullID, "web01")).To(Equal(false))
+		Expect(MatchIDOrName("", fullID, "")).To(Equal(false))
+	})
+
+	It("fuzzy match", func() {
+		score, ok := FuzzyMatch("lgs", "logs web-1")
+		Expect(ok).To(Equal(true))
+		Expect(score).To(BeNumerically(">", 0))
+
+		_, ok = FuzzyMatch("slg", "logs web-1")
+		Expect(ok).To(Equal(false))
+
+		_, ok = FuzzyMatch("", "logs web-1")
+		Expect(ok).To(Equal(true))
+
+		// consecutive and word start characters score higher
+		consecutive, _ := FuzzyMatch("web", "logs web-1")
+		scattered, _ := FuzzyMatch("web", "network remove bridge")
+		Expect(consecutive).To(BeNumerically(">", scattered))
+	})
+
+	It("split command line", func() {
+		words, err := SplitCommandLine("  network connect  backend web ")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(words).To(Equal([]string{"network", "connect", "backend", "web"}))
+
+		words, err = SplitCommandLine(`rm "my container" 'it''s' a\ b ""`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(words).To(Equal([]string{"rm", "my container", "its", "a b", ""}))
+
+		words, err = SplitCommandLine("podman run --name 'my web' \\\n  -e MSG=\"hello \\\"world\\\" \\d\" \\\r\n  nginx")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(words).To(Equal([]string{"podman", "run", "--name", "my web", "-e", `MSG=hello "world" \d`, "nginx"}))
+
+		words, err = SplitCommandLine("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(words).To(BeEmpty())
+
+		_, err = SplitCommandLine(`stop "web`)
+		Expect(err).To(Equal(ErrUnterminatedQuote))
+	})
 })
@@ -5,10 +5,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/containers/podman-tui/pdcs/containers"
 	"github.com/containers/podman-tui/pdcs/volumes"
 	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/containers/podman-tui/ui/style"
-	"github.com/containers/podman-tui/ui/utils"
 	"github.com/rs/zerolog/log"
 )
 
@@ -28,6 +28,8 @@ func (vols *Volumes) runCommand(cmd string) {
 		vols.exportDialog.Display()
 	case "import":
 		vols.importPrep()
+	case "used by":
+		vols.usedBy()
 	}
 }
 
@@ -142,24 +144,62 @@ func (vols *Volumes) inspect() {
 		return
 	}
 
-	vols.messageDialog.SetTitle("podman volume inspect")
-	vols.messageDialog.SetText(dialogs.MessageVolumeInfo, volID, data)
-	vols.messageDialog.Display()
+	vols.inspectDialog.SetTitle("podman volume inspect")
+	vols.inspectDialog.SetData(dialogs.MessageVolumeInfo, volID, data)
+	vols.inspectDialog.Display()
 }
 
 func (vols *Volumes) prunePrep() {
-	vols.confirmDialog.SetTitle("podman volume prune")
-	vols.confirmData = utils.PruneCommandLabel
-	vols.confirmDialog.SetText("Are you sure you want to remove all unused volumes ?")
-	vols.confirmDialog.Display()
+	vols.pruneDialog.Display()
+	vols.prunePreview()
+}
+
+func (vols *Volumes) prunePreview() {
+	errorTitle := "volume prune preview error"
+
+	opts, err := vols.pruneDialog.GetPruneOptions()
+	if err != nil {
+		vols.displayError(errorTitle, err)
+
+		return
+	}
+
+	vols.progressDialog.SetTitle("volume prune preview in progress")
+	vols.progressDialog.Display()
+
+	preview := func() {
+		reports, err := volumes.PrunePreview(opts)
+
+		vols.progressDialog.Hide()
+
+		if err != nil {
+			vols.displayError(errorTitle, err)
+			vols.appFocusHandler()
+
+			return
+		}
+
+		vols.pruneDialog.SetPreview(reports)
+		vols.appFocusHandler()
+	}
+
+	go preview()
 }
 
 func (vols *Volumes) prune() {
+	opts, err := vols.pruneDialog.GetPruneOptions()
+	if err != nil {
+		vols.displayError("volume prune error", err)
+
+		return
+	}
+
+	vols.pruneDialog.Hide()
 	vols.progressDialog.SetTitle("VOLUME prune in progress")
 	vols.progressDialog.Display()
 
 	prune := func() {
-		errData, err := volumes.Prune()
+		errData, err := volumes.Prune(opts)
 
 		vols.progressDialog.Hide()
 
@@ -229,3 +269,45 @@ func (vols *Volumes) remove() {
 
 	go remove(volID)
 }
+
+func (vols *Volumes) usedBy() {
+	volID := vols.getSelectedItem()
+	if volID == "" {
+		vols.displayError("", errNoVolume)
+
+		return
+	}
+
+	vols.progressDialog.SetTitle("volume used by in progress")
+	vols.progressDialog.Display()
+
+	go func() {
+		refs, err := containers.UsedByVolume(volID)
+
+		vols.progressDialog.Hide()
+
+		if err != nil {
+			vols.displayError("VOLUME USED BY ERROR", err)
+			vols.appFocusHandler()
+
+			return
+		}
+
+		vols.navigateDialog.SetResourceInfo("volume name", volID, "")
+		vols.navigateDialog.SetReferences(refs)
+		vols.navigateDialog.Display()
+		vols.appFocusHandler()
+	}()
+}
+
+func (vols *Volumes) goTo() {
+	ref, ok := vols.navigateDialog.GetSelectedReference()
+
+	vols.navigateDialog.Hide()
+
+	if !ok || vols.navigateHandler == nil {
+		return
+	}
+
+	vols.navigateHandler(ref.Kind, ref.Target())
+}
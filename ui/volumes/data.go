@@ -26,6 +26,8 @@ func (vols *Volumes) SortView(option string, ascending bool) {
 
 // UpdateData retrieves pods list data.
 func (vols *Volumes) UpdateData() {
+	vols.detailsPanel.Invalidate()
+
 	volList, err := volumes.List()
 	if err != nil {
 		log.Error().Msgf("view: volumes update %v", err)
@@ -0,0 +1,27 @@
+package volumes
+
+import (
+	"github.com/containers/podman-tui/pdcs/volumes"
+)
+
+// initDetailsPanel sets details panel title, its data loader and reloads
+// the details whenever the table selected item changes.
+func (vols *Volumes) initDetailsPanel() {
+	vols.detailsPanel.SetTitle("volume details")
+	vols.detailsPanel.SetLoadFunc(volumes.Details)
+	vols.detailsPanel.SetSelectedItemFunc(vols.selectedItemID)
+	vols.detailsPanel.SetRefreshFunc(func() {
+		if vols.fastRefreshChan != nil {
+			vols.fastRefreshChan <- true
+		}
+	})
+	vols.table.SetSelectionChangedFunc(func(_, _ int) {
+		vols.detailsPanel.Update(vols.selectedItemID())
+	})
+}
+
+func (vols *Volumes) selectedItemID() string {
+	id := vols.getSelectedItem()
+
+	return id
+}
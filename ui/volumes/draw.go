@@ -11,12 +11,16 @@ func (vols *Volumes) Draw(screen tcell.Screen) {
 
 	x, y, width, height := vols.GetInnerRect()
 
-	vols.refresh(width)
-	vols.table.SetRect(x, y, width, height)
+	tableX, tableY, tableW, tableH := vols.detailsPanel.Split(x, y, width, height)
+
+	vols.refresh(tableW)
+	vols.table.SetRect(tableX, tableY, tableW, tableH)
 	vols.table.SetBorder(true)
 
 	vols.table.Draw(screen)
 
+	vols.detailsPanel.Draw(screen)
+
 	for _, dialog := range vols.getInnerDialogs() {
 		if dialog.IsDisplay() {
 			dialog.SetRect(x, y, width, height)
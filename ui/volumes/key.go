@@ -52,6 +52,13 @@ func (vols *Volumes) processTableInputHandler(event *tcell.EventKey, setFocus fu
 		return
 	}
 
+	// toggle details panel
+	if event.Rune() == utils.DetailsPanelKey.Rune() {
+		vols.detailsPanel.Toggle()
+
+		return
+	}
+
 	if event.Key() == utils.DeleteKey.EventKey() {
 		vols.removePrep()
 
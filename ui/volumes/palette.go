@@ -0,0 +1,29 @@
+package volumes
+
+import putils "github.com/containers/podman-tui/pdcs/utils"
+
+// GetCommands returns the volumes screen commands and their descriptions.
+func (vols *Volumes) GetCommands() [][]string {
+	return vols.cmdDialog.GetCommands()
+}
+
+// RunCommand runs the volumes screen command on the selected item.
+func (vols *Volumes) RunCommand(cmd string) {
+	vols.runCommand(cmd)
+}
+
+// GetResources returns the volumes listed on the screen.
+func (vols *Volumes) GetResources() []putils.ResourceReference {
+	data := vols.getData()
+	resources := make([]putils.ResourceReference, 0, len(data))
+
+	for _, item := range data {
+		resources = append(resources, putils.ResourceReference{
+			Kind: putils.ResourceKindVolume,
+			Name: item.Name,
+			Info: item.Driver,
+		})
+	}
+
+	return resources
+}
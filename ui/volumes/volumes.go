@@ -29,18 +29,24 @@ type Volumes struct {
 	title           string
 	headers         []string
 	table           *tview.Table
+	detailsPanel    *dialogs.DetailsPanel
 	errorDialog     *dialogs.ErrorDialog
 	progressDialog  *dialogs.ProgressDialog
 	confirmDialog   *dialogs.ConfirmDialog
 	cmdDialog       *dialogs.CommandDialog
 	messageDialog   *dialogs.MessageDialog
+	inspectDialog   *dialogs.InspectDialog
 	sortDialog      *dialogs.SortDialog
 	createDialog    *voldialogs.VolumeCreateDialog
 	exportDialog    *voldialogs.VolumeExportDialog
 	importDialog    *voldialogs.VolumeImportDialog
+	pruneDialog     *dialogs.PruneDialog
+	navigateDialog  *dialogs.NavigateDialog
 	volumeList      volListReport
 	confirmData     string
 	appFocusHandler func()
+	fastRefreshChan chan bool
+	navigateHandler func(kind string, id string)
 }
 
 type volListReport struct {
@@ -64,26 +70,41 @@ func NewVolumes() *Volumes {
 		title:          "volumes",
 		headers:        UIViewHeaders,
 		errorDialog:    dialogs.NewErrorDialog(),
+		detailsPanel:   dialogs.NewDetailsPanel(),
 		progressDialog: dialogs.NewProgressDialog(),
 		confirmDialog:  dialogs.NewConfirmDialog(),
 		messageDialog:  dialogs.NewMessageDialog(""),
+		inspectDialog:  dialogs.NewInspectDialog(),
 		sortDialog:     dialogs.NewSortDialog(sortHeaderItems, 2), //nolint:mnd
 		createDialog:   voldialogs.NewVolumeCreateDialog(),
 		exportDialog:   voldialogs.NewVolumeExportDialog(),
 		importDialog:   voldialogs.NewVolumeImportDialog(),
+		pruneDialog:    dialogs.NewPruneDialog(false, false),
+		navigateDialog: dialogs.NewNavigateDialog(),
 		volumeList:     volListReport{sortBy: UIViewHeaders[volsTableCreatedAtColIndex], ascending: true},
 	}
 
 	vols.initUI()
+	vols.initDetailsPanel()
 
 	return vols
 }
 
+// SetFastRefreshChannel sets channel for fastRefresh func.
+func (vols *Volumes) SetFastRefreshChannel(refresh chan bool) {
+	vols.fastRefreshChan = refresh
+}
+
 // SetAppFocusHandler sets application focus handler.
 func (vols *Volumes) SetAppFocusHandler(handler func()) {
 	vols.appFocusHandler = handler
 }
 
+// SetNavigateFunc sets the handler which switches to another resource screen.
+func (vols *Volumes) SetNavigateFunc(handler func(kind string, id string)) {
+	vols.navigateHandler = handler
+}
+
 // GetTitle returns primitive title.
 func (vols *Volumes) GetTitle() string {
 	return vols.title
@@ -142,10 +163,13 @@ func (vols *Volumes) getInnerDialogs() []utils.UIDialog {
 		vols.confirmDialog,
 		vols.cmdDialog,
 		vols.messageDialog,
+		vols.inspectDialog,
 		vols.createDialog,
 		vols.sortDialog,
 		vols.exportDialog,
 		vols.importDialog,
+		vols.pruneDialog,
+		vols.navigateDialog,
 	}
 
 	return dialogs
@@ -162,6 +186,22 @@ func (vols *Volumes) getSelectedItem() string {
 	return volID
 }
 
+// GetSelectedItemID returns the selected volume name.
+func (vols *Volumes) GetSelectedItemID() string {
+	return vols.getSelectedItem()
+}
+
+// SelectItem selects the volume by its name.
+func (vols *Volumes) SelectItem(id string) {
+	for i, volume := range vols.getData() {
+		if volume.Name == id {
+			vols.table.Select(i+1, 0)
+
+			return
+		}
+	}
+}
+
 func (vols *Volumes) initUI() {
 	vols.cmdDialog = dialogs.NewCommandDialog([][]string{
 		{"create", "create a new volume"},
@@ -170,6 +210,7 @@ func (vols *Volumes) initUI() {
 		{"inspect", "display detailed volume's information"},
 		{"prune", "remove all unused volumes"},
 		{"rm", "remove the selected volume"},
+		{"used by", "list containers which use the selected volume and switch to one of them"},
 	})
 
 	vols.table = tview.NewTable()
@@ -208,14 +249,16 @@ func (vols *Volumes) initUI() {
 		vols.messageDialog.Hide()
 	})
 
+	// set inspect dialog functions
+	vols.inspectDialog.SetCancelFunc(func() {
+		vols.inspectDialog.Hide()
+	})
+
 	// set confirm dialogs functions
 	vols.confirmDialog.SetSelectedFunc(func() {
 		vols.confirmDialog.Hide()
 
-		switch vols.confirmData {
-		case utils.PruneCommandLabel:
-			vols.prune()
-		case "rm":
+		if vols.confirmData == "rm" {
 			vols.remove()
 		}
 	})
@@ -252,6 +295,17 @@ func (vols *Volumes) initUI() {
 		vols.importVol()
 	})
 
+	// prune dialog handlers
+	vols.pruneDialog.SetTitle("podman volume prune")
+	vols.pruneDialog.SetCancelFunc(vols.pruneDialog.Hide)
+	vols.pruneDialog.SetPreviewFunc(vols.prunePreview)
+	vols.pruneDialog.SetPruneFunc(vols.prune)
+
+	// set navigate dialog functions
+	vols.navigateDialog.SetTitle("podman volume used by")
+	vols.navigateDialog.SetCancelFunc(vols.navigateDialog.Hide)
+	vols.navigateDialog.SetGoToFunc(vols.goTo)
+
 	// set sort dialog functions
 	vols.sortDialog.SetSelectFunc(vols.SortView)
 	vols.sortDialog.SetCancelFunc(vols.sortDialog.Hide)